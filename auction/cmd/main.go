@@ -75,6 +75,26 @@ func main() {
 	defer consumer.Close()
 
 	auctionService := service.NewAuctionService(bidRepo, packageRepo, producer, log)
+	if cfg.Auction.Duration > 0 {
+		auctionService.SetAuctionDuration(cfg.Auction.Duration)
+	}
+	if cfg.Auction.CloseLease > 0 {
+		auctionService.SetCloseLease(cfg.Auction.CloseLease)
+	}
+	auctionService.SetSoftClose(service.SoftClose{
+		Window:    cfg.Auction.SoftClose.Window,
+		Extension: cfg.Auction.SoftClose.Extension,
+		Max:       cfg.Auction.SoftClose.Max,
+	})
+	auctionService.SetPricing(service.Pricing{
+		Depreciation:        cfg.Auction.Pricing.Depreciation,
+		MinStartingPrice:    cfg.Auction.Pricing.MinStartingPrice,
+		ReservePercent:      cfg.Auction.Pricing.Reserve,
+		MinIncrement:        cfg.Auction.Pricing.MinIncrement,
+		MinIncrementPercent: cfg.Auction.Pricing.MinIncrementPercent,
+	})
+	go service.NewAuctionScheduler(auctionService, cfg.Auction.SchedulerInterval, log).Run(ctx)
+
 	bidHandler := handlers.NewBidGRPCHandler(auctionService, log)
 	go func() {
 		lis, err := net.Listen("tcp", cfg.Server.GRPCAddress)
@@ -12,6 +12,7 @@ type Config struct {
 	Server   ServerConfig   `yaml:"server"`
 	Database DatabaseConfig `yaml:"database"`
 	Kafka    KafkaConfig    `yaml:"kafka"`
+	Auction  AuctionConfig  `yaml:"auction"`
 }
 
 type ServerConfig struct {
@@ -37,6 +38,35 @@ type KafkaConfig struct {
 	GroupID      string   `yaml:"groupID"`
 }
 
+// AuctionConfig schedules auctions; zero values keep the service defaults.
+type AuctionConfig struct {
+	Duration          time.Duration   `yaml:"duration"`
+	SchedulerInterval time.Duration   `yaml:"scheduler_interval"`
+	CloseLease        time.Duration   `yaml:"close_lease"`
+	SoftClose         SoftCloseConfig `yaml:"soft_close"`
+	Pricing           PricingConfig   `yaml:"pricing"`
+}
+
+// SoftCloseConfig extends an auction by extension for every bid placed in
+// its final window, up to max past the scheduled end.
+type SoftCloseConfig struct {
+	Window    time.Duration `yaml:"window"`
+	Extension time.Duration `yaml:"extension"`
+	Max       time.Duration `yaml:"max"`
+}
+
+// PricingConfig prices an auction from the package's delivery cost: the
+// starting price is the cost less depreciation percent, and the hidden
+// reserve is reserve percent of the cost. Bids must beat the top one by
+// min_increment or min_increment_percent of it, whichever is larger.
+type PricingConfig struct {
+	Depreciation        float64 `yaml:"depreciation"`
+	MinStartingPrice    float64 `yaml:"min_starting_price"`
+	Reserve             float64 `yaml:"reserve"`
+	MinIncrement        float64 `yaml:"min_increment"`
+	MinIncrementPercent float64 `yaml:"min_increment_percent"`
+}
+
 func Load() *Config {
 	// data, err := os.ReadFile("./auction/configs/config.yaml")
 	configPath := os.Getenv("AUCTION_CONFIG")
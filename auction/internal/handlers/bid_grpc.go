@@ -59,11 +59,12 @@ func (h *BidGRPCHandler) GetBidsByPackage(ctx context.Context, req *auctionpb.Bi
 	var resp auctionpb.BidsResponse
 	for _, b := range bids {
 		resp.Bids = append(resp.Bids, &auctionpb.Bid{
-			BidId:     b.BidID,
-			PackageId: b.PackageID,
-			UserId:    b.UserID,
-			Amount:    b.Amount,
-			Timestamp: b.Timestamp.Format(time.RFC3339),
+			BidId:         b.BidID,
+			PackageId:     b.PackageID,
+			UserId:        b.UserID,
+			Amount:        b.Amount,
+			Timestamp:     b.Timestamp.Format(time.RFC3339),
+			AuctionEndsAt: formatTime(b.AuctionEndsAt),
 		})
 	}
 	return &resp, nil
@@ -93,11 +94,12 @@ func (h *BidGRPCHandler) StreamBids(req *auctionpb.BidsRequest, stream auctionpb
 
 		bid := event.FullDocument
 		if err := stream.Send(&auctionpb.Bid{
-			BidId:     bid.BidID,
-			PackageId: bid.PackageID,
-			UserId:    bid.UserID,
-			Amount:    bid.Amount,
-			Timestamp: bid.Timestamp.Format(time.RFC3339),
+			BidId:         bid.BidID,
+			PackageId:     bid.PackageID,
+			UserId:        bid.UserID,
+			Amount:        bid.Amount,
+			Timestamp:     bid.Timestamp.Format(time.RFC3339),
+			AuctionEndsAt: formatTime(bid.AuctionEndsAt),
 		}); err != nil {
 			h.logger.WithError(err).Error("stream send failed")
 			return err
@@ -121,17 +123,21 @@ func (h *BidGRPCHandler) GetAuctioningPackages(ctx context.Context, _ *auctionpb
 	var res auctionpb.Packages
 	for _, p := range pkgs {
 		res.Package = append(res.Package, &auctionpb.Package{
-			PackageId:  p.PackageID,
-			Status:     p.Status,
-			From:       p.From,
-			To:         p.To,
-			Weight:     p.Weight,
-			Width:      int32(p.Width),
-			Length:     int32(p.Length),
-			Height:     int32(p.Height),
-			Cost:       p.Cost,
-			Currency:   p.Currency,
-			TariffCode: p.TariffCode,
+			PackageId:           p.PackageID,
+			Status:              p.Status,
+			From:                p.From,
+			To:                  p.To,
+			Weight:              p.Weight,
+			Width:               int32(p.Width),
+			Length:              int32(p.Length),
+			Height:              int32(p.Height),
+			Cost:                p.Cost,
+			Currency:            p.Currency,
+			TariffCode:          p.TariffCode,
+			AuctionEndsAt:       formatTime(p.AuctionEndsAt),
+			StartingPrice:       p.StartingPrice,
+			MinIncrement:        p.MinIncrement,
+			MinIncrementPercent: p.MinIncrementPercent,
 		})
 	}
 	return &res, nil
@@ -146,17 +152,21 @@ func (h *BidGRPCHandler) GetFailedPackages(ctx context.Context, _ *auctionpb.Emp
 	var res auctionpb.Packages
 	for _, p := range pkgs {
 		res.Package = append(res.Package, &auctionpb.Package{
-			PackageId:  p.PackageID,
-			Status:     p.Status,
-			From:       p.From,
-			To:         p.To,
-			Weight:     p.Weight,
-			Width:      int32(p.Width),
-			Length:     int32(p.Length),
-			Height:     int32(p.Height),
-			Cost:       p.Cost,
-			Currency:   p.Currency,
-			TariffCode: p.TariffCode,
+			PackageId:           p.PackageID,
+			Status:              p.Status,
+			From:                p.From,
+			To:                  p.To,
+			Weight:              p.Weight,
+			Width:               int32(p.Width),
+			Length:              int32(p.Length),
+			Height:              int32(p.Height),
+			Cost:                p.Cost,
+			Currency:            p.Currency,
+			TariffCode:          p.TariffCode,
+			AuctionEndsAt:       formatTime(p.AuctionEndsAt),
+			StartingPrice:       p.StartingPrice,
+			MinIncrement:        p.MinIncrement,
+			MinIncrementPercent: p.MinIncrementPercent,
 		})
 	}
 	return &res, nil
@@ -177,17 +187,21 @@ func (h *BidGRPCHandler) GetUserWonPackages(ctx context.Context, _ *auctionpb.Em
 	var res auctionpb.Packages
 	for _, p := range pkgs {
 		res.Package = append(res.Package, &auctionpb.Package{
-			PackageId:  p.PackageID,
-			Status:     p.Status,
-			From:       p.From,
-			To:         p.To,
-			Weight:     p.Weight,
-			Width:      int32(p.Width),
-			Length:     int32(p.Length),
-			Height:     int32(p.Height),
-			Cost:       p.Cost,
-			Currency:   p.Currency,
-			TariffCode: p.TariffCode,
+			PackageId:           p.PackageID,
+			Status:              p.Status,
+			From:                p.From,
+			To:                  p.To,
+			Weight:              p.Weight,
+			Width:               int32(p.Width),
+			Length:              int32(p.Length),
+			Height:              int32(p.Height),
+			Cost:                p.Cost,
+			Currency:            p.Currency,
+			TariffCode:          p.TariffCode,
+			AuctionEndsAt:       formatTime(p.AuctionEndsAt),
+			StartingPrice:       p.StartingPrice,
+			MinIncrement:        p.MinIncrement,
+			MinIncrementPercent: p.MinIncrementPercent,
 		})
 	}
 	return &res, nil
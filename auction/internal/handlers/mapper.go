@@ -9,11 +9,12 @@ import (
 
 func BidModelToProto(b *models.Bid) *auctionpb.Bid {
 	return &auctionpb.Bid{
-		BidId:     b.BidID,
-		PackageId: b.PackageID,
-		UserId:    b.UserID,
-		Amount:    b.Amount,
-		Timestamp: b.Timestamp.Format(time.RFC3339),
+		BidId:         b.BidID,
+		PackageId:     b.PackageID,
+		UserId:        b.UserID,
+		Amount:        b.Amount,
+		Timestamp:     b.Timestamp.Format(time.RFC3339),
+		AuctionEndsAt: formatTime(b.AuctionEndsAt),
 	}
 }
 
@@ -28,17 +29,21 @@ func BidProtoToModel(req *auctionpb.BidRequest) *models.Bid {
 
 func PackageModelToProto(p *models.Package) *auctionpb.Package {
 	return &auctionpb.Package{
-		PackageId:  p.PackageID,
-		Status:     p.Status,
-		From:       p.From,
-		To:         p.To,
-		Weight:     p.Weight,
-		Length:     int32(p.Length),
-		Width:      int32(p.Width),
-		Height:     int32(p.Height),
-		Cost:       p.Cost,
-		Currency:   p.Currency,
-		TariffCode: p.TariffCode,
+		PackageId:           p.PackageID,
+		Status:              p.Status,
+		From:                p.From,
+		To:                  p.To,
+		Weight:              p.Weight,
+		Length:              int32(p.Length),
+		Width:               int32(p.Width),
+		Height:              int32(p.Height),
+		Cost:                p.Cost,
+		Currency:            p.Currency,
+		TariffCode:          p.TariffCode,
+		AuctionEndsAt:       formatTime(p.AuctionEndsAt),
+		StartingPrice:       p.StartingPrice,
+		MinIncrement:        p.MinIncrement,
+		MinIncrementPercent: p.MinIncrementPercent,
 	}
 }
 
@@ -50,3 +55,11 @@ func PackagesModelToProto(pkgs []models.Package) *auctionpb.Packages {
 	}
 	return &packagesProto
 }
+
+// formatTime leaves times that were never set empty.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
@@ -83,7 +83,10 @@ func TestAuctionFlow_ShortDuration(t *testing.T) {
 	err = auctionService.PlaceBid(ctx, bid2)
 	assert.NoError(t, err)
 
-	time.Sleep(2 * time.Second)
+	time.Sleep(1100 * time.Millisecond)
+	closed, err := auctionService.CloseDueAuctions(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, closed)
 
 	updatedPkg, err := packageRepo.FindByID(ctx, "test-package-1")
 	assert.NoError(t, err)
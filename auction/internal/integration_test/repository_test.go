@@ -2,6 +2,7 @@ package integration_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -201,6 +202,101 @@ func TestPackageRepository_FindUserPackages(t *testing.T) {
 	assert.Contains(t, pkgIDs, "pkg-3")
 }
 
+func TestPackageRepository_ClaimDueAuction(t *testing.T) {
+	ctx, db, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewPackageRepository(db, "packages")
+	now := time.Now().UTC()
+	for _, id := range []string{"due", "running"} {
+		_, err := repo.Create(ctx, &models.Package{PackageID: id, Status: "Waiting", CreatedAt: now, UpdatedAt: now})
+		assert.NoError(t, err)
+	}
+	terms := models.AuctionTerms{StartingPrice: 500, ReservePrice: 800, MinIncrement: 10}
+	started, err := repo.StartAuction(ctx, "due", "Waiting", now.Add(-time.Hour), now.Add(-time.Minute), terms)
+	assert.NoError(t, err)
+	assert.True(t, started)
+	started, err = repo.StartAuction(ctx, "due", "Waiting", now, now.Add(time.Hour), models.AuctionTerms{})
+	assert.NoError(t, err)
+	assert.False(t, started, "an auction is started once")
+	_, err = repo.StartAuction(ctx, "running", "Waiting", now, now.Add(time.Hour), terms)
+	assert.NoError(t, err)
+
+	// replicas race for the close; exactly one claims it
+	claims := make(chan *models.Package, 4)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pkg, err := repo.ClaimDueAuction(ctx, now, time.Minute)
+			assert.NoError(t, err)
+			claims <- pkg
+		}()
+	}
+	wg.Wait()
+	close(claims)
+	var claimed []*models.Package
+	for pkg := range claims {
+		if pkg != nil {
+			claimed = append(claimed, pkg)
+		}
+	}
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, "due", claimed[0].PackageID)
+	assert.Equal(t, "Closing", claimed[0].Status)
+	assert.Equal(t, terms, claimed[0].AuctionTerms)
+
+	// the claim lapses and is taken over; the first closer then loses
+	takeover, err := repo.ClaimDueAuction(ctx, now.Add(2*time.Minute), time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "due", takeover.PackageID)
+
+	claimed[0].Status = "Finished"
+	saved, err := repo.CompleteAuction(ctx, claimed[0])
+	assert.NoError(t, err)
+	assert.False(t, saved)
+
+	takeover.Status = "Auction-failed"
+	saved, err = repo.CompleteAuction(ctx, takeover)
+	assert.NoError(t, err)
+	assert.True(t, saved)
+
+	result, err := repo.FindByID(ctx, "due")
+	assert.NoError(t, err)
+	assert.Equal(t, "Auction-failed", result.Status)
+}
+
+func TestPackageRepository_ScheduleLegacyAuction(t *testing.T) {
+	ctx, db, cleanup := setupTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewPackageRepository(db, "packages")
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	_, err := repo.Create(ctx, &models.Package{PackageID: "legacy", Status: "Auctioning", UpdatedAt: now})
+	assert.NoError(t, err)
+	_, err = repo.Create(ctx, &models.Package{PackageID: "closed", Status: "Finished", UpdatedAt: now})
+	assert.NoError(t, err)
+
+	scheduled, err := repo.ScheduleLegacyAuction(ctx, "legacy", now, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.True(t, scheduled)
+	scheduled, err = repo.ScheduleLegacyAuction(ctx, "legacy", now, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, scheduled, "a stored end time is kept")
+	scheduled, err = repo.ScheduleLegacyAuction(ctx, "closed", now, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.False(t, scheduled)
+
+	pkg, err := repo.FindByID(ctx, "legacy")
+	assert.NoError(t, err)
+	assert.True(t, pkg.AuctionEndsAt.Equal(now.Add(time.Minute)))
+	pkg, err = repo.FindByID(ctx, "closed")
+	assert.NoError(t, err)
+	assert.Equal(t, "Finished", pkg.Status)
+	assert.True(t, pkg.AuctionEndsAt.IsZero())
+}
+
 func setupTestEnvironment(t *testing.T) (context.Context, *mongo.Database, func()) {
 	ctx := context.Background()
 
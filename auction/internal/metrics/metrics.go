@@ -65,6 +65,18 @@ var (
 			Help: "Number of auctions finished without winner",
 		},
 	)
+	AuctionBelowReserveTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "auctions_below_reserve_total",
+			Help: "Number of auctions whose top bid did not meet the reserve price",
+		},
+	)
+	AuctionExtendedTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "auctions_extended_total",
+			Help: "Number of soft-close extensions caused by late bids",
+		},
+	)
 	BidsPlacedTotal = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "bids_placed_total",
@@ -139,6 +151,8 @@ func init() {
 		AuctionFinishedTotal,
 		AuctionFinishedWithWinner,
 		AuctionFinishedWithoutWinner,
+		AuctionBelowReserveTotal,
+		AuctionExtendedTotal,
 		BidsPlacedTotal,
 		BidErrorsTotal,
 		KafkaMessagesSent,
@@ -8,4 +8,7 @@ type Bid struct {
 	UserID    string    `bson:"user_id" json:"user_id"`
 	Amount    float64   `bson:"amount" json:"amount"`
 	Timestamp time.Time `bson:"timestamp" json:"timestamp"`
+	// AuctionEndsAt is the end of the auction once this bid was placed, so
+	// bid stream subscribers see soft-close extensions.
+	AuctionEndsAt time.Time `bson:"auction_ends_at,omitempty" json:"auction_ends_at"`
 }
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 type Package struct {
 	PackageID  string    `bson:"package_id" json:"package_id"`
@@ -18,6 +21,37 @@ type Package struct {
 	TariffCode string    `bson:"tariff_code" json:"tariff_code"`
 	CreatedAt  time.Time `bson:"created_at" json:"created_at"`
 	UpdatedAt  time.Time `bson:"updated_at" json:"updated_at"`
+
+	// AuctionEndsAt is when the scheduler closes the auction. ClosingAt is
+	// set by the replica that claimed the close and identifies its claim.
+	AuctionStartedAt time.Time `bson:"auction_started_at,omitempty" json:"auction_started_at"`
+	AuctionEndsAt    time.Time `bson:"auction_ends_at,omitempty" json:"auction_ends_at"`
+	ClosingAt        time.Time `bson:"closing_at,omitempty" json:"-"`
+
+	AuctionTerms `bson:",inline"`
+}
+
+// AuctionTerms are fixed when an auction starts. The reserve price is never
+// shown to bidders: a top bid below it closes the auction unsold.
+type AuctionTerms struct {
+	StartingPrice       float64 `bson:"starting_price,omitempty" json:"starting_price"`
+	ReservePrice        float64 `bson:"reserve_price,omitempty" json:"-"`
+	MinIncrement        float64 `bson:"min_increment,omitempty" json:"min_increment"`
+	MinIncrementPercent float64 `bson:"min_increment_percent,omitempty" json:"min_increment_percent"`
+}
+
+// MinBid is the lowest acceptable bid given the current top bid, or the
+// starting price when there is none. A zero result with a top bid means any
+// amount above it will do.
+func (t AuctionTerms) MinBid(top *Bid) float64 {
+	if top == nil {
+		return t.StartingPrice
+	}
+	increment := math.Max(t.MinIncrement, top.Amount*t.MinIncrementPercent/100)
+	if increment <= 0 {
+		return 0
+	}
+	return math.Round((top.Amount+increment)*100) / 100
 }
 
 type DeliveryInit struct {
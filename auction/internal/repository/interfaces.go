@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/auction/internal/models"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -22,4 +23,9 @@ type Packager interface {
 	FindByFailedStatus(ctx context.Context) ([]*models.Package, error)
 	FindByAuctioningStatus(ctx context.Context) ([]*models.Package, error)
 	FindByWaitingStatus(ctx context.Context) ([]*models.Package, error)
+	StartAuction(ctx context.Context, packageID, fromStatus string, startedAt, endsAt time.Time, terms models.AuctionTerms) (bool, error)
+	ScheduleLegacyAuction(ctx context.Context, packageID string, startedAt, endsAt time.Time) (bool, error)
+	ExtendAuction(ctx context.Context, packageID string, endsAt time.Time) (bool, error)
+	ClaimDueAuction(ctx context.Context, now time.Time, lease time.Duration) (*models.Package, error)
+	CompleteAuction(ctx context.Context, pkg *models.Package) (bool, error)
 }
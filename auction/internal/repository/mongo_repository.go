@@ -2,12 +2,14 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/maksroxx/DeliveryService/auction/internal/metrics"
 	"github.com/maksroxx/DeliveryService/auction/internal/models"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type PackageRepository struct {
@@ -175,3 +177,146 @@ func (r *PackageRepository) FindByWaitingStatus(ctx context.Context) ([]*models.
 	metrics.PackageOpsCount.WithLabelValues("FindByWaitingStatus", status).Inc()
 	return packages, nil
 }
+
+// StartAuction moves the package from fromStatus into "Auctioning" with the
+// given schedule and terms. It reports false when the package has already left
+// fromStatus, e.g. because another replica started the auction first.
+func (r *PackageRepository) StartAuction(ctx context.Context, packageID, fromStatus string, startedAt, endsAt time.Time, terms models.AuctionTerms) (bool, error) {
+	start := time.Now()
+	defer func() {
+		metrics.PackageOpsDuration.WithLabelValues("StartAuction").Observe(time.Since(start).Seconds())
+	}()
+
+	filter := bson.M{"package_id": packageID, "status": fromStatus}
+	update := bson.M{
+		"$set": bson.M{
+			"status":                "Auctioning",
+			"auction_started_at":    startedAt,
+			"auction_ends_at":       endsAt,
+			"updated_at":            startedAt,
+			"starting_price":        terms.StartingPrice,
+			"reserve_price":         terms.ReservePrice,
+			"min_increment":         terms.MinIncrement,
+			"min_increment_percent": terms.MinIncrementPercent,
+		},
+		"$unset": bson.M{"closing_at": ""},
+	}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	metrics.PackageOpsCount.WithLabelValues("StartAuction", status).Inc()
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// ScheduleLegacyAuction stores the schedule of a running auction that was
+// started before end times were stored. It reports false when the auction
+// already has an end time or is no longer running.
+func (r *PackageRepository) ScheduleLegacyAuction(ctx context.Context, packageID string, startedAt, endsAt time.Time) (bool, error) {
+	start := time.Now()
+	defer func() {
+		metrics.PackageOpsDuration.WithLabelValues("ScheduleLegacyAuction").Observe(time.Since(start).Seconds())
+	}()
+
+	filter := bson.M{
+		"package_id": packageID,
+		"status":     "Auctioning",
+		"$or": bson.A{
+			bson.M{"auction_ends_at": bson.M{"$exists": false}},
+			bson.M{"auction_ends_at": nil},
+			bson.M{"auction_ends_at": time.Time{}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"auction_started_at": startedAt, "auction_ends_at": endsAt}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	metrics.PackageOpsCount.WithLabelValues("ScheduleLegacyAuction", status).Inc()
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// ExtendAuction moves the end of a running auction later. It reports false
+// when the auction is no longer running or already ends at or after endsAt.
+func (r *PackageRepository) ExtendAuction(ctx context.Context, packageID string, endsAt time.Time) (bool, error) {
+	start := time.Now()
+	defer func() {
+		metrics.PackageOpsDuration.WithLabelValues("ExtendAuction").Observe(time.Since(start).Seconds())
+	}()
+
+	filter := bson.M{"package_id": packageID, "status": "Auctioning", "auction_ends_at": bson.M{"$lt": endsAt}}
+	res, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"auction_ends_at": endsAt}})
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	metrics.PackageOpsCount.WithLabelValues("ExtendAuction", status).Inc()
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount == 1, nil
+}
+
+// ClaimDueAuction atomically marks one auction that has ended as "Closing"
+// and returns it, or nil when nothing is due. A close left unfinished for
+// longer than lease, because its replica died, can be claimed again.
+func (r *PackageRepository) ClaimDueAuction(ctx context.Context, now time.Time, lease time.Duration) (*models.Package, error) {
+	start := time.Now()
+	defer func() {
+		metrics.PackageOpsDuration.WithLabelValues("ClaimDueAuction").Observe(time.Since(start).Seconds())
+	}()
+
+	// Mongo keeps milliseconds; the claim has to match itself on completion.
+	now = now.UTC().Truncate(time.Millisecond)
+	filter := bson.M{"$or": []bson.M{
+		{"status": "Auctioning", "auction_ends_at": bson.M{"$lte": now}},
+		{"status": "Closing", "closing_at": bson.M{"$lte": now.Add(-lease)}},
+	}}
+	update := bson.M{"$set": bson.M{"status": "Closing", "closing_at": now}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "auction_ends_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var pkg models.Package
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&pkg)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		metrics.PackageOpsCount.WithLabelValues("ClaimDueAuction", "success").Inc()
+		return nil, nil
+	}
+	if err != nil {
+		metrics.PackageOpsCount.WithLabelValues("ClaimDueAuction", "error").Inc()
+		return nil, err
+	}
+	metrics.PackageOpsCount.WithLabelValues("ClaimDueAuction", "success").Inc()
+	return &pkg, nil
+}
+
+// CompleteAuction saves the outcome of a claimed close. It reports false
+// when the claim was lost to another replica in the meantime, in which case
+// nothing is written.
+func (r *PackageRepository) CompleteAuction(ctx context.Context, pkg *models.Package) (bool, error) {
+	start := time.Now()
+	defer func() {
+		metrics.PackageOpsDuration.WithLabelValues("CompleteAuction").Observe(time.Since(start).Seconds())
+	}()
+
+	filter := bson.M{"package_id": pkg.PackageID, "status": "Closing", "closing_at": pkg.ClosingAt}
+	res, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": pkg})
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	metrics.PackageOpsCount.WithLabelValues("CompleteAuction", status).Inc()
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount == 1, nil
+}
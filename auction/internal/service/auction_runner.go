@@ -2,75 +2,177 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
-	"github.com/maksroxx/DeliveryService/auction/internal/kafka"
 	"github.com/maksroxx/DeliveryService/auction/internal/metrics"
 	"github.com/maksroxx/DeliveryService/auction/internal/models"
-	"github.com/maksroxx/DeliveryService/auction/internal/repository"
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
-func StartAuction(
-	pkg *models.Package,
-	auctionSvc *AuctionService,
-	producer kafka.AucPublisher,
-	repo repository.Packager,
-	log *logrus.Logger,
-	auctionDuration time.Duration,
-) {
-	go func() {
-		metrics.AuctionStartedTotal.Inc()
+// AuctionScheduler closes auctions once their end time has passed. The
+// schedule lives on the packages, so a restarted service picks up where it
+// left off, and closes are claimed in the database, so any number of
+// replicas can run the scheduler side by side.
+type AuctionScheduler struct {
+	svc      *AuctionService
+	interval time.Duration
+	log      *logrus.Logger
+}
 
-		timer := time.NewTimer(auctionDuration)
-		defer timer.Stop()
+func NewAuctionScheduler(svc *AuctionService, interval time.Duration, log *logrus.Logger) *AuctionScheduler {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	return &AuctionScheduler{
+		svc:      svc,
+		interval: interval,
+		log:      log,
+	}
+}
 
-		pkg.Status = "Auctioning"
-		if err := repo.Update(context.Background(), pkg); err != nil {
-			log.WithError(err).Error("Failed to update package when auction start")
+// Run recovers auctions that ended while the service was down, then polls
+// for due auctions until ctx is cancelled.
+func (s *AuctionScheduler) Run(ctx context.Context) {
+	if err := s.svc.RecoverAuctions(ctx); err != nil {
+		s.log.WithError(err).Error("Failed to recover auctions")
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
 			return
+		case <-ticker.C:
+			if _, err := s.svc.CloseDueAuctions(ctx); err != nil {
+				s.log.WithError(err).Error("Failed to close due auctions")
+			}
 		}
+	}
+}
 
-		<-timer.C
-		metrics.AuctionFinishedTotal.Inc()
-
-		winner, err := auctionSvc.DetermineWinner(context.Background(), pkg.PackageID)
-		if err != nil || winner == nil {
-			metrics.AuctionFinishedWithoutWinner.Inc()
+// startAuction schedules the auction from now and prices it from the
+// package's delivery cost; it is a no-op when the package has already left
+// fromStatus.
+func (s *AuctionService) startAuction(ctx context.Context, pkg *models.Package, fromStatus string) error {
+	now := s.now()
+	terms := s.pricing.Terms(pkg.Cost)
+	started, err := s.packageRepo.StartAuction(ctx, pkg.PackageID, fromStatus, now, now.Add(s.auctionDuration), terms)
+	if err != nil {
+		return fmt.Errorf("start auction for package %s: %w", pkg.PackageID, err)
+	}
+	if started {
+		metrics.AuctionStartedTotal.Inc()
+	}
+	return nil
+}
 
-			log.Warnf("Auction finished with no winner for package %s", pkg.PackageID)
-			pkg.Status = "Auction-failed"
-			pkg.UpdatedAt = time.Now()
-			_ = repo.Update(context.Background(), pkg)
-			return
+// RecoverAuctions gives auctions started before end times were stored one
+// counted from their last update, then closes everything already due.
+func (s *AuctionService) RecoverAuctions(ctx context.Context) error {
+	pkgs, err := s.packageRepo.FindByAuctioningStatus(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		if !pkg.AuctionEndsAt.IsZero() {
+			continue
+		}
+		// another replica may have scheduled or closed it since the read
+		if _, err := s.packageRepo.ScheduleLegacyAuction(ctx, pkg.PackageID, pkg.UpdatedAt, pkg.UpdatedAt.Add(s.auctionDuration)); err != nil {
+			return fmt.Errorf("schedule auction for package %s: %w", pkg.PackageID, err)
 		}
+	}
 
-		metrics.AuctionFinishedWithWinner.Inc()
+	closed, err := s.CloseDueAuctions(ctx)
+	if closed > 0 {
+		s.logger.Infof("Recovered %d auctions that ended while the service was down", closed)
+	}
+	return err
+}
+
+// CloseDueAuctions closes every auction whose end time has passed and
+// returns how many it closed.
+func (s *AuctionService) CloseDueAuctions(ctx context.Context) (int, error) {
+	closed := 0
+	for {
+		pkg, err := s.packageRepo.ClaimDueAuction(ctx, s.now(), s.closeLease)
+		if err != nil {
+			return closed, err
+		}
+		if pkg == nil {
+			return closed, nil
+		}
+		if err := s.closeAuction(ctx, pkg); err != nil {
+			s.logger.WithError(err).Errorf("Failed to close auction for package %s", pkg.PackageID)
+			continue
+		}
+		closed++
+	}
+}
 
+// closeAuction settles a claimed auction. Nothing is published unless the
+// outcome was saved under this replica's claim.
+func (s *AuctionService) closeAuction(ctx context.Context, pkg *models.Package) error {
+	winner, err := s.DetermineWinner(ctx, pkg.PackageID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		winner = nil
+	} else if err != nil {
+		// left claimed; it is retried once the close lease runs out
+		return fmt.Errorf("determine winner: %w", err)
+	}
+	pkg.UpdatedAt = s.now()
+	belowReserve := winner != nil && winner.Amount < pkg.ReservePrice
+	if winner == nil || belowReserve {
+		pkg.Status = "Auction-failed"
+	} else {
 		pkg.Status = "Finished"
 		pkg.UserID = winner.UserID
 		pkg.Cost = winner.Amount
-		pkg.UpdatedAt = time.Now()
+	}
 
-		if err := repo.Update(context.Background(), pkg); err != nil {
-			log.WithError(err).Error("Failed to update package after auction win")
-			return
-		}
+	saved, err := s.packageRepo.CompleteAuction(ctx, pkg)
+	if err != nil {
+		return err
+	}
+	if !saved {
+		s.logger.Warnf("Auction for package %s was closed by another replica", pkg.PackageID)
+		return nil
+	}
 
-		result := &models.AuctionResult{
-			PackageID:  pkg.PackageID,
-			WinnerID:   winner.UserID,
-			FinalPrice: winner.Amount,
-			Currency:   pkg.Currency,
-			FinishedAt: time.Now(),
+	metrics.AuctionFinishedTotal.Inc()
+	if pkg.Status == "Auction-failed" {
+		metrics.AuctionFinishedWithoutWinner.Inc()
+		if belowReserve {
+			metrics.AuctionBelowReserveTotal.Inc()
+			s.logger.Warnf("Auction for package %s closed at %.2f, below its reserve", pkg.PackageID, winner.Amount)
+			return nil
 		}
-		_ = producer.PublishPayment(context.Background(), result)
+		s.logger.Warnf("Auction finished with no winner for package %s", pkg.PackageID)
+		return nil
+	}
+	metrics.AuctionFinishedWithWinner.Inc()
 
-		notification := &models.Notification{
-			UserID:  winner.UserID,
-			Message: fmt.Sprintf("Поздравляем! Вы выиграли аукцион на пакет %s за %.2f %s", pkg.PackageID, winner.Amount, pkg.Currency),
-		}
-		_ = producer.PublishNotification(context.Background(), notification)
-	}()
+	result := &models.AuctionResult{
+		PackageID:  pkg.PackageID,
+		WinnerID:   winner.UserID,
+		FinalPrice: winner.Amount,
+		Currency:   pkg.Currency,
+		FinishedAt: pkg.UpdatedAt,
+	}
+	if err := s.producer.PublishPayment(ctx, result); err != nil {
+		s.logger.WithError(err).Errorf("Failed to publish payment for package %s", pkg.PackageID)
+	}
+
+	notification := &models.Notification{
+		UserID:  winner.UserID,
+		Message: fmt.Sprintf("Поздравляем! Вы выиграли аукцион на пакет %s за %.2f %s", pkg.PackageID, winner.Amount, pkg.Currency),
+	}
+	if err := s.producer.PublishNotification(ctx, notification); err != nil {
+		s.logger.WithError(err).Errorf("Failed to publish notification for package %s", pkg.PackageID)
+	}
+	return nil
 }
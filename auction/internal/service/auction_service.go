@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/maksroxx/DeliveryService/auction/internal/kafka"
@@ -13,6 +14,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+var errAuctionClosed = errors.New("auction closed before it could be extended")
+
 type AuctionServicer interface {
 	PlaceBid(ctx context.Context, bid *models.Bid) error
 	GetBidsByPackage(ctx context.Context, packageID string) ([]*models.Bid, error)
@@ -32,6 +35,20 @@ type AuctionService struct {
 	producer        kafka.AucPublisher
 	logger          *logrus.Logger
 	auctionDuration time.Duration
+	closeLease      time.Duration
+	softClose       SoftClose
+	pricing         Pricing
+	now             func() time.Time
+}
+
+// SoftClose keeps bids in the final Window of an auction from going
+// unanswered: each one pushes the end back by Extension, until the auction
+// has run Max past its scheduled end. A zero Window or Extension turns
+// soft close off and a zero Max leaves extensions unlimited.
+type SoftClose struct {
+	Window    time.Duration
+	Extension time.Duration
+	Max       time.Duration
 }
 
 func NewAuctionService(bidRepo repository.Bidder, packageRepo repository.Packager, producer kafka.AucPublisher, logger *logrus.Logger) *AuctionService {
@@ -41,6 +58,8 @@ func NewAuctionService(bidRepo repository.Bidder, packageRepo repository.Package
 		producer:        producer,
 		logger:          logger,
 		auctionDuration: 2 * time.Minute,
+		closeLease:      time.Minute,
+		now:             time.Now,
 	}
 }
 
@@ -54,20 +73,49 @@ func (s *AuctionService) PlaceBid(ctx context.Context, bid *models.Bid) error {
 		metrics.BidErrorsTotal.WithLabelValues("auction_not_active").Inc()
 		return errors.New("auction not active")
 	}
-	if time.Now().After(pkg.UpdatedAt.Add(s.auctionDuration)) {
+	if s.now().After(s.endsAt(pkg)) {
 		metrics.BidErrorsTotal.WithLabelValues("auction_ended").Inc()
 		return errors.New("auction ended")
 	}
 	topBid, err := s.bidRepo.GetTopBidByPackage(ctx, bid.PackageID)
-	if err == nil && topBid != nil && bid.Amount <= topBid.Amount {
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		topBid = nil
+	} else if err != nil {
+		metrics.BidErrorsTotal.WithLabelValues("top_bid_lookup_failed").Inc()
+		return err
+	}
+	minBid := pkg.MinBid(topBid)
+	switch {
+	case topBid == nil && bid.Amount < minBid:
+		metrics.BidErrorsTotal.WithLabelValues("bid_below_start").Inc()
+		return fmt.Errorf("bid must be at least the starting price of %.2f", minBid)
+	case topBid != nil && minBid == 0 && bid.Amount <= topBid.Amount:
 		metrics.BidErrorsTotal.WithLabelValues("bid_too_low").Inc()
 		return errors.New("bid must be greater than current highest")
+	case topBid != nil && bid.Amount < minBid:
+		metrics.BidErrorsTotal.WithLabelValues("bid_too_low").Inc()
+		return fmt.Errorf("bid must be at least %.2f", minBid)
+	}
+	// a bid in the closing window extends the auction before it is stored:
+	// once the close has been claimed the extension fails and the bid is
+	// turned away instead of being stored behind the close
+	bid.AuctionEndsAt = s.softCloseEnd(pkg)
+	if bid.AuctionEndsAt.After(s.endsAt(pkg)) {
+		ends, err := s.extend(ctx, pkg.PackageID, bid.AuctionEndsAt)
+		if errors.Is(err, errAuctionClosed) {
+			metrics.BidErrorsTotal.WithLabelValues("auction_ended").Inc()
+			return errors.New("auction ended")
+		}
+		if err != nil {
+			return err
+		}
+		bid.AuctionEndsAt = ends
 	}
-	err = s.bidRepo.PlaceBid(ctx, bid)
-	if err == nil {
-		metrics.BidsPlacedTotal.Inc()
+	if err := s.bidRepo.PlaceBid(ctx, bid); err != nil {
+		return err
 	}
-	return err
+	metrics.BidsPlacedTotal.Inc()
+	return nil
 }
 
 func (s *AuctionService) GetBidsByPackage(ctx context.Context, packageID string) ([]*models.Bid, error) {
@@ -96,7 +144,9 @@ func (s *AuctionService) StartWaitingAuctions(ctx context.Context) error {
 		return err
 	}
 	for _, p := range pkgs {
-		StartAuction(p, s, s.producer, s.packageRepo, s.logger, s.auctionDuration)
+		if err := s.startAuction(ctx, p, "Waiting"); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -107,7 +157,9 @@ func (s *AuctionService) RepeatFailedAuctions(ctx context.Context) error {
 		return err
 	}
 	for _, p := range pkgs {
-		StartAuction(p, s, s.producer, s.packageRepo, s.logger, s.auctionDuration)
+		if err := s.startAuction(ctx, p, "Auction-failed"); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -119,3 +171,80 @@ func (s *AuctionService) DetermineWinner(ctx context.Context, packageID string)
 func (s *AuctionService) SetAuctionDuration(duration time.Duration) {
 	s.auctionDuration = duration
 }
+
+// SetCloseLease sets how long a replica may take to close an auction
+// before another one takes the close over.
+func (s *AuctionService) SetCloseLease(lease time.Duration) {
+	s.closeLease = lease
+}
+
+// SetClock replaces the clock auctions are scheduled and closed by.
+func (s *AuctionService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// SetSoftClose sets how bids near the end of an auction extend it.
+func (s *AuctionService) SetSoftClose(softClose SoftClose) {
+	s.softClose = softClose
+}
+
+// SetPricing sets the rule auctions started from now on are priced by.
+func (s *AuctionService) SetPricing(pricing Pricing) {
+	s.pricing = pricing
+}
+
+// endsAt falls back to the scheduled end for auctions started before end
+// times were stored.
+func (s *AuctionService) endsAt(pkg *models.Package) time.Time {
+	if !pkg.AuctionEndsAt.IsZero() {
+		return pkg.AuctionEndsAt
+	}
+	return s.scheduledEnd(pkg)
+}
+
+// scheduledEnd is the end of the auction before any soft-close extension.
+func (s *AuctionService) scheduledEnd(pkg *models.Package) time.Time {
+	started := pkg.AuctionStartedAt
+	if started.IsZero() {
+		started = pkg.UpdatedAt
+	}
+	return started.Add(s.auctionDuration)
+}
+
+// softCloseEnd is the end of the auction once a bid placed now is in.
+func (s *AuctionService) softCloseEnd(pkg *models.Package) time.Time {
+	ends := s.endsAt(pkg)
+	sc := s.softClose
+	if sc.Window <= 0 || sc.Extension <= 0 || ends.Sub(s.now()) > sc.Window {
+		return ends
+	}
+	extended := ends.Add(sc.Extension)
+	if limit := s.scheduledEnd(pkg).Add(sc.Max); sc.Max > 0 && extended.After(limit) {
+		extended = limit
+	}
+	if extended.Before(ends) {
+		return ends
+	}
+	return extended
+}
+
+// extend pushes the end of the auction back to extended and returns the end
+// it has now, which is later when a concurrent bid extended it further.
+func (s *AuctionService) extend(ctx context.Context, packageID string, extended time.Time) (time.Time, error) {
+	ok, err := s.packageRepo.ExtendAuction(ctx, packageID, extended)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if ok {
+		metrics.AuctionExtendedTotal.Inc()
+		return extended, nil
+	}
+	current, err := s.packageRepo.FindByID(ctx, packageID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if current.Status != "Auctioning" {
+		return time.Time{}, errAuctionClosed
+	}
+	return s.endsAt(current), nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"math"
+
+	"github.com/maksroxx/DeliveryService/auction/internal/models"
+)
+
+// Pricing derives the terms of an auction from the delivery cost of the
+// package being sold. Percentages are of that cost, except
+// MinIncrementPercent, which is of the current top bid; an increment is
+// whichever of MinIncrement and MinIncrementPercent is larger.
+type Pricing struct {
+	Depreciation        float64
+	MinStartingPrice    float64
+	ReservePercent      float64
+	MinIncrement        float64
+	MinIncrementPercent float64
+}
+
+// Terms depreciates cost into the starting price, never below
+// MinStartingPrice, and sets the reserve at ReservePercent of cost.
+func (p Pricing) Terms(cost float64) models.AuctionTerms {
+	depreciation := math.Min(math.Max(p.Depreciation, 0), 100)
+	starting := math.Max(cost*(1-depreciation/100), p.MinStartingPrice)
+	return models.AuctionTerms{
+		StartingPrice:       roundPrice(starting),
+		ReservePrice:        roundPrice(cost * math.Max(p.ReservePercent, 0) / 100),
+		MinIncrement:        math.Max(p.MinIncrement, 0),
+		MinIncrementPercent: math.Max(p.MinIncrementPercent, 0),
+	}
+}
+
+func roundPrice(v float64) float64 {
+	return math.Round(v*100) / 100
+}
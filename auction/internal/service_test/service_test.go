@@ -78,6 +78,31 @@ func (m *MockPackageRepository) FindByWaitingStatus(ctx context.Context) ([]*mod
 	return args.Get(0).([]*models.Package), args.Error(1)
 }
 
+func (m *MockPackageRepository) StartAuction(ctx context.Context, packageID, fromStatus string, startedAt, endsAt time.Time, terms models.AuctionTerms) (bool, error) {
+	args := m.Called(ctx, packageID, fromStatus, startedAt, endsAt, terms)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPackageRepository) ScheduleLegacyAuction(ctx context.Context, packageID string, startedAt, endsAt time.Time) (bool, error) {
+	args := m.Called(ctx, packageID, startedAt, endsAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPackageRepository) ExtendAuction(ctx context.Context, packageID string, endsAt time.Time) (bool, error) {
+	args := m.Called(ctx, packageID, endsAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPackageRepository) ClaimDueAuction(ctx context.Context, now time.Time, lease time.Duration) (*models.Package, error) {
+	args := m.Called(ctx, now, lease)
+	return args.Get(0).(*models.Package), args.Error(1)
+}
+
+func (m *MockPackageRepository) CompleteAuction(ctx context.Context, pkg *models.Package) (bool, error) {
+	args := m.Called(ctx, pkg)
+	return args.Bool(0), args.Error(1)
+}
+
 type MockAuctionPublisher struct {
 	mock.Mock
 }
@@ -178,3 +203,311 @@ func TestAuctionService_PlaceBid(t *testing.T) {
 		})
 	}
 }
+
+func TestAuctionService_StartWaitingAuctions_PersistsSchedule(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	auctionService.SetClock(func() time.Time { return now })
+	auctionService.SetAuctionDuration(10 * time.Minute)
+	auctionService.SetPricing(service.Pricing{Depreciation: 40, MinStartingPrice: 100, ReservePercent: 75, MinIncrement: 10})
+
+	mockPkgRepo.On("FindByWaitingStatus", mock.Anything).Return([]*models.Package{{PackageID: "pkg-1", Cost: 1000}, {PackageID: "pkg-2", Cost: 100}}, nil)
+	mockPkgRepo.On("StartAuction", mock.Anything, "pkg-1", "Waiting", now, now.Add(10*time.Minute),
+		models.AuctionTerms{StartingPrice: 600, ReservePrice: 750, MinIncrement: 10}).Return(true, nil)
+	// another replica got there first
+	mockPkgRepo.On("StartAuction", mock.Anything, "pkg-2", "Waiting", now, now.Add(10*time.Minute),
+		models.AuctionTerms{StartingPrice: 100, ReservePrice: 75, MinIncrement: 10}).Return(false, nil)
+
+	assert.NoError(t, auctionService.StartWaitingAuctions(context.Background()))
+	mockPkgRepo.AssertExpectations(t)
+}
+
+func TestAuctionService_CloseDueAuctions(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	mockProducer := new(MockAuctionPublisher)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, mockProducer, logrus.New())
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	auctionService.SetClock(func() time.Time { return now })
+	auctionService.SetCloseLease(time.Minute)
+
+	won := &models.Package{PackageID: "won", Status: "Closing", Currency: "RUB", ClosingAt: now}
+	unsold := &models.Package{PackageID: "unsold", Status: "Closing", ClosingAt: now}
+	lost := &models.Package{PackageID: "lost", Status: "Closing", ClosingAt: now}
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return(won, nil).Once()
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return(unsold, nil).Once()
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return(lost, nil).Once()
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return((*models.Package)(nil), nil).Once()
+
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "won").Return(&models.Bid{UserID: "user-1", Amount: 700}, nil)
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "unsold").Return((*models.Bid)(nil), mongo.ErrNoDocuments)
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "lost").Return(&models.Bid{UserID: "user-2", Amount: 500}, nil)
+
+	mockPkgRepo.On("CompleteAuction", mock.Anything, mock.MatchedBy(func(p *models.Package) bool {
+		return p.PackageID == "won" && p.Status == "Finished" && p.UserID == "user-1" && p.Cost == 700
+	})).Return(true, nil)
+	mockPkgRepo.On("CompleteAuction", mock.Anything, mock.MatchedBy(func(p *models.Package) bool {
+		return p.PackageID == "unsold" && p.Status == "Auction-failed"
+	})).Return(true, nil)
+	// the claim on "lost" expired and another replica closed it
+	mockPkgRepo.On("CompleteAuction", mock.Anything, mock.MatchedBy(func(p *models.Package) bool {
+		return p.PackageID == "lost"
+	})).Return(false, nil)
+
+	mockProducer.On("PublishPayment", mock.Anything, mock.MatchedBy(func(r *models.AuctionResult) bool {
+		return r.PackageID == "won" && r.WinnerID == "user-1" && r.FinalPrice == 700
+	})).Return(nil).Once()
+	mockProducer.On("PublishNotification", mock.Anything, mock.Anything).Return(nil).Once()
+
+	closed, err := auctionService.CloseDueAuctions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 3, closed)
+	mockPkgRepo.AssertExpectations(t)
+	mockProducer.AssertExpectations(t)
+}
+
+func TestAuctionService_CloseDueAuctions_LeavesAuctionOnLookupError(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	mockProducer := new(MockAuctionPublisher)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, mockProducer, logrus.New())
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	auctionService.SetClock(func() time.Time { return now })
+	auctionService.SetCloseLease(time.Minute)
+
+	pkg := &models.Package{PackageID: "pkg-1", Status: "Closing", ClosingAt: now}
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return(pkg, nil).Once()
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return((*models.Package)(nil), nil).Once()
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return((*models.Bid)(nil), errors.New("connection reset"))
+
+	closed, err := auctionService.CloseDueAuctions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, closed)
+	mockPkgRepo.AssertNotCalled(t, "CompleteAuction", mock.Anything, mock.Anything)
+	mockProducer.AssertNotCalled(t, "PublishNotification", mock.Anything, mock.Anything)
+}
+
+func TestAuctionService_RecoverAuctions_SchedulesLegacyAuctions(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	auctionService.SetClock(func() time.Time { return now })
+	auctionService.SetAuctionDuration(2 * time.Minute)
+
+	started := now.Add(-time.Minute)
+	mockPkgRepo.On("FindByAuctioningStatus", mock.Anything).Return([]*models.Package{
+		{PackageID: "legacy", Status: "Auctioning", UpdatedAt: started},
+		{PackageID: "closed-meanwhile", Status: "Auctioning", UpdatedAt: started},
+		{PackageID: "scheduled", Status: "Auctioning", UpdatedAt: started, AuctionEndsAt: now.Add(time.Hour)},
+	}, nil)
+	mockPkgRepo.On("ScheduleLegacyAuction", mock.Anything, "legacy", started, started.Add(2*time.Minute)).Return(true, nil).Once()
+	// another replica closed it after the read; it is left alone
+	mockPkgRepo.On("ScheduleLegacyAuction", mock.Anything, "closed-meanwhile", started, started.Add(2*time.Minute)).Return(false, nil).Once()
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return((*models.Package)(nil), nil).Once()
+
+	assert.NoError(t, auctionService.RecoverAuctions(context.Background()))
+	mockPkgRepo.AssertExpectations(t)
+}
+
+func TestAuctionService_PlaceBid_AfterScheduledEnd(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{
+		Status:        "Auctioning",
+		UpdatedAt:     time.Now(),
+		AuctionEndsAt: time.Now().Add(-time.Second),
+	}, nil)
+
+	err := auctionService.PlaceBid(context.Background(), &models.Bid{PackageID: "pkg-1", UserID: "user1", Amount: 100})
+	assert.EqualError(t, err, "auction ended")
+	mockBidRepo.AssertNotCalled(t, "PlaceBid", mock.Anything, mock.Anything)
+}
+
+func TestAuctionService_PlaceBid_SoftClose(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	started := now.Add(-2 * time.Minute)
+	softClose := service.SoftClose{Window: 30 * time.Second, Extension: 20 * time.Second, Max: 30 * time.Second}
+
+	tests := []struct {
+		name    string
+		endsAt  time.Time
+		extends time.Time // zero when no extension is attempted
+		wantEnd time.Time
+	}{
+		{name: "bid before the window", endsAt: now.Add(time.Minute), wantEnd: now.Add(time.Minute)},
+		{name: "bid in the window", endsAt: now.Add(10 * time.Second), extends: now.Add(30 * time.Second), wantEnd: now.Add(30 * time.Second)},
+		{name: "capped by the maximum", endsAt: now.Add(20 * time.Second), extends: now.Add(30 * time.Second), wantEnd: now.Add(30 * time.Second)},
+		{name: "maximum reached", endsAt: now.Add(30 * time.Second), wantEnd: now.Add(30 * time.Second)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBidRepo := new(MockBidRepository)
+			mockPkgRepo := new(MockPackageRepository)
+			auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+			auctionService.SetClock(func() time.Time { return now })
+			auctionService.SetAuctionDuration(2 * time.Minute)
+			auctionService.SetSoftClose(softClose)
+
+			mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{
+				PackageID: "pkg-1", Status: "Auctioning", AuctionStartedAt: started, AuctionEndsAt: tt.endsAt,
+			}, nil)
+			mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return(&models.Bid{Amount: 50}, nil)
+			if !tt.extends.IsZero() {
+				mockPkgRepo.On("ExtendAuction", mock.Anything, "pkg-1", tt.extends).Return(true, nil).Once()
+			}
+			mockBidRepo.On("PlaceBid", mock.Anything, mock.MatchedBy(func(b *models.Bid) bool {
+				return b.AuctionEndsAt.Equal(tt.wantEnd)
+			})).Return(nil).Once()
+
+			err := auctionService.PlaceBid(context.Background(), &models.Bid{PackageID: "pkg-1", UserID: "user1", Amount: 100})
+			assert.NoError(t, err)
+			mockPkgRepo.AssertExpectations(t)
+			mockBidRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuctionService_PlaceBid_SoftCloseRace(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+	auctionService.SetClock(func() time.Time { return now })
+	auctionService.SetSoftClose(service.SoftClose{Window: 30 * time.Second, Extension: 20 * time.Second})
+
+	running := &models.Package{PackageID: "pkg-1", Status: "Auctioning", AuctionStartedAt: now.Add(-time.Minute), AuctionEndsAt: now.Add(5 * time.Second)}
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return(&models.Bid{Amount: 50}, nil)
+	mockPkgRepo.On("ExtendAuction", mock.Anything, "pkg-1", now.Add(25*time.Second)).Return(false, nil)
+
+	// the stored bid carries the end the auction has once it is stored
+	storedBids := 0
+	mockBidRepo.On("PlaceBid", mock.Anything, mock.MatchedBy(func(b *models.Bid) bool {
+		return b.AuctionEndsAt.Equal(now.Add(40 * time.Second))
+	})).Run(func(mock.Arguments) { storedBids++ }).Return(nil)
+
+	// the scheduler claimed the close in between; the bid is turned away
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(running, nil).Once()
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{PackageID: "pkg-1", Status: "Closing"}, nil).Once()
+	err := auctionService.PlaceBid(context.Background(), &models.Bid{PackageID: "pkg-1", UserID: "user1", Amount: 100})
+	assert.EqualError(t, err, "auction ended")
+	assert.Equal(t, 0, storedBids)
+
+	// another bid extended it further first
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(running, nil).Once()
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{PackageID: "pkg-1", Status: "Auctioning", AuctionEndsAt: now.Add(40 * time.Second)}, nil).Once()
+	bid := &models.Bid{PackageID: "pkg-1", UserID: "user2", Amount: 110}
+	err = auctionService.PlaceBid(context.Background(), bid)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(40*time.Second), bid.AuctionEndsAt)
+	assert.Equal(t, 1, storedBids)
+}
+
+func TestAuctionService_PlaceBid_FailedInsert(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+	auctionService.SetClock(func() time.Time { return now })
+	auctionService.SetSoftClose(service.SoftClose{Window: 30 * time.Second, Extension: 20 * time.Second})
+
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{
+		PackageID: "pkg-1", Status: "Auctioning", AuctionStartedAt: now.Add(-time.Minute), AuctionEndsAt: now.Add(5 * time.Second),
+	}, nil)
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return(&models.Bid{Amount: 50}, nil)
+	mockPkgRepo.On("ExtendAuction", mock.Anything, "pkg-1", now.Add(25*time.Second)).Return(true, nil)
+	mockBidRepo.On("PlaceBid", mock.Anything, mock.Anything).Return(errors.New("write conflict"))
+
+	err := auctionService.PlaceBid(context.Background(), &models.Bid{PackageID: "pkg-1", UserID: "user1", Amount: 100})
+
+	assert.EqualError(t, err, "write conflict")
+	mockBidRepo.AssertNumberOfCalls(t, "PlaceBid", 1)
+}
+
+func TestAuctionService_PlaceBid_Terms(t *testing.T) {
+	terms := models.AuctionTerms{StartingPrice: 500, ReservePrice: 800, MinIncrement: 10, MinIncrementPercent: 5}
+
+	tests := []struct {
+		name    string
+		top     *models.Bid
+		amount  float64
+		wantErr string
+	}{
+		{name: "below the starting price", amount: 0.01, wantErr: "bid must be at least the starting price of 500.00"},
+		{name: "at the starting price", amount: 500},
+		{name: "under the absolute increment", top: &models.Bid{Amount: 100}, amount: 109.99, wantErr: "bid must be at least 110.00"},
+		{name: "under the percent increment", top: &models.Bid{Amount: 600}, amount: 620, wantErr: "bid must be at least 630.00"},
+		{name: "meets the increment", top: &models.Bid{Amount: 600}, amount: 630},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockBidRepo := new(MockBidRepository)
+			mockPkgRepo := new(MockPackageRepository)
+			auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+
+			mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{
+				PackageID: "pkg-1", Status: "Auctioning", UpdatedAt: time.Now(), AuctionTerms: terms,
+			}, nil)
+			if tt.top != nil {
+				mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return(tt.top, nil)
+			} else {
+				mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return((*models.Bid)(nil), mongo.ErrNoDocuments)
+			}
+			if tt.wantErr == "" {
+				mockBidRepo.On("PlaceBid", mock.Anything, mock.Anything).Return(nil).Once()
+			}
+
+			err := auctionService.PlaceBid(context.Background(), &models.Bid{PackageID: "pkg-1", UserID: "user1", Amount: tt.amount})
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockBidRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuctionService_PlaceBid_TopBidLookupFails(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, new(MockAuctionPublisher), logrus.New())
+
+	mockPkgRepo.On("FindByID", mock.Anything, "pkg-1").Return(&models.Package{
+		PackageID: "pkg-1", Status: "Auctioning", UpdatedAt: time.Now(),
+		AuctionTerms: models.AuctionTerms{StartingPrice: 500},
+	}, nil)
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return((*models.Bid)(nil), errors.New("connection reset"))
+
+	err := auctionService.PlaceBid(context.Background(), &models.Bid{PackageID: "pkg-1", UserID: "user1", Amount: 600})
+
+	assert.EqualError(t, err, "connection reset")
+	mockBidRepo.AssertNotCalled(t, "PlaceBid", mock.Anything, mock.Anything)
+}
+
+func TestAuctionService_CloseDueAuctions_BelowReserve(t *testing.T) {
+	mockBidRepo := new(MockBidRepository)
+	mockPkgRepo := new(MockPackageRepository)
+	mockProducer := new(MockAuctionPublisher)
+	auctionService := service.NewAuctionService(mockBidRepo, mockPkgRepo, mockProducer, logrus.New())
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	auctionService.SetClock(func() time.Time { return now })
+
+	pkg := &models.Package{PackageID: "pkg-1", Status: "Closing", Cost: 1000, ClosingAt: now, AuctionTerms: models.AuctionTerms{ReservePrice: 800}}
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return(pkg, nil).Once()
+	mockPkgRepo.On("ClaimDueAuction", mock.Anything, now, time.Minute).Return((*models.Package)(nil), nil).Once()
+	mockBidRepo.On("GetTopBidByPackage", mock.Anything, "pkg-1").Return(&models.Bid{UserID: "user-1", Amount: 799}, nil)
+	mockPkgRepo.On("CompleteAuction", mock.Anything, mock.MatchedBy(func(p *models.Package) bool {
+		return p.Status == "Auction-failed" && p.UserID == "" && p.Cost == 1000
+	})).Return(true, nil)
+
+	closed, err := auctionService.CloseDueAuctions(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, closed)
+	mockPkgRepo.AssertExpectations(t)
+	mockProducer.AssertNotCalled(t, "PublishPayment", mock.Anything, mock.Anything)
+}
@@ -86,6 +86,7 @@ func createMainServer(h *handler.AuthHandler, logger *logrus.Logger) *http.Serve
 	mux := http.NewServeMux()
 	mux.HandleFunc("POST /register", h.Register)
 	mux.HandleFunc("POST /register/moderator", h.RegisterModerator)
+	mux.HandleFunc("POST /register/courier", h.RegisterCourier)
 	mux.HandleFunc("POST /login", h.Login)
 	loggedMux := middleware.NewLogMiddleware(mux, logger)
 
@@ -108,6 +109,7 @@ func createProtectedServer(svc *service.AuthService, repo repository.UserReposit
 		handler.RespondJSON(w, http.StatusOK, map[string]string{
 			"status":  "ok",
 			"user_id": r.Context().Value(middleware.UserIDKey).(string),
+			"role":    r.Context().Value(middleware.RoleKey).(string),
 		})
 	})
 
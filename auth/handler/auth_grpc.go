@@ -44,6 +44,18 @@ func (s *AuthGRPCServer) RegisterModerator(ctx context.Context, req *authpb.Regi
 	}, nil
 }
 
+func (s *AuthGRPCServer) RegisterCourier(ctx context.Context, req *authpb.RegisterRequest) (*authpb.AuthResponse, error) {
+	user, token, err := s.service.RegisterCourier(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &authpb.AuthResponse{
+		UserId: user.ID,
+		Token:  token,
+		Role:   user.Role,
+	}, nil
+}
+
 func (s *AuthGRPCServer) Login(ctx context.Context, req *authpb.LoginRequest) (*authpb.AuthResponse, error) {
 	user, token, err := s.service.Login(ctx, req.Email, req.Password)
 	if err != nil {
@@ -64,9 +76,11 @@ func (s *AuthGRPCServer) Validate(ctx context.Context, req *authpb.ValidateReque
 		}, nil
 	}
 
+	role, _ := middleware.RoleFromContext(ctx)
 	return &authpb.ValidateResponse{
 		Valid:  "ok",
 		UserId: userID,
+		Role:   role,
 	}, nil
 }
 
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"regexp"
@@ -25,53 +26,18 @@ func NewAuthHandler(service *service.AuthService, rep repository.Telegramer) *Au
 }
 
 func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
-	defer func(start time.Time) {
-		duration := time.Since(start).Seconds()
-		metrics.HTTPResponseTime.WithLabelValues(
-			r.Method,
-			r.URL.Path,
-			strconv.Itoa(w.(*middleware.LoggingResponseWriter).Status),
-		).Observe(duration)
-	}(time.Now())
-
-	var req struct {
-		Email    string `json:"email"`
-		Password string `json:"password"`
-	}
-
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		RespondError(w, http.StatusBadRequest, "invalid request")
-		return
-	}
-
-	if req.Email == "" || req.Password == "" {
-		RespondError(w, http.StatusBadRequest, "email and password are required")
-		return
-	}
-
-	if !validateEmail(req.Email) {
-		RespondError(w, http.StatusBadRequest, "invalid email format")
-		return
-	}
-
-	if len(req.Password) < 3 {
-		RespondError(w, http.StatusBadRequest, "password must be at least 3 characters")
-		return
-	}
+	h.register(w, r, h.service.Register)
+}
 
-	user, token, err := h.service.Register(r.Context(), req.Email, req.Password)
-	if err != nil {
-		RespondError(w, getStatusCode(err), err.Error())
-		return
-	}
+func (h *AuthHandler) RegisterModerator(w http.ResponseWriter, r *http.Request) {
+	h.register(w, r, h.service.RegisterModerator)
+}
 
-	RespondJSON(w, http.StatusCreated, map[string]any{
-		"user":  user,
-		"token": token,
-	})
+func (h *AuthHandler) RegisterCourier(w http.ResponseWriter, r *http.Request) {
+	h.register(w, r, h.service.RegisterCourier)
 }
 
-func (h *AuthHandler) RegisterModerator(w http.ResponseWriter, r *http.Request) {
+func (h *AuthHandler) register(w http.ResponseWriter, r *http.Request, registerFn func(ctx context.Context, email, password string) (*models.User, string, error)) {
 	defer func(start time.Time) {
 		duration := time.Since(start).Seconds()
 		metrics.HTTPResponseTime.WithLabelValues(
@@ -106,7 +72,7 @@ func (h *AuthHandler) RegisterModerator(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	user, token, err := h.service.RegisterModerator(r.Context(), req.Email, req.Password)
+	user, token, err := registerFn(r.Context(), req.Email, req.Password)
 	if err != nil {
 		RespondError(w, getStatusCode(err), err.Error())
 		return
@@ -14,7 +14,10 @@ import (
 
 type contextKey string
 
-const userIDContextKey = contextKey("userID")
+const (
+	userIDContextKey = contextKey("userID")
+	roleContextKey   = contextKey("role")
+)
 
 type AuthInterceptor struct {
 	authService   *service.AuthService
@@ -27,6 +30,7 @@ func NewAuthInterceptor(authService *service.AuthService) *AuthInterceptor {
 		publicMethods: map[string]bool{
 			"/auth.AuthService/Register":              true,
 			"/auth.AuthService/RegisterModerator":     true,
+			"/auth.AuthService/RegisterCourier":       true,
 			"/auth.AuthService/Login":                 true,
 			"/auth.AuthService/GetUserByTelegramCode": true,
 			"/auth.AuthService/GenerateTelegramCode":  true,
@@ -78,6 +82,7 @@ func (a *AuthInterceptor) authorize(ctx context.Context, method string) (context
 	metrics.ValidateSuccessTotal.WithLabelValues(method).Inc()
 
 	newCtx := context.WithValue(ctx, userIDContextKey, claims.UserID)
+	newCtx = context.WithValue(newCtx, roleContextKey, claims.Role)
 	return newCtx, nil
 }
 
@@ -85,3 +90,8 @@ func UserIDFromContext(ctx context.Context) (string, bool) {
 	userID, ok := ctx.Value(userIDContextKey).(string)
 	return userID, ok
 }
+
+func RoleFromContext(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(roleContextKey).(string)
+	return role, ok
+}
@@ -12,7 +12,10 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-const UserIDKey = "user_id"
+const (
+	UserIDKey = "user_id"
+	RoleKey   = "role"
+)
 
 func JWTAuth(svc *service.AuthService, logger *logrus.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -46,6 +49,7 @@ func JWTAuth(svc *service.AuthService, logger *logrus.Logger) func(http.Handler)
 
 			metrics.ValidateSuccessTotal.WithLabelValues(r.Method).Inc()
 			ctx := context.WithValue(r.Context(), UserIDKey, claims.UserID)
+			ctx = context.WithValue(ctx, RoleKey, claims.Role)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
@@ -5,6 +5,12 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	RoleUser      = "user"
+	RoleModerator = "moderator"
+	RoleCourier   = "courier"
+)
+
 type User struct {
 	ID                string `bson:"user_id" json:"user_id"`
 	Email             string `bson:"email" json:"email"`
@@ -14,6 +20,7 @@ type User struct {
 
 type JWTClaims struct {
 	UserID string `json:"user_id"`
+	Role   string `json:"role"`
 	jwt.RegisteredClaims
 }
 
@@ -26,31 +26,18 @@ func NewAuthService(userRepo repository.UserRepository, tgRepo repository.Telegr
 }
 
 func (s *AuthService) Register(ctx context.Context, email, password string) (*models.User, string, error) {
-	existing, _ := s.repo.GetByEmail(ctx, email)
-	if existing != nil {
-		return nil, "", models.ErrEmailAlreadyExists
-	}
-
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, "", err
-	}
+	return s.register(ctx, email, password, models.RoleUser)
+}
 
-	user := &models.User{
-		Email:             email,
-		EncryptedPassword: string(hashedPassword),
-	}
-	user.GenerateUserID()
-	user.Role = "user"
-	if err = s.repo.CreateUser(ctx, user); err != nil {
-		return nil, "", err
-	}
+func (s *AuthService) RegisterModerator(ctx context.Context, email, password string) (*models.User, string, error) {
+	return s.register(ctx, email, password, models.RoleModerator)
+}
 
-	token, err := s.GenerateToken(user)
-	return user, token, err
+func (s *AuthService) RegisterCourier(ctx context.Context, email, password string) (*models.User, string, error) {
+	return s.register(ctx, email, password, models.RoleCourier)
 }
 
-func (s *AuthService) RegisterModerator(ctx context.Context, email, password string) (*models.User, string, error) {
+func (s *AuthService) register(ctx context.Context, email, password, role string) (*models.User, string, error) {
 	existing, _ := s.repo.GetByEmail(ctx, email)
 	if existing != nil {
 		return nil, "", models.ErrEmailAlreadyExists
@@ -66,7 +53,7 @@ func (s *AuthService) RegisterModerator(ctx context.Context, email, password str
 		EncryptedPassword: string(hashedPassword),
 	}
 	user.GenerateUserID()
-	user.Role = "moderator"
+	user.Role = role
 	if err = s.repo.CreateUser(ctx, user); err != nil {
 		return nil, "", err
 	}
@@ -78,6 +65,7 @@ func (s *AuthService) RegisterModerator(ctx context.Context, email, password str
 func (s *AuthService) GenerateToken(user *models.User) (string, error) {
 	claims := &models.JWTClaims{
 		UserID: user.ID,
+		Role:   user.Role,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
 		},
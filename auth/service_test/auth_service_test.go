@@ -241,3 +241,23 @@ func TestAuthService_Login(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthService_RegisterCourier(t *testing.T) {
+	mockUserRepo := new(MockUserRepository)
+	mockTgRepo := new(MockTelegramer)
+	authService := service.NewAuthService(mockUserRepo, mockTgRepo, "test-secret")
+
+	mockUserRepo.On("GetByEmail", mock.Anything, "courier@example.com").Return(nil, errors.New("user not found"))
+	mockUserRepo.On("CreateUser", mock.Anything, mock.AnythingOfType("*models.User")).Return(nil)
+
+	user, token, err := authService.RegisterCourier(context.Background(), "courier@example.com", "password123")
+	assert.NoError(t, err)
+	assert.Equal(t, models.RoleCourier, user.Role)
+
+	claims, err := authService.ValidateToken(token)
+	assert.NoError(t, err)
+	assert.Equal(t, user.ID, claims.UserID)
+	assert.Equal(t, models.RoleCourier, claims.Role)
+
+	mockUserRepo.AssertExpectations(t)
+}
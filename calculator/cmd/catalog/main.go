@@ -0,0 +1,150 @@
+// Command catalog exports, checks and rolls out the calculator's tariffs and
+// cities.
+//
+//	catalog export   [-format yaml|csv] [-out path]
+//	catalog validate -file path
+//	catalog diff     -file path [-prune]
+//	catalog apply    -file path [-prune] [-actor name]
+//
+// A catalog is a YAML file, or a directory with tariffs.csv and/or
+// cities.csv. diff is a dry run of apply; apply writes every change in one
+// transaction, so MongoDB has to run as a replica set.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/maksroxx/DeliveryService/calculator/configs"
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const usage = `usage: catalog <command> [flags]
+
+commands:
+  export    write the live tariffs and cities to YAML or CSV
+  validate  check a catalog without touching the database
+  diff      show what apply would change
+  apply     apply a catalog in one transaction
+`
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	cmd, args := os.Args[1], os.Args[2:]
+
+	fs := flag.NewFlagSet(cmd, flag.ExitOnError)
+	file := fs.String("file", "", "catalog YAML file or directory of CSVs")
+	format := fs.String("format", "yaml", "export format: yaml or csv")
+	out := fs.String("out", "", "export destination; a directory for csv, stdout for yaml by default")
+	prune := fs.Bool("prune", false, "delete tariffs and cities missing from the catalog")
+	actor := fs.String("actor", os.Getenv("USER"), "who the tariff changes are recorded against")
+
+	switch cmd {
+	case "export":
+		fs.Parse(args)
+		export(*format, *out)
+	case "validate":
+		fs.Parse(args)
+		catalog := load(*file)
+		if err := service.ValidateCatalog(catalog); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("%s: %d tariffs, %d cities, ok\n", *file, len(catalog.Tariffs), len(catalog.Cities))
+	case "diff":
+		fs.Parse(args)
+		catalog := load(*file)
+		changes, err := manager().Diff(context.Background(), catalog, *prune)
+		if err != nil {
+			log.Fatal(err)
+		}
+		printChanges(changes)
+	case "apply":
+		fs.Parse(args)
+		catalog := load(*file)
+		ctx := service.WithActor(context.Background(), *actor)
+		changes, err := manager().Apply(ctx, catalog, *prune)
+		if err != nil {
+			log.Fatalf("Nothing applied: %v", err)
+		}
+		printChanges(changes)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+func load(path string) models.Catalog {
+	if path == "" {
+		log.Fatal("-file is required")
+	}
+	catalog, err := service.LoadCatalog(path)
+	if err != nil {
+		log.Fatalf("Failed to read catalog: %v", err)
+	}
+	return catalog
+}
+
+func export(format, out string) {
+	catalog, err := manager().Export(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	switch format {
+	case "yaml":
+		w := os.Stdout
+		if out != "" {
+			if w, err = os.Create(out); err != nil {
+				log.Fatal(err)
+			}
+			defer w.Close()
+		}
+		err = service.WriteCatalogYAML(w, catalog)
+	case "csv":
+		if out == "" {
+			log.Fatal("-out directory is required for csv")
+		}
+		err = service.SaveCatalogCSV(out, catalog)
+	default:
+		log.Fatalf("unknown format %q", format)
+	}
+	if err != nil {
+		log.Fatalf("Failed to export catalog: %v", err)
+	}
+}
+
+func printChanges(changes []models.CatalogChange) {
+	if len(changes) == 0 {
+		fmt.Println("no changes")
+		return
+	}
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+	fmt.Printf("%d changes\n", len(changes))
+}
+
+func manager() *service.CatalogManager {
+	cfg := configs.Load()
+	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(cfg.Database.MongoDB.URI))
+	if err != nil {
+		log.Fatalf("Failed to connect to MongoDB: %v", err)
+	}
+	db := client.Database(cfg.Database.MongoDB.Database)
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: repository.NewCityMongoRepository(db, "countries"),
+		Tariffs:   repository.NewTariffMongoRepository(db, "tariffs"),
+		Audit:     repository.NewTariffAuditMongoRepository(db, "tariff_audit"),
+	})
+	return service.NewCatalogManager(calc, repository.NewMongoTransactor(client))
+}
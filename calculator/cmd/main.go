@@ -4,12 +4,14 @@ import (
 	"context"
 	"log"
 	"net/http"
+	_ "time/tzdata"
 
 	"github.com/maksroxx/DeliveryService/calculator/configs"
 	"github.com/maksroxx/DeliveryService/calculator/internal/middleware"
 	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
 	"github.com/maksroxx/DeliveryService/calculator/internal/service"
 	"github.com/maksroxx/DeliveryService/calculator/internal/transport"
+	"github.com/maksroxx/DeliveryService/calculator/models"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -18,6 +20,9 @@ import (
 
 func main() {
 	cfg := configs.Load()
+	if err := cfg.Quotes.Validate(); err != nil {
+		log.Fatalf("Invalid quote config: %v", err)
+	}
 	mongoCfg := cfg.Database.MongoDB
 	clientOptions := options.Client().ApplyURI(mongoCfg.URI)
 	client, err := mongo.Connect(context.Background(), clientOptions)
@@ -32,37 +37,102 @@ func main() {
 	db := client.Database(mongoCfg.Database)
 	repo := repository.NewCityMongoRepository(db, "countries")
 	tariffRepo := repository.NewTariffMongoRepository(db, "tariffs")
+	hubRepo := repository.NewHubMongoRepository(db, "hubs", "hub_links")
+	surchargeRepo := repository.NewSurchargeMongoRepository(db, "surcharge_rules")
+	zoneRepo := repository.NewZoneMongoRepository(db, "zone_rates")
+	exchangeRepo := repository.NewExchangeMongoRepository(db, "exchange_rates")
+	fuelRepo := repository.NewFuelIndexMongoRepository(db, "fuel_index")
+	promoRepo := repository.NewPromoMongoRepository(db, "promotions")
+	auditRepo := repository.NewTariffAuditMongoRepository(db, "tariff_audit")
+	if cfg.Exchange.File != "" {
+		rates, err := service.LoadExchangeRates(cfg.Exchange.File)
+		if err != nil {
+			log.Fatalf("Failed to load exchange rates: %v", err)
+		}
+		if err := exchangeRepo.SetRates(context.Background(), rates); err != nil {
+			log.Fatalf("Failed to store exchange rates: %v", err)
+		}
+	}
+	if err := surchargeRepo.SeedDefaults(context.Background(), service.DefaultSurchargeRules()); err != nil {
+		log.Fatalf("Failed to seed surcharge rules: %v", err)
+	}
+	if err := repo.EnsureSearchKeys(context.Background()); err != nil {
+		log.Fatalf("Failed to index city names: %v", err)
+	}
 	log := logrus.New()
 	chain := middleware.NewChain(
 		middleware.NewMetricsMiddleware(),
 		middleware.NewLogMiddleware(log),
 	)
 
-	svc := service.NewExtendedCalculator(repo, tariffRepo)
+	router := service.NewHubRouter(hubRepo, repo)
+	deps := service.CalculatorDeps{
+		Countries:  repo,
+		Tariffs:    tariffRepo,
+		Router:     router,
+		Surcharges: surchargeRepo,
+		Zones:      zoneRepo,
+		Rates:      exchangeRepo,
+		Promos:     promoRepo,
+		Audit:      auditRepo,
+		Fuel:       fuelRepo,
+	}
+	if cfg.Roads.File != "" {
+		roads, err := service.LoadRoadGraph(cfg.Roads.File)
+		if err != nil {
+			log.Fatalf("Failed to load road graph: %v", err)
+		}
+		deps.Distances = map[string]service.DistanceProvider{
+			models.DistanceRoad: service.NewRoadGraphProvider(roads),
+		}
+	}
+	if cfg.Calendar.Hours != "" {
+		hours, err := service.LoadWorkingHours(cfg.Calendar.Hours)
+		if err != nil {
+			log.Fatalf("Failed to load working hours: %v", err)
+		}
+		var holidays []models.Holiday
+		if cfg.Calendar.Holidays != "" {
+			if holidays, err = service.LoadHolidays(cfg.Calendar.Holidays); err != nil {
+				log.Fatalf("Failed to load holidays: %v", err)
+			}
+		}
+		deps.Calendar = service.NewCalendar(hours, holidays)
+	}
+	svc := service.NewExtendedCalculator(deps)
+	quotes := service.NewQuoteSigner(cfg.Quotes.Secret, cfg.Quotes.TTL)
+	optimizer := service.NewRouteOptimizer(repo)
+	promotions := service.NewPromotions(promoRepo)
 	go func() {
-		if err := transport.StartGRPCServer(cfg.GRPCPort, svc, log); err != nil {
+		if err := transport.StartGRPCServer(cfg.GRPCPort, transport.ServerDeps{
+			Calculator: svc,
+			Quotes:     quotes,
+			Optimizer:  optimizer,
+			Hubs:       hubRepo,
+			Router:     router,
+			Surcharges: surchargeRepo,
+			Zones:      zoneRepo,
+			Rates:      exchangeRepo,
+			Fuel:       fuelRepo,
+			Promotions: promotions,
+			Promos:     promoRepo,
+			Cities:     repo,
+			Logger:     log,
+		}); err != nil {
 			log.Fatalf("gRPC server failed: %v", err)
 		}
 	}()
 
 	http.Handle("/metrics", promhttp.Handler())
-	startHTTPServer(cfg.HTTPPort, svc, chain, log, tariffRepo)
+	startHTTPServer(cfg.HTTPPort, svc, chain, log)
 }
 
-func startHTTPServer(port string, calc service.Calculator, chain *middleware.Chain, log *logrus.Logger, rep repository.TariffRepository) {
-	handler := transport.NewHTTPHandler(calc, rep)
+func startHTTPServer(port string, calc service.Calculator, chain *middleware.Chain, log *logrus.Logger) {
+	handler := transport.NewHTTPHandler(calc)
 
 	http.HandleFunc("/calculate", func(w http.ResponseWriter, r *http.Request) {
 		chain.Then(http.HandlerFunc(handler.HandleCalculate)).ServeHTTP(w, r)
 	})
-	http.HandleFunc("/tariff", func(w http.ResponseWriter, r *http.Request) {
-		switch r.Method {
-		case http.MethodPost:
-			chain.Then(http.HandlerFunc(handler.CreateTariff)).ServeHTTP(w, r)
-		case http.MethodDelete:
-			chain.Then(http.HandlerFunc(handler.DeleteTariff)).ServeHTTP(w, r)
-		}
-	})
 	log.Infof("HTTP server listening on :%s", port)
 	if err := http.ListenAndServe(":"+port, nil); err != nil {
 		log.Fatalf("HTTP server failed: %v", err)
@@ -1,8 +1,11 @@
 package configs
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -11,6 +14,51 @@ type Config struct {
 	Database DatabaseConfig `yaml:"database"`
 	HTTPPort string         `yaml:"http_port"`
 	GRPCPort string         `yaml:"grpc_port"`
+	Quotes   QuoteConfig    `yaml:"quotes"`
+	Exchange ExchangeConfig `yaml:"exchange_rates"`
+	Roads    RoadConfig     `yaml:"road_graph"`
+	Calendar CalendarConfig `yaml:"calendar"`
+}
+
+// ExchangeConfig points at a CSV of rates loaded on start-up; a relative
+// path is resolved against the config file's directory.
+type ExchangeConfig struct {
+	File string `yaml:"file"`
+}
+
+// RoadConfig points at a CSV edge list of road distances between cities
+// used by tariffs with the "road" distance provider.
+type RoadConfig struct {
+	File string `yaml:"file"`
+}
+
+// CalendarConfig points at the CSVs of working hours and public holidays
+// delivery windows are planned around. Without working hours quotes carry
+// no delivery window.
+type CalendarConfig struct {
+	Hours    string `yaml:"working_hours"`
+	Holidays string `yaml:"holidays"`
+}
+
+// QuoteConfig signs quote tokens. The secret comes from the QUOTE_SECRET
+// environment variable when it is set, so it needn't live in the file.
+type QuoteConfig struct {
+	Secret string        `yaml:"secret"`
+	TTL    time.Duration `yaml:"ttl"`
+}
+
+const placeholderQuoteSecret = "change-me-quote-secret"
+
+// Validate refuses an unset secret and the placeholder once shipped in
+// config.yaml: anyone who knows it can mint quotes at any price.
+func (c QuoteConfig) Validate() error {
+	switch c.Secret {
+	case "":
+		return errors.New("quote secret is not set: set QUOTE_SECRET")
+	case placeholderQuoteSecret:
+		return errors.New("quote secret is still the placeholder: set QUOTE_SECRET")
+	}
+	return nil
 }
 
 type DatabaseConfig struct {
@@ -39,5 +87,20 @@ func Load() *Config {
 		panic(fmt.Sprintf("Error parsing config: %v", err))
 	}
 
+	if secret := os.Getenv("QUOTE_SECRET"); secret != "" {
+		cfg.Quotes.Secret = secret
+	}
+	if cfg.Exchange.File != "" && !filepath.IsAbs(cfg.Exchange.File) {
+		cfg.Exchange.File = filepath.Join(filepath.Dir(configPath), cfg.Exchange.File)
+	}
+	if cfg.Roads.File != "" && !filepath.IsAbs(cfg.Roads.File) {
+		cfg.Roads.File = filepath.Join(filepath.Dir(configPath), cfg.Roads.File)
+	}
+	for _, file := range []*string{&cfg.Calendar.Hours, &cfg.Calendar.Holidays} {
+		if *file != "" && !filepath.IsAbs(*file) {
+			*file = filepath.Join(filepath.Dir(configPath), *file)
+		}
+	}
+
 	return &cfg
 }
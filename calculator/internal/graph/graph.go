@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+)
+
+var ErrNoPath = errors.New("no path between nodes")
+
+type Edge struct {
+	To     string
+	Weight float64
+}
+
+type Graph struct {
+	adj map[string][]Edge
+}
+
+func New() *Graph {
+	return &Graph{adj: make(map[string][]Edge)}
+}
+
+func (g *Graph) AddNode(id string) {
+	if _, ok := g.adj[id]; !ok {
+		g.adj[id] = nil
+	}
+}
+
+func (g *Graph) AddEdge(from, to string, weight float64) {
+	g.AddNode(to)
+	g.adj[from] = append(g.adj[from], Edge{To: to, Weight: weight})
+}
+
+func (g *Graph) HasNode(id string) bool {
+	_, ok := g.adj[id]
+	return ok
+}
+
+func (g *Graph) Len() int {
+	return len(g.adj)
+}
+
+// ShortestPath runs Dijkstra, or A* when a heuristic is given. The heuristic
+// must never overestimate the remaining weight to target.
+func (g *Graph) ShortestPath(from, to string, heuristic func(node string) float64) ([]string, float64, error) {
+	if !g.HasNode(from) || !g.HasNode(to) {
+		return nil, 0, ErrNoPath
+	}
+	if heuristic == nil {
+		heuristic = func(string) float64 { return 0 }
+	}
+
+	dist := map[string]float64{from: 0}
+	prev := make(map[string]string)
+	done := make(map[string]bool)
+	pq := &queue{{node: from, priority: heuristic(from)}}
+
+	for pq.Len() > 0 {
+		cur := heap.Pop(pq).(item).node
+		if done[cur] {
+			continue
+		}
+		if cur == to {
+			return buildPath(prev, from, to), dist[to], nil
+		}
+		done[cur] = true
+
+		for _, e := range g.adj[cur] {
+			if done[e.To] {
+				continue
+			}
+			d := dist[cur] + e.Weight
+			if old, ok := dist[e.To]; !ok || d < old {
+				dist[e.To] = d
+				prev[e.To] = cur
+				heap.Push(pq, item{node: e.To, priority: d + heuristic(e.To)})
+			}
+		}
+	}
+	return nil, math.Inf(1), ErrNoPath
+}
+
+func buildPath(prev map[string]string, from, to string) []string {
+	path := []string{to}
+	for cur := to; cur != from; {
+		cur = prev[cur]
+		path = append(path, cur)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+type item struct {
+	node     string
+	priority float64
+}
+
+type queue []item
+
+func (q queue) Len() int           { return len(q) }
+func (q queue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q queue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x any)        { *q = append(*q, x.(item)) }
+func (q *queue) Pop() any {
+	old := *q
+	n := len(old)
+	it := old[n-1]
+	*q = old[:n-1]
+	return it
+}
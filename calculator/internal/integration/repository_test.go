@@ -3,6 +3,7 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
 	"github.com/maksroxx/DeliveryService/calculator/models"
@@ -83,7 +84,7 @@ func TestMongoCityRepo_GetCoordinates(t *testing.T) {
 			if tt.expectedError {
 				assert.Error(t, err)
 				assert.Nil(t, coordinates)
-				assert.Contains(t, err.Error(), "country not found in DB")
+				assert.ErrorIs(t, err, models.ErrCityNotFound)
 			} else {
 				assert.NoError(t, err)
 				assert.NotNil(t, coordinates)
@@ -96,6 +97,75 @@ func TestMongoCityRepo_GetCoordinates(t *testing.T) {
 	}
 }
 
+func TestMongoCityRepo_SearchAndNearest(t *testing.T) {
+	ctx, db, cleanup := setupCalculatorTestEnvironment(t)
+	defer cleanup()
+
+	cities := []interface{}{
+		bson.M{"name": "Moscow", "location": bson.M{"type": "Point", "coordinates": []float64{37.6173, 55.7558}}},
+		bson.M{"name": "Mozhaysk", "location": bson.M{"type": "Point", "coordinates": []float64{36.0275, 55.5069}}},
+		bson.M{"name": "Kazan", "location": bson.M{"type": "Point", "coordinates": []float64{49.1221, 55.7887}}},
+	}
+	_, err := db.Collection("countries").InsertMany(ctx, cities)
+	assert.NoError(t, err)
+
+	repo := repository.NewCityMongoRepository(db, "countries")
+	assert.NoError(t, repo.EnsureSearchKeys(ctx))
+
+	found, err := repo.Search(ctx, "mo", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 2)
+	assert.Equal(t, "Moscow", found[0].Name)
+
+	found, err = repo.Search(ctx, "m.*", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, found)
+
+	_, err = repo.SetAliases(ctx, "moscow", []string{"Москва"})
+	assert.NoError(t, err)
+	found, err = repo.Search(ctx, "Моск", 10)
+	assert.NoError(t, err)
+	assert.Len(t, found, 1)
+	assert.Equal(t, "Moscow", found[0].Name)
+
+	coords, err := repo.GetCoordinates(ctx, "москва")
+	assert.NoError(t, err)
+	assert.Equal(t, "Moscow", coords.Name)
+
+	nearest, err := repo.Nearest(ctx, 55.75, 37.6, 2)
+	assert.NoError(t, err)
+	assert.Len(t, nearest, 2)
+	assert.Equal(t, "Moscow", nearest[0].Name)
+	assert.Equal(t, "Mozhaysk", nearest[1].Name)
+	assert.Less(t, nearest[0].DistanceKm, 2.0)
+}
+
+func TestMongoCityRepo_UpsertAndDelete(t *testing.T) {
+	ctx, db, cleanup := setupCalculatorTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewCityMongoRepository(db, "countries")
+	moscow := models.CountryCoordinates{Name: "Moscow", Code: "MSK", Latitude: 55.7558, Longitude: 37.6173, Aliases: []string{"Москва"}}
+	assert.NoError(t, repo.Upsert(ctx, moscow))
+	assert.NoError(t, repo.Upsert(ctx, models.CountryCoordinates{Name: "Kazan", Latitude: 55.7887, Longitude: 49.1221}))
+
+	moscow.Zone = "RU-C"
+	assert.NoError(t, repo.Upsert(ctx, moscow))
+
+	cities, err := repo.GetAll(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, cities, 2)
+	assert.Equal(t, "Kazan", cities[0].Name)
+	assert.Equal(t, moscow, cities[1])
+
+	coords, err := repo.GetCoordinates(ctx, "москва")
+	assert.NoError(t, err)
+	assert.Equal(t, "RU-C", coords.Zone)
+
+	assert.NoError(t, repo.Delete(ctx, "Kazan"))
+	assert.ErrorIs(t, repo.Delete(ctx, "Kazan"), models.ErrCityNotFound)
+}
+
 func TestMongoTariffRepo_GetAll(t *testing.T) {
 	ctx, db, cleanup := setupCalculatorTestEnvironment(t)
 	defer cleanup()
@@ -181,7 +251,7 @@ func TestMongoTariffRepo_GetByCode(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := repo.GetByCode(ctx, tt.code)
+			result, err := repo.GetByCode(ctx, tt.code, time.Now())
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -219,13 +289,62 @@ func TestMongoTariffRepo_CreateTariff(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, "STANDARD", result.Code)
 
-	created, err := repo.GetByCode(ctx, "STANDARD")
+	created, err := repo.GetByCode(ctx, "STANDARD", time.Now())
 	assert.NoError(t, err)
 	assert.NotNil(t, created)
 	assert.Equal(t, "Standard Delivery", created.Name)
 	assert.Equal(t, 10.0, created.BaseRate)
 }
 
+func TestMongoTariffRepo_ScheduledVersion(t *testing.T) {
+	ctx, db, cleanup := setupCalculatorTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewTariffMongoRepository(db, "tariffs")
+
+	current := &models.Tariff{
+		Code:              "STANDARD",
+		Name:              "Standard Delivery",
+		BaseRate:          10.0,
+		PricePerKm:        0.5,
+		PricePerKg:        1.0,
+		Currency:          "USD",
+		VolumetricDivider: 5000,
+		SpeedKmph:         60,
+	}
+	_, err := repo.CreateTariff(ctx, current)
+	assert.NoError(t, err)
+
+	switchAt := time.Now().Add(24 * time.Hour)
+	next := *current
+	next.PricePerKm = 0.7
+	next.ValidFrom = switchAt
+	created, err := repo.CreateTariff(ctx, &next)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, created.Version)
+
+	now, err := repo.GetByCode(ctx, "STANDARD", time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, now.Version)
+	assert.Equal(t, 0.5, now.PricePerKm)
+
+	later, err := repo.GetByCode(ctx, "STANDARD", switchAt.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, later.Version)
+	assert.Equal(t, 0.7, later.PricePerKm)
+
+	earlier := *current
+	earlier.ValidFrom = time.Now()
+	_, err = repo.CreateTariff(ctx, &earlier)
+	assert.ErrorIs(t, err, models.ErrTariffVersionConflict)
+
+	versions, err := repo.GetVersions(ctx, "STANDARD")
+	assert.NoError(t, err)
+	assert.Len(t, versions, 2)
+	assert.NotNil(t, versions[0].ValidTo)
+	assert.Nil(t, versions[1].ValidTo)
+}
+
 func TestMongoTariffRepo_DeleteTariff(t *testing.T) {
 	ctx, db, cleanup := setupCalculatorTestEnvironment(t)
 	defer cleanup()
@@ -249,7 +368,7 @@ func TestMongoTariffRepo_DeleteTariff(t *testing.T) {
 	err = repo.DeleteTariff(ctx, "STANDARD")
 	assert.NoError(t, err)
 
-	result, err := repo.GetByCode(ctx, "STANDARD")
+	result, err := repo.GetByCode(ctx, "STANDARD", time.Now())
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "tariff not found")
@@ -266,6 +385,27 @@ func TestMongoTariffRepo_DeleteTariff_NotFound(t *testing.T) {
 	assert.Contains(t, err.Error(), "tariff with code NON_EXISTENT not found")
 }
 
+func TestMongoTariffAuditRepo(t *testing.T) {
+	ctx, db, cleanup := setupCalculatorTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewTariffAuditMongoRepository(db, "tariff_audit")
+
+	before := &models.Tariff{Code: "STANDARD", BaseRate: 10, Version: 1}
+	after := &models.Tariff{Code: "STANDARD", BaseRate: 12, Version: 2}
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	assert.NoError(t, repo.Record(ctx, models.TariffAudit{TariffCode: "STANDARD", Action: models.TariffUpdated, Actor: "mod", At: now, Before: before, After: after}))
+	assert.NoError(t, repo.Record(ctx, models.TariffAudit{TariffCode: "STANDARD", Action: models.TariffCreated, Actor: "mod", At: now.Add(-time.Hour), After: before}))
+	assert.NoError(t, repo.Record(ctx, models.TariffAudit{TariffCode: "EXPRESS", Action: models.TariffDeleted, Actor: "mod", At: now}))
+
+	entries, err := repo.GetByCode(ctx, "STANDARD")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, models.TariffCreated, entries[0].Action)
+	assert.Nil(t, entries[0].Before)
+	assert.Equal(t, 12.0, entries[1].After.BaseRate)
+}
+
 func setupCalculatorTestEnvironment(t *testing.T) (context.Context, *mongo.Database, func()) {
 	ctx := context.Background()
 
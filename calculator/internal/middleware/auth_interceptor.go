@@ -9,6 +9,21 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+type contextKey string
+
+const (
+	userIDKey contextKey = "user_id"
+	roleKey   contextKey = "role"
+)
+
+func GRPCUserIDKey() contextKey {
+	return userIDKey
+}
+
+func GRPCRoleKey() contextKey {
+	return roleKey
+}
+
 func AuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -16,15 +31,55 @@ func AuthInterceptor() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (resp any, err error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.InvalidArgument, "missing metadata")
+		ctx, err = authenticate(ctx)
+		if err != nil {
+			return nil, err
 		}
-		authHeaders := md.Get("authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor applies the same check as AuthInterceptor to
+// streaming calls and hands the handler a stream carrying the user.
+func StreamAuthInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := authenticate(ss.Context())
+		if err != nil {
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		return handler(ctx, req)
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate carries the user ID and role the gateway sends as metadata
+// into the context.
+func authenticate(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "missing metadata")
+	}
+	authHeaders := md.Get("authorization")
+	if len(authHeaders) == 0 || authHeaders[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	ctx = context.WithValue(ctx, userIDKey, authHeaders[0])
+	if roles := md.Get("role"); len(roles) > 0 {
+		ctx = context.WithValue(ctx, roleKey, roles[0])
 	}
+	return ctx, nil
 }
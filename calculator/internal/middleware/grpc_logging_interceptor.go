@@ -30,3 +30,26 @@ func NewLoggingInterceptor(logger *logrus.Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+func NewStreamLoggingInterceptor(logger *logrus.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+		st, _ := status.FromError(err)
+		entry := logger.WithFields(logrus.Fields{
+			"method": info.FullMethod,
+			"error":  st.Message(),
+			"code":   st.Code(),
+		})
+		if err != nil {
+			entry.Error("gRPC stream failed")
+		} else {
+			entry.Info("gRPC stream success")
+		}
+		return err
+	}
+}
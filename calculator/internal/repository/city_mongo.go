@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 
 	"github.com/maksroxx/DeliveryService/calculator/models"
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,6 +14,14 @@ import (
 
 type CountryRepository interface {
 	GetCoordinates(ctx context.Context, country string) (*models.CountryCoordinates, error)
+	GetNames(ctx context.Context) ([]string, error)
+	Search(ctx context.Context, query string, limit int) ([]models.CountryCoordinates, error)
+	Nearest(ctx context.Context, latitude, longitude float64, limit int) ([]models.NearbyCity, error)
+	SetAliases(ctx context.Context, name string, aliases []string) (*models.CountryCoordinates, error)
+	EnsureSearchKeys(ctx context.Context) error
+	GetAll(ctx context.Context) ([]models.CountryCoordinates, error)
+	Upsert(ctx context.Context, city models.CountryCoordinates) error
+	Delete(ctx context.Context, name string) error
 }
 
 type mongoCityRepo struct {
@@ -30,20 +39,190 @@ func NewCityMongoRepository(db *mongo.Database, collectionName string) CountryRe
 	if err != nil {
 		panic(fmt.Sprintf("Failed to create unique index: %v", err))
 	}
+	_, err = collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+		{Keys: bson.D{{Key: "search", Value: 1}}},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create city search indexes: %v", err))
+	}
 	return &mongoCityRepo{collection: db.Collection(collectionName)}
 }
 
+// exactName matches the name as typed, ignoring case. The input is quoted so
+// it can't be used as a pattern.
+func exactName(name string) bson.M {
+	return bson.M{"$regex": "^" + regexp.QuoteMeta(name) + "$", "$options": "i"}
+}
+
 func (r *mongoCityRepo) GetCoordinates(ctx context.Context, country string) (*models.CountryCoordinates, error) {
-	filter := bson.M{"name": bson.M{"$regex": "^" + country + "$", "$options": "i"}}
+	filter := bson.M{"name": exactName(country)}
+	if key := models.SearchKey(country); key != "" {
+		filter = bson.M{"$or": []bson.M{filter, {"search": key}}}
+	}
 	var doc models.CountryDoc
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
-		return nil, errors.New("country not found in DB")
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrCityNotFound
+		}
+		return nil, fmt.Errorf("failed to find city %q: %w", country, err)
+	}
+	return doc.Coordinates(), nil
+}
+
+func (r *mongoCityRepo) GetNames(ctx context.Context) ([]string, error) {
+	values, err := r.collection.Distinct(ctx, "name", bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(values))
+	for _, v := range values {
+		if name, ok := v.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func (r *mongoCityRepo) Search(ctx context.Context, query string, limit int) ([]models.CountryCoordinates, error) {
+	key := models.SearchKey(query)
+	if key == "" {
+		return nil, nil
+	}
+	filter := bson.M{"search": bson.M{"$regex": "^" + regexp.QuoteMeta(key)}}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []models.CountryDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	cities := make([]models.CountryCoordinates, 0, len(docs))
+	for _, doc := range docs {
+		cities = append(cities, *doc.Coordinates())
+	}
+	return cities, nil
+}
+
+func (r *mongoCityRepo) Nearest(ctx context.Context, latitude, longitude float64, limit int) ([]models.NearbyCity, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$geoNear", Value: bson.D{
+			{Key: "near", Value: bson.D{
+				{Key: "type", Value: "Point"},
+				{Key: "coordinates", Value: bson.A{longitude, latitude}},
+			}},
+			{Key: "distanceField", Value: "distance"},
+			{Key: "spherical", Value: true},
+		}}},
+		{{Key: "$limit", Value: limit}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []struct {
+		models.CountryDoc `bson:",inline"`
+		Distance          float64 `bson:"distance"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	cities := make([]models.NearbyCity, 0, len(docs))
+	for _, doc := range docs {
+		cities = append(cities, models.NearbyCity{
+			CountryCoordinates: *doc.Coordinates(),
+			DistanceKm:         doc.Distance / 1000,
+		})
+	}
+	return cities, nil
+}
+
+func (r *mongoCityRepo) SetAliases(ctx context.Context, name string, aliases []string) (*models.CountryCoordinates, error) {
+	var doc models.CountryDoc
+	if err := r.collection.FindOne(ctx, bson.M{"name": exactName(name)}).Decode(&doc); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrCityNotFound
+		}
+		return nil, err
+	}
+	doc.Aliases = aliases
+	doc.Search = models.SearchKeys(doc.Name, aliases)
+	update := bson.M{"$set": bson.M{"aliases": doc.Aliases, "search": doc.Search}}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"name": doc.Name}, update); err != nil {
+		return nil, err
+	}
+	return doc.Coordinates(), nil
+}
+
+// EnsureSearchKeys fills in the lookup keys of cities imported straight into
+// the collection.
+func (r *mongoCityRepo) EnsureSearchKeys(ctx context.Context) error {
+	cursor, err := r.collection.Find(ctx, bson.M{"search": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []models.CountryDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		update := bson.M{"$set": bson.M{"search": models.SearchKeys(doc.Name, doc.Aliases)}}
+		if _, err := r.collection.UpdateOne(ctx, bson.M{"name": doc.Name}, update); err != nil {
+			return fmt.Errorf("failed to index city %q: %w", doc.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *mongoCityRepo) GetAll(ctx context.Context) ([]models.CountryCoordinates, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var docs []models.CountryDoc
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	cities := make([]models.CountryCoordinates, 0, len(docs))
+	for _, doc := range docs {
+		cities = append(cities, *doc.Coordinates())
+	}
+	return cities, nil
+}
+
+// Upsert writes the city under its exact name, replacing what was stored.
+func (r *mongoCityRepo) Upsert(ctx context.Context, city models.CountryCoordinates) error {
+	doc := models.CountryDoc{Name: city.Name, Code: city.Code, Zone: city.Zone, Aliases: city.Aliases}
+	doc.Location.Type = "Point"
+	doc.Location.Coordinates = []float64{city.Longitude, city.Latitude}
+	doc.Search = models.SearchKeys(city.Name, city.Aliases)
+
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, bson.M{"name": city.Name}, doc, opts); err != nil {
+		return fmt.Errorf("failed to save city %q: %w", city.Name, err)
+	}
+	return nil
+}
+
+func (r *mongoCityRepo) Delete(ctx context.Context, name string) error {
+	res, err := r.collection.DeleteOne(ctx, bson.M{"name": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete city %q: %w", name, err)
+	}
+	if res.DeletedCount == 0 {
+		return models.ErrCityNotFound
 	}
-	return &models.CountryCoordinates{
-		Name:      doc.Name,
-		Latitude:  doc.Location.Coordinates[1],
-		Longitude: doc.Location.Coordinates[0],
-		Zone:      doc.Zone,
-	}, nil
+	return nil
 }
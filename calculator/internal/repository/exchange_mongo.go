@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ExchangeRateRepository interface {
+	GetRate(ctx context.Context, base, quote string, at time.Time) (*models.ExchangeRate, error)
+	GetAll(ctx context.Context) ([]models.ExchangeRate, error)
+	SetRates(ctx context.Context, rates []models.ExchangeRate) error
+}
+
+type mongoExchangeRepo struct {
+	collection *mongo.Collection
+}
+
+func NewExchangeMongoRepository(db *mongo.Database, collectionName string) ExchangeRateRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "base", Value: 1},
+			{Key: "quote", Value: 1},
+			{Key: "date", Value: -1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	return &mongoExchangeRepo{
+		collection: collection,
+	}
+}
+
+// GetRate returns the latest rate for the pair dated no later than at.
+func (r *mongoExchangeRepo) GetRate(ctx context.Context, base, quote string, at time.Time) (*models.ExchangeRate, error) {
+	filter := bson.M{"base": base, "quote": quote, "date": bson.M{"$lte": at}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "date", Value: -1}})
+
+	var rate models.ExchangeRate
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&rate); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrExchangeRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *mongoExchangeRepo) GetAll(ctx context.Context) ([]models.ExchangeRate, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "base", Value: 1}, {Key: "quote", Value: 1}, {Key: "date", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rates []models.ExchangeRate
+	if err := cursor.All(ctx, &rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// SetRates upserts the rates, replacing any already stored for the same pair and date.
+func (r *mongoExchangeRepo) SetRates(ctx context.Context, rates []models.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(rates))
+	for _, rate := range rates {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"base": rate.Base, "quote": rate.Quote, "date": rate.Date}).
+			SetReplacement(rate).
+			SetUpsert(true))
+	}
+	if _, err := r.collection.BulkWrite(ctx, writes); err != nil {
+		return fmt.Errorf("failed to store exchange rates: %w", err)
+	}
+	return nil
+}
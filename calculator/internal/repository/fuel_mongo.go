@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type FuelIndexRepository interface {
+	GetAt(ctx context.Context, at time.Time) (*models.FuelIndex, error)
+	GetAll(ctx context.Context) ([]models.FuelIndex, error)
+	Set(ctx context.Context, entries []models.FuelIndex) error
+}
+
+type mongoFuelIndexRepo struct {
+	collection *mongo.Collection
+}
+
+func NewFuelIndexMongoRepository(db *mongo.Database, collectionName string) FuelIndexRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "effective_from", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	return &mongoFuelIndexRepo{
+		collection: collection,
+	}
+}
+
+// GetAt returns the entry in force at the given instant.
+func (r *mongoFuelIndexRepo) GetAt(ctx context.Context, at time.Time) (*models.FuelIndex, error) {
+	filter := bson.M{"effective_from": bson.M{"$lte": at}}
+	opts := options.FindOne().SetSort(bson.D{{Key: "effective_from", Value: -1}})
+
+	var entry models.FuelIndex
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&entry); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrFuelIndexNotFound
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *mongoFuelIndexRepo) GetAll(ctx context.Context) ([]models.FuelIndex, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "effective_from", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []models.FuelIndex
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Set upserts the entries, replacing any already stored for the same date.
+func (r *mongoFuelIndexRepo) Set(ctx context.Context, entries []models.FuelIndex) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	writes := make([]mongo.WriteModel, 0, len(entries))
+	for _, entry := range entries {
+		writes = append(writes, mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"effective_from": entry.EffectiveFrom}).
+			SetReplacement(entry).
+			SetUpsert(true))
+	}
+	if _, err := r.collection.BulkWrite(ctx, writes); err != nil {
+		return fmt.Errorf("failed to store fuel index: %w", err)
+	}
+	return nil
+}
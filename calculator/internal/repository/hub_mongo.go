@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type HubRepository interface {
+	GetHubs(ctx context.Context) ([]models.Hub, error)
+	GetLinks(ctx context.Context) ([]models.HubLink, error)
+	CreateHub(ctx context.Context, hub *models.Hub) (*models.Hub, error)
+	CreateLink(ctx context.Context, link *models.HubLink) (*models.HubLink, error)
+}
+
+type mongoHubRepo struct {
+	hubs  *mongo.Collection
+	links *mongo.Collection
+}
+
+func NewHubMongoRepository(db *mongo.Database, hubsCollection, linksCollection string) HubRepository {
+	hubs := db.Collection(hubsCollection)
+	links := db.Collection(linksCollection)
+
+	_, err := hubs.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "code", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	_, err = links.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "from", Value: 1}, {Key: "to", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	return &mongoHubRepo{
+		hubs:  hubs,
+		links: links,
+	}
+}
+
+func (r *mongoHubRepo) GetHubs(ctx context.Context) ([]models.Hub, error) {
+	cursor, err := r.hubs.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var hubs []models.Hub
+	if err := cursor.All(ctx, &hubs); err != nil {
+		return nil, err
+	}
+	return hubs, nil
+}
+
+func (r *mongoHubRepo) GetLinks(ctx context.Context) ([]models.HubLink, error) {
+	cursor, err := r.links.Find(ctx, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var links []models.HubLink
+	if err := cursor.All(ctx, &links); err != nil {
+		return nil, err
+	}
+	return links, nil
+}
+
+func (r *mongoHubRepo) CreateHub(ctx context.Context, hub *models.Hub) (*models.Hub, error) {
+	if _, err := r.hubs.InsertOne(ctx, hub); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errors.New("hub has already exists")
+		}
+		return nil, err
+	}
+	return hub, nil
+}
+
+func (r *mongoHubRepo) CreateLink(ctx context.Context, link *models.HubLink) (*models.HubLink, error) {
+	count, err := r.hubs.CountDocuments(ctx, bson.M{"code": bson.M{"$in": bson.A{link.From, link.To}}})
+	if err != nil {
+		return nil, err
+	}
+	if count != 2 {
+		return nil, errors.New("both hubs of the link must exist")
+	}
+	if _, err := r.links.InsertOne(ctx, link); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errors.New("link has already exists")
+		}
+		return nil, err
+	}
+	return link, nil
+}
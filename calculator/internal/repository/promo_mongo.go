@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type PromotionRepository interface {
+	GetByCode(ctx context.Context, code string) (*models.Promotion, error)
+	GetAll(ctx context.Context) ([]models.Promotion, error)
+	Create(ctx context.Context, promo *models.Promotion) (*models.Promotion, error)
+	SetActive(ctx context.Context, code string, active bool) error
+	Redeem(ctx context.Context, promo *models.Promotion, userID, packageID string) error
+	Release(ctx context.Context, code, packageID string) error
+}
+
+type mongoPromoRepo struct {
+	promotions  *mongo.Collection
+	usage       *mongo.Collection
+	redemptions *mongo.Collection
+}
+
+type promoRedemption struct {
+	Code       string    `bson:"code"`
+	UserID     string    `bson:"user_id"`
+	PackageID  string    `bson:"package_id"`
+	RedeemedAt time.Time `bson:"redeemed_at"`
+}
+
+func NewPromoMongoRepository(db *mongo.Database, collectionName string) PromotionRepository {
+	repo := &mongoPromoRepo{
+		promotions:  db.Collection(collectionName),
+		usage:       db.Collection(collectionName + "_usage"),
+		redemptions: db.Collection(collectionName + "_redemptions"),
+	}
+
+	indexes := []struct {
+		collection *mongo.Collection
+		keys       bson.D
+	}{
+		{repo.promotions, bson.D{{Key: "code", Value: 1}}},
+		{repo.usage, bson.D{{Key: "code", Value: 1}, {Key: "user_id", Value: 1}}},
+		{repo.redemptions, bson.D{{Key: "code", Value: 1}, {Key: "package_id", Value: 1}}},
+	}
+	for _, idx := range indexes {
+		_, err := idx.collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+			Keys:    idx.keys,
+			Options: options.Index().SetUnique(true),
+		})
+		if err != nil {
+			panic(fmt.Sprintf("Failed to create unique index: %v", err))
+		}
+	}
+	return repo
+}
+
+func (r *mongoPromoRepo) GetByCode(ctx context.Context, code string) (*models.Promotion, error) {
+	var promo models.Promotion
+	if err := r.promotions.FindOne(ctx, bson.M{"code": code}).Decode(&promo); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrPromoNotFound
+		}
+		return nil, err
+	}
+	return &promo, nil
+}
+
+func (r *mongoPromoRepo) GetAll(ctx context.Context) ([]models.Promotion, error) {
+	cursor, err := r.promotions.Find(ctx, bson.D{}, options.Find().SetSort(bson.D{{Key: "code", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var promos []models.Promotion
+	if err := cursor.All(ctx, &promos); err != nil {
+		return nil, err
+	}
+	return promos, nil
+}
+
+func (r *mongoPromoRepo) Create(ctx context.Context, promo *models.Promotion) (*models.Promotion, error) {
+	if _, err := r.promotions.InsertOne(ctx, promo); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errors.New("promo code has already exists")
+		}
+		return nil, err
+	}
+	return promo, nil
+}
+
+func (r *mongoPromoRepo) SetActive(ctx context.Context, code string, active bool) error {
+	result, err := r.promotions.UpdateOne(ctx, bson.M{"code": code}, bson.M{"$set": bson.M{"active": active}})
+	if err != nil {
+		return fmt.Errorf("failed to update promotion: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return models.ErrPromoNotFound
+	}
+	return nil
+}
+
+// Redeem takes one use of the code for the package. Each limit is checked
+// and incremented in a single conditional update, so concurrent orders can't
+// push a counter past its limit; a later failure gives back what was taken.
+// Redeeming the same package twice is a no-op.
+func (r *mongoPromoRepo) Redeem(ctx context.Context, promo *models.Promotion, userID, packageID string) error {
+	count, err := r.redemptions.CountDocuments(ctx, bson.M{"code": promo.Code, "package_id": packageID})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	if limit := promo.UserLimit(); limit > 0 {
+		_, err := r.usage.UpdateOne(ctx,
+			bson.M{"code": promo.Code, "user_id": userID, "count": bson.M{"$lt": limit}},
+			bson.M{"$inc": bson.M{"count": 1}},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			// the user is at the limit: the filter missed and the upsert hit the unique index
+			if mongo.IsDuplicateKeyError(err) {
+				return models.ErrPromoUserLimit
+			}
+			return err
+		}
+	}
+
+	filter := bson.M{"code": promo.Code, "active": true}
+	if promo.MaxUses > 0 {
+		filter["used"] = bson.M{"$lt": promo.MaxUses}
+	}
+	result, err := r.promotions.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"used": 1}})
+	if err == nil && result.MatchedCount == 0 {
+		err = models.ErrPromoExhausted
+	}
+	if err != nil {
+		r.releaseUser(ctx, promo, userID)
+		return err
+	}
+
+	_, err = r.redemptions.InsertOne(ctx, promoRedemption{
+		Code:       promo.Code,
+		UserID:     userID,
+		PackageID:  packageID,
+		RedeemedAt: time.Now(),
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// a concurrent retry for the same package got there first
+			err = nil
+		}
+		r.releaseUser(ctx, promo, userID)
+		r.promotions.UpdateOne(ctx, bson.M{"code": promo.Code}, bson.M{"$inc": bson.M{"used": -1}})
+		return err
+	}
+	return nil
+}
+
+// Release gives back the use taken for the package, e.g. when storing the
+// package failed after the code was redeemed.
+func (r *mongoPromoRepo) Release(ctx context.Context, code, packageID string) error {
+	var redemption promoRedemption
+	err := r.redemptions.FindOneAndDelete(ctx, bson.M{"code": code, "package_id": packageID}).Decode(&redemption)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		}
+		return err
+	}
+	if _, err := r.promotions.UpdateOne(ctx, bson.M{"code": code}, bson.M{"$inc": bson.M{"used": -1}}); err != nil {
+		return err
+	}
+	_, err = r.usage.UpdateOne(ctx,
+		bson.M{"code": code, "user_id": redemption.UserID, "count": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"count": -1}},
+	)
+	return err
+}
+
+func (r *mongoPromoRepo) releaseUser(ctx context.Context, promo *models.Promotion, userID string) {
+	if promo.UserLimit() == 0 {
+		return
+	}
+	r.usage.UpdateOne(ctx, bson.M{"code": promo.Code, "user_id": userID}, bson.M{"$inc": bson.M{"count": -1}})
+}
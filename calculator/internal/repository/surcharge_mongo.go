@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type SurchargeRuleRepository interface {
+	GetAll(ctx context.Context) ([]models.SurchargeRule, error)
+	Create(ctx context.Context, rule *models.SurchargeRule) (*models.SurchargeRule, error)
+	Update(ctx context.Context, rule *models.SurchargeRule) (*models.SurchargeRule, error)
+	Delete(ctx context.Context, id string) error
+	SeedDefaults(ctx context.Context, rules []models.SurchargeRule) error
+}
+
+type mongoSurchargeRepo struct {
+	collection *mongo.Collection
+}
+
+func NewSurchargeMongoRepository(db *mongo.Database, collectionName string) SurchargeRuleRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "rule_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	return &mongoSurchargeRepo{
+		collection: collection,
+	}
+}
+
+func (r *mongoSurchargeRepo) GetAll(ctx context.Context) ([]models.SurchargeRule, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: 1}, {Key: "rule_id", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.D{}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rules []models.SurchargeRule
+	if err := cursor.All(ctx, &rules); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *mongoSurchargeRepo) Create(ctx context.Context, rule *models.SurchargeRule) (*models.SurchargeRule, error) {
+	if _, err := r.collection.InsertOne(ctx, rule); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, errors.New("surcharge rule has already exists")
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *mongoSurchargeRepo) Update(ctx context.Context, rule *models.SurchargeRule) (*models.SurchargeRule, error) {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"rule_id": rule.ID}, rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update surcharge rule: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return nil, models.ErrSurchargeRuleNotFound
+	}
+	return rule, nil
+}
+
+func (r *mongoSurchargeRepo) Delete(ctx context.Context, id string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"rule_id": id})
+	if err != nil {
+		return fmt.Errorf("failed to delete surcharge rule: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return models.ErrSurchargeRuleNotFound
+	}
+	return nil
+}
+
+// SeedDefaults stores the rules only into an empty collection, so rules that
+// were edited or deleted are not brought back on restart.
+func (r *mongoSurchargeRepo) SeedDefaults(ctx context.Context, rules []models.SurchargeRule) error {
+	count, err := r.collection.CountDocuments(ctx, bson.D{})
+	if err != nil || count > 0 {
+		return err
+	}
+	docs := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		docs = append(docs, rule)
+	}
+	_, err = r.collection.InsertMany(ctx, docs)
+	if mongo.IsDuplicateKeyError(err) {
+		// another replica seeded first
+		return nil
+	}
+	return err
+}
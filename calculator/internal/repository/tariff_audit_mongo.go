@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type TariffAuditRepository interface {
+	Record(ctx context.Context, entry models.TariffAudit) error
+	GetByCode(ctx context.Context, code string) ([]models.TariffAudit, error)
+}
+
+type mongoTariffAuditRepo struct {
+	collection *mongo.Collection
+}
+
+func NewTariffAuditMongoRepository(db *mongo.Database, collectionName string) TariffAuditRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tariff_code", Value: 1},
+			{Key: "at", Value: 1},
+		},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create tariff audit index: %v", err))
+	}
+
+	return &mongoTariffAuditRepo{
+		collection: collection,
+	}
+}
+
+// Record appends an entry; the trail is never rewritten.
+func (r *mongoTariffAuditRepo) Record(ctx context.Context, entry models.TariffAudit) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	return err
+}
+
+// GetByCode returns the changes to the tariff, oldest first.
+func (r *mongoTariffAuditRepo) GetByCode(ctx context.Context, code string) ([]models.TariffAudit, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"tariff_code": code}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var entries []models.TariffAudit
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/calculator/models"
 	"go.mongodb.org/mongo-driver/bson"
@@ -13,7 +14,8 @@ import (
 
 type TariffRepository interface {
 	GetAll(ctx context.Context) ([]models.Tariff, error)
-	GetByCode(ctx context.Context, code string) (*models.Tariff, error)
+	GetByCode(ctx context.Context, code string, at time.Time) (*models.Tariff, error)
+	GetVersions(ctx context.Context, code string) ([]models.Tariff, error)
 	CreateTariff(ctx context.Context, tariff *models.Tariff) (*models.Tariff, error)
 	DeleteTariff(ctx context.Context, code string) error
 }
@@ -25,15 +27,28 @@ type mongoTariffRepo struct {
 func NewTariffMongoRepository(db *mongo.Database, collectionName string) TariffRepository {
 	collection := db.Collection(collectionName)
 
+	// tariffs used to be unique by (code, name), which rules out several
+	// versions of the same tariff
+	_, _ = collection.Indexes().DropOne(context.Background(), "code_1_name_1")
+
+	// tariffs created before versioning become version 1, effective since forever
+	_, err := collection.UpdateMany(context.Background(),
+		bson.M{"version": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"version": 1, "valid_from": time.Time{}}},
+	)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to migrate tariffs: %v", err))
+	}
+
 	indexModel := mongo.IndexModel{
 		Keys: bson.D{
 			{Key: "code", Value: 1},
-			{Key: "name", Value: 1},
+			{Key: "version", Value: 1},
 		},
 		Options: options.Index().SetUnique(true),
 	}
 
-	_, err := collection.Indexes().CreateOne(context.Background(), indexModel)
+	_, err = collection.Indexes().CreateOne(context.Background(), indexModel)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to create unique index: %v", err))
 	}
@@ -43,47 +58,152 @@ func NewTariffMongoRepository(db *mongo.Database, collectionName string) TariffR
 	}
 }
 
+func effectiveFilter(at time.Time) bson.M {
+	return bson.M{
+		"valid_from": bson.M{"$lte": at},
+		"$or": bson.A{
+			bson.M{"valid_to": nil},
+			bson.M{"valid_to": bson.M{"$gt": at}},
+		},
+	}
+}
+
+// GetAll returns the version of every tariff that is in force right now.
 func (r *mongoTariffRepo) GetAll(ctx context.Context) ([]models.Tariff, error) {
-	cursor, err := r.collection.Find(ctx, bson.D{})
+	opts := options.Find().SetSort(bson.D{{Key: "code", Value: 1}, {Key: "version", Value: -1}})
+	cursor, err := r.collection.Find(ctx, effectiveFilter(time.Now()), opts)
 	if err != nil {
 		return nil, err
 	}
 	defer cursor.Close(ctx)
 
-	var tariffs []models.Tariff
-	if err := cursor.All(ctx, &tariffs); err != nil {
+	var versions []models.Tariff
+	if err := cursor.All(ctx, &versions); err != nil {
 		return nil, err
 	}
+
+	var tariffs []models.Tariff
+	for _, t := range versions {
+		if len(tariffs) > 0 && tariffs[len(tariffs)-1].Code == t.Code {
+			continue
+		}
+		tariffs = append(tariffs, t)
+	}
 	return tariffs, nil
 }
 
-func (r *mongoTariffRepo) GetByCode(ctx context.Context, code string) (*models.Tariff, error) {
-	filter := bson.M{"code": code}
+// GetByCode resolves the version of the tariff effective at the given instant.
+func (r *mongoTariffRepo) GetByCode(ctx context.Context, code string, at time.Time) (*models.Tariff, error) {
+	filter := effectiveFilter(at)
+	filter["code"] = code
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+
 	var tariff models.Tariff
-	if err := r.collection.FindOne(ctx, filter).Decode(&tariff); err != nil {
-		return nil, errors.New("tariff not found")
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&tariff); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrTariffNotFound
+		}
+		return nil, err
 	}
 	return &tariff, nil
 }
 
+func (r *mongoTariffRepo) GetVersions(ctx context.Context, code string) ([]models.Tariff, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "version", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"code": code}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var versions []models.Tariff
+	if err := cursor.All(ctx, &versions); err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, models.ErrTariffNotFound
+	}
+	return versions, nil
+}
+
+func (r *mongoTariffRepo) latest(ctx context.Context, code string) (*models.Tariff, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+	var tariff models.Tariff
+	if err := r.collection.FindOne(ctx, bson.M{"code": code}, opts).Decode(&tariff); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tariff, nil
+}
+
+// CreateTariff appends a new version of the tariff. The version takes effect
+// at ValidFrom (immediately when unset) and closes the previous one, so a
+// price change can be scheduled ahead of time without touching old quotes.
 func (r *mongoTariffRepo) CreateTariff(ctx context.Context, tariff *models.Tariff) (*models.Tariff, error) {
-	_, err := r.collection.InsertOne(ctx, tariff)
+	if tariff.ValidFrom.IsZero() {
+		tariff.ValidFrom = time.Now()
+	}
+	tariff.ValidFrom = tariff.ValidFrom.UTC().Truncate(time.Millisecond)
+	tariff.ValidTo = nil
+
+	prev, err := r.latest(ctx, tariff.Code)
 	if err != nil {
+		return nil, err
+	}
+	tariff.Version = 1
+	if prev != nil {
+		if !tariff.ValidFrom.After(prev.ValidFrom) {
+			return nil, fmt.Errorf("%w: version %d of %s is effective from %s", models.ErrTariffVersionConflict,
+				prev.Version, tariff.Code, prev.ValidFrom.Format(time.RFC3339))
+		}
+		tariff.Version = prev.Version + 1
+	}
+
+	if _, err := r.collection.InsertOne(ctx, tariff); err != nil {
 		if mongo.IsDuplicateKeyError(err) {
-			return nil, errors.New("tariff has already exists")
+			return nil, models.ErrTariffVersionConflict
 		}
 		return nil, err
 	}
+
+	if prev != nil {
+		// a retired version keeps its own end date
+		filter := bson.M{
+			"code":    prev.Code,
+			"version": prev.Version,
+			"$or": bson.A{
+				bson.M{"valid_to": nil},
+				bson.M{"valid_to": bson.M{"$gt": tariff.ValidFrom}},
+			},
+		}
+		update := bson.M{"$set": bson.M{"valid_to": tariff.ValidFrom}}
+		if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+			return nil, fmt.Errorf("failed to close tariff version %d: %w", prev.Version, err)
+		}
+	}
 	return tariff, nil
 }
 
+// DeleteTariff retires the tariff: the current version stops being effective
+// and scheduled versions are dropped. Past versions are kept so packages can
+// still be traced back to the prices that were charged.
 func (r *mongoTariffRepo) DeleteTariff(ctx context.Context, code string) error {
-	filter := bson.M{"code": code}
-	result, err := r.collection.DeleteOne(ctx, filter)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	scheduled, err := r.collection.DeleteMany(ctx, bson.M{"code": code, "valid_from": bson.M{"$gt": now}})
+	if err != nil {
+		return fmt.Errorf("failed to delete tariff: %w", err)
+	}
+
+	filter := effectiveFilter(now)
+	filter["code"] = code
+	current, err := r.collection.UpdateMany(ctx, filter, bson.M{"$set": bson.M{"valid_to": now}})
 	if err != nil {
 		return fmt.Errorf("failed to delete tariff: %w", err)
 	}
-	if result.DeletedCount == 0 {
+	if scheduled.DeletedCount == 0 && current.ModifiedCount == 0 {
 		return fmt.Errorf("tariff with code %s not found", code)
 	}
 	return nil
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Transactor runs a unit of work atomically. Repositories called with the
+// context passed to fn take part in the transaction.
+type Transactor interface {
+	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type mongoTransactor struct {
+	client *mongo.Client
+}
+
+// NewMongoTransactor needs MongoDB running as a replica set; a standalone
+// server rejects transactions.
+func NewMongoTransactor(client *mongo.Client) Transactor {
+	return &mongoTransactor{client: client}
+}
+
+// WithTransaction commits when fn succeeds and aborts otherwise. fn may run
+// again if the transaction hits a transient error.
+func (t *mongoTransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	session, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
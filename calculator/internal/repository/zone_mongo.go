@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type ZoneMatrixRepository interface {
+	GetRate(ctx context.Context, tariffCode, fromZone, toZone string) (*models.ZoneRate, error)
+	GetMatrix(ctx context.Context, tariffCode string) ([]models.ZoneRate, error)
+	SetRate(ctx context.Context, rate *models.ZoneRate) (*models.ZoneRate, error)
+	DeleteRate(ctx context.Context, tariffCode, fromZone, toZone string) error
+}
+
+type mongoZoneRepo struct {
+	collection *mongo.Collection
+}
+
+func NewZoneMongoRepository(db *mongo.Database, collectionName string) ZoneMatrixRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tariff_code", Value: 1},
+			{Key: "from_zone", Value: 1},
+			{Key: "to_zone", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	return &mongoZoneRepo{
+		collection: collection,
+	}
+}
+
+func zoneKey(tariffCode, fromZone, toZone string) bson.M {
+	return bson.M{"tariff_code": tariffCode, "from_zone": fromZone, "to_zone": toZone}
+}
+
+func (r *mongoZoneRepo) GetRate(ctx context.Context, tariffCode, fromZone, toZone string) (*models.ZoneRate, error) {
+	var rate models.ZoneRate
+	err := r.collection.FindOne(ctx, zoneKey(tariffCode, fromZone, toZone)).Decode(&rate)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrZoneRateNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *mongoZoneRepo) GetMatrix(ctx context.Context, tariffCode string) ([]models.ZoneRate, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "from_zone", Value: 1}, {Key: "to_zone", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"tariff_code": tariffCode}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rates []models.ZoneRate
+	if err := cursor.All(ctx, &rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// SetRate inserts the cell or overwrites the existing one for the same lane.
+func (r *mongoZoneRepo) SetRate(ctx context.Context, rate *models.ZoneRate) (*models.ZoneRate, error) {
+	opts := options.Replace().SetUpsert(true)
+	if _, err := r.collection.ReplaceOne(ctx, zoneKey(rate.TariffCode, rate.FromZone, rate.ToZone), rate, opts); err != nil {
+		return nil, fmt.Errorf("failed to set zone rate: %w", err)
+	}
+	return rate, nil
+}
+
+func (r *mongoZoneRepo) DeleteRate(ctx context.Context, tariffCode, fromZone, toZone string) error {
+	result, err := r.collection.DeleteOne(ctx, zoneKey(tariffCode, fromZone, toZone))
+	if err != nil {
+		return fmt.Errorf("failed to delete zone rate: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return models.ErrZoneRateNotFound
+	}
+	return nil
+}
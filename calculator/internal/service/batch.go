@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// BatchItem is one parcel of a batch. ID is the caller's correlation ID and
+// comes back on the matching result; an empty tariff code prices with the
+// default tariff.
+type BatchItem struct {
+	ID         string
+	Package    models.Package
+	TariffCode string
+}
+
+type BatchResult struct {
+	ID     string
+	Result models.CalculationResult
+	Err    error
+}
+
+// CalculateBatch prices the items with a fixed number of workers and sends
+// each result as soon as it is ready, so results don't keep the order of the
+// items. The returned channel is closed once items is closed and drained,
+// or the context is done.
+//
+// Cities, tariffs and surcharge rules are looked up once per batch rather
+// than once per item, so every item of a batch sees the same tariff version.
+func (c *ExtendedCalculator) CalculateBatch(ctx context.Context, items <-chan BatchItem, workers int) <-chan BatchResult {
+	if workers < 1 {
+		workers = 1
+	}
+	cached := c.withCaches()
+	out := make(chan BatchResult)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range items {
+				result := BatchResult{ID: item.ID}
+				if item.TariffCode == "" || item.TariffCode == cached.defaultTariff.Code {
+					result.Result, result.Err = cached.Calculate(ctx, item.Package)
+				} else {
+					result.Result, result.Err = cached.CalculateByTariffCode(ctx, item.Package, item.TariffCode)
+				}
+				select {
+				case out <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// withCaches returns a copy of the calculator whose city, tariff, surcharge
+// and fuel index lookups are memoized for as long as the copy lives.
+func (c *ExtendedCalculator) withCaches() *ExtendedCalculator {
+	cached := *c
+	cached.repository = &cityCache{CountryRepository: c.repository}
+	cached.tariffRepo = &tariffCache{TariffRepository: c.tariffRepo}
+	if c.surcharges != nil {
+		cached.surcharges = &surchargeCache{SurchargeRuleRepository: c.surcharges}
+	}
+	if c.fuel != nil {
+		cached.fuel = &fuelCache{FuelIndexRepository: c.fuel}
+	}
+	return &cached
+}
+
+// memo loads each key once. Concurrent lookups of a key being loaded wait
+// for that load instead of starting their own; a result keep rejects is
+// handed to those waiters but not remembered.
+type memo[V any] struct {
+	mu      sync.Mutex
+	entries map[string]*memoEntry[V]
+}
+
+type memoEntry[V any] struct {
+	ready chan struct{}
+	value V
+	err   error
+}
+
+func (m *memo[V]) get(key string, load func() (V, error), keep func(error) bool) (V, error) {
+	m.mu.Lock()
+	if m.entries == nil {
+		m.entries = map[string]*memoEntry[V]{}
+	}
+	if e, ok := m.entries[key]; ok {
+		m.mu.Unlock()
+		<-e.ready
+		return e.value, e.err
+	}
+	e := &memoEntry[V]{ready: make(chan struct{})}
+	m.entries[key] = e
+	m.mu.Unlock()
+
+	e.value, e.err = load()
+	if !keep(e.err) {
+		m.mu.Lock()
+		delete(m.entries, key)
+		m.mu.Unlock()
+	}
+	close(e.ready)
+	return e.value, e.err
+}
+
+type cityCache struct {
+	repository.CountryRepository
+	cities memo[*models.CountryCoordinates]
+}
+
+// GetCoordinates remembers found cities and unknown names; other errors are
+// retried on the next lookup.
+func (c *cityCache) GetCoordinates(ctx context.Context, name string) (*models.CountryCoordinates, error) {
+	return c.cities.get(models.SearchKey(name), func() (*models.CountryCoordinates, error) {
+		return c.CountryRepository.GetCoordinates(ctx, name)
+	}, func(err error) bool {
+		return err == nil || errors.Is(err, models.ErrCityNotFound)
+	})
+}
+
+type tariffCache struct {
+	repository.TariffRepository
+	tariffs memo[*models.Tariff]
+}
+
+// GetByCode resolves each tariff once, at the instant of the first lookup.
+func (c *tariffCache) GetByCode(ctx context.Context, code string, at time.Time) (*models.Tariff, error) {
+	return c.tariffs.get(code, func() (*models.Tariff, error) {
+		return c.TariffRepository.GetByCode(ctx, code, at)
+	}, func(err error) bool {
+		return err == nil || errors.Is(err, models.ErrTariffNotFound)
+	})
+}
+
+type surchargeCache struct {
+	repository.SurchargeRuleRepository
+	rules memo[[]models.SurchargeRule]
+}
+
+func (c *surchargeCache) GetAll(ctx context.Context) ([]models.SurchargeRule, error) {
+	return c.rules.get("", func() ([]models.SurchargeRule, error) {
+		return c.SurchargeRuleRepository.GetAll(ctx)
+	}, func(err error) bool {
+		return err == nil
+	})
+}
+
+type fuelCache struct {
+	repository.FuelIndexRepository
+	entry memo[*models.FuelIndex]
+}
+
+// GetAt resolves the index once, at the instant of the first lookup.
+func (c *fuelCache) GetAt(ctx context.Context, at time.Time) (*models.FuelIndex, error) {
+	return c.entry.get("", func() (*models.FuelIndex, error) {
+		return c.FuelIndexRepository.GetAt(ctx, at)
+	}, func(err error) bool {
+		return err == nil || errors.Is(err, models.ErrFuelIndexNotFound)
+	})
+}
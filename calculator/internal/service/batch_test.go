@@ -0,0 +1,81 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExtendedCalculator_CalculateBatch(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Name: "France", Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Name: "UK", Latitude: 51.51, Longitude: -0.13}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Atlantis").Return((*models.CountryCoordinates)(nil), models.ErrCityNotFound)
+	countryRepo.On("GetNames", mock.Anything).Return([]string{"France", "UK"}, nil)
+	express := models.Tariff{
+		Code:              "EXPRESS",
+		Name:              "Express",
+		BaseRate:          500,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         200,
+	}
+	tariffRepo.On("GetByCode", mock.Anything, "EXPRESS").Return(&express, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+
+	const n = 50
+	items := make(chan service.BatchItem)
+	go func() {
+		defer close(items)
+		for i := 0; i < n; i++ {
+			item := service.BatchItem{
+				ID:         fmt.Sprintf("sku-%d", i),
+				Package:    models.Package{From: "France", To: "UK", Weight: float64(i%5 + 1), Length: 20, Width: 15, Height: 10},
+				TariffCode: "EXPRESS",
+			}
+			if i%2 == 1 {
+				item.TariffCode = ""
+			}
+			items <- item
+		}
+		items <- service.BatchItem{ID: "lost", Package: models.Package{From: "Atlantis", To: "UK", Weight: 1, Length: 1, Width: 1, Height: 1}}
+	}()
+
+	results := map[string]service.BatchResult{}
+	for res := range calc.CalculateBatch(context.Background(), items, 4) {
+		results[res.ID] = res
+	}
+
+	assert.Len(t, results, n+1)
+	assert.NoError(t, results["sku-0"].Err)
+	assert.Equal(t, "EUR", results["sku-0"].Result.Currency)
+	assert.Equal(t, "RUB", results["sku-1"].Result.Currency, "no tariff code prices with the default tariff")
+	assert.ErrorIs(t, results["lost"].Err, models.ErrCityNotFound)
+
+	countryRepo.AssertNumberOfCalls(t, "GetCoordinates", 3)
+	tariffRepo.AssertNumberOfCalls(t, "GetByCode", 1)
+}
+
+func TestExtendedCalculator_CalculateBatch_Cancelled(t *testing.T) {
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: new(mockCountryRepo), Tariffs: new(mockTariffRepo)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	items := make(chan service.BatchItem)
+	results := calc.CalculateBatch(ctx, items, 2)
+	cancel()
+	close(items)
+
+	// the results channel still closes once the caller has gone away
+	for range results {
+	}
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+const (
+	anyScope      = "*"
+	calendarDays  = 366
+	holidayLayout = "2006-01-02"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// Calendar knows when hubs, cities, countries and zones work. A place with
+// no hours of its own and no "*" entry works around the clock.
+type Calendar struct {
+	hours    map[string]models.WorkingHours
+	holidays map[string]map[string]bool
+}
+
+func NewCalendar(hours []models.WorkingHours, holidays []models.Holiday) *Calendar {
+	c := &Calendar{
+		hours:    make(map[string]models.WorkingHours),
+		holidays: make(map[string]map[string]bool),
+	}
+	for _, h := range hours {
+		c.hours[scopeKey(h.Scope)] = h
+	}
+	for _, h := range holidays {
+		key := scopeKey(h.Scope)
+		if c.holidays[key] == nil {
+			c.holidays[key] = make(map[string]bool)
+		}
+		c.holidays[key][h.Date.Format(holidayLayout)] = true
+	}
+	return c
+}
+
+func scopeKey(scope string) string {
+	if strings.TrimSpace(scope) == anyScope {
+		return anyScope
+	}
+	return models.SearchKey(scope)
+}
+
+// hoursFor picks the hours of the first scope that has its own, most
+// specific first.
+func (c *Calendar) hoursFor(scopes []string) models.WorkingHours {
+	for _, scope := range scopes {
+		if h, ok := c.hours[scopeKey(scope)]; ok {
+			return h
+		}
+	}
+	if h, ok := c.hours[anyScope]; ok {
+		return h
+	}
+	return models.WorkingHours{
+		Scope:    anyScope,
+		Days:     [7]bool{true, true, true, true, true, true, true},
+		Close:    24 * time.Hour,
+		Location: time.UTC,
+	}
+}
+
+func (c *Calendar) isHoliday(day time.Time, scopes []string) bool {
+	date := day.Format(holidayLayout)
+	for _, scope := range scopes {
+		if c.holidays[scopeKey(scope)][date] {
+			return true
+		}
+	}
+	return c.holidays[anyScope][date]
+}
+
+// Span returns the working period at or after t for the place described by
+// scopes: it starts at t when t falls inside working hours, otherwise at the
+// next opening, and ends at that day's closing time.
+func (c *Calendar) Span(t time.Time, scopes ...string) (start, end time.Time) {
+	h := c.hoursFor(scopes)
+	loc := h.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := t.In(loc)
+	for i := 0; i < calendarDays; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+		if h.Days[day.Weekday()] && !c.isHoliday(day, scopes) {
+			open, closing := day.Add(h.Open), day.Add(h.Close)
+			if local.Before(closing) {
+				if local.Before(open) {
+					return open, closing
+				}
+				return local, closing
+			}
+		}
+		local = day.AddDate(0, 0, 1)
+	}
+	// nothing is open for a year: the calendar is misconfigured, so don't
+	// hold the parcel back
+	return t, t
+}
+
+// deliveryWindow places the transit on the calendar: the parcel leaves at
+// the origin's next working moment, travels around the clock and is handed
+// over within the destination's working hours.
+func (c *DefaultCalculator) deliveryWindow(at time.Time, transitHours int, from, to *models.CountryCoordinates, route *models.HubRoute) *models.DeliveryWindow {
+	if c.calendar == nil {
+		return nil
+	}
+	origin, destination := cityScopes(from), cityScopes(to)
+	if route != nil && len(route.Legs) > 0 {
+		origin = append([]string{route.Legs[0].FromHub}, origin...)
+		destination = append([]string{route.Legs[len(route.Legs)-1].ToHub}, destination...)
+	}
+
+	departure, _ := c.calendar.Span(at, origin...)
+	arrival := departure.Add(time.Duration(transitHours) * time.Hour)
+	start, end := c.calendar.Span(arrival, destination...)
+	return &models.DeliveryWindow{From: start.UTC(), To: end.UTC()}
+}
+
+func cityScopes(city *models.CountryCoordinates) []string {
+	var scopes []string
+	for _, s := range []string{city.Name, city.Code, city.Country, city.Zone} {
+		if s != "" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+// LoadWorkingHours reads a CSV of scope,days,open,close,timezone rows, e.g.
+// "Germany,mon-fri,08:00,18:00,Europe/Berlin"; days are a space separated
+// list of days and ranges. A header line is skipped.
+func LoadWorkingHours(path string) ([]models.WorkingHours, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseWorkingHours(f)
+}
+
+func ParseWorkingHours(r io.Reader) ([]models.WorkingHours, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 5
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var hours []models.WorkingHours
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return hours, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "scope") {
+			continue
+		}
+		h := models.WorkingHours{Scope: strings.TrimSpace(record[0])}
+		if h.Scope == "" {
+			return nil, fmt.Errorf("line %d: scope is required", line)
+		}
+		if h.Days, err = parseDays(record[1]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if h.Open, err = parseClock(record[2]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if h.Close, err = parseClock(record[3]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if h.Close <= h.Open {
+			return nil, fmt.Errorf("line %d: closing time %s is not after opening time %s", line, record[3], record[2])
+		}
+		if h.Location, err = time.LoadLocation(strings.TrimSpace(record[4])); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		hours = append(hours, h)
+	}
+}
+
+// LoadHolidays reads a CSV of date,scope,name rows, e.g.
+// "2026-01-01,*,New Year". A header line is skipped.
+func LoadHolidays(path string) ([]models.Holiday, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseHolidays(f)
+}
+
+func ParseHolidays(r io.Reader) ([]models.Holiday, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var holidays []models.Holiday
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return holidays, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "date") {
+			continue
+		}
+		date, err := time.Parse(holidayLayout, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, record[0])
+		}
+		scope := strings.TrimSpace(record[1])
+		if scope == "" {
+			return nil, fmt.Errorf("line %d: scope is required", line)
+		}
+		holidays = append(holidays, models.Holiday{Scope: scope, Date: date, Name: strings.TrimSpace(record[2])})
+	}
+}
+
+func parseDays(s string) ([7]bool, error) {
+	var days [7]bool
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return days, fmt.Errorf("no working days")
+	}
+	for _, field := range fields {
+		first, last, isRange := strings.Cut(field, "-")
+		from, ok := weekdays[first]
+		if !ok {
+			return days, fmt.Errorf("unknown day %q", first)
+		}
+		to := from
+		if isRange {
+			if to, ok = weekdays[last]; !ok {
+				return days, fmt.Errorf("unknown day %q", last)
+			}
+		}
+		for d := from; ; d = (d + 1) % 7 {
+			days[d] = true
+			if d == to {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// parseClock reads "HH:MM" as the time since midnight; "24:00" is the end
+// of the day.
+func parseClock(s string) (time.Duration, error) {
+	var hh, mm int
+	if _, err := fmt.Sscanf(strings.TrimSpace(s), "%d:%d", &hh, &mm); err != nil {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	if hh < 0 || mm < 0 || mm > 59 || hh*60+mm > 24*60 {
+		return 0, fmt.Errorf("invalid time %q", s)
+	}
+	return time.Duration(hh)*time.Hour + time.Duration(mm)*time.Minute, nil
+}
@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWorkingHours(t *testing.T) {
+	hours, err := service.ParseWorkingHours(strings.NewReader(`scope,days,open,close,timezone
+# comment
+Germany,mon-fri,08:00,18:00,Europe/Berlin
+HUB-MSK,sat sun,10:00,24:00,Europe/Moscow
+`))
+	require.NoError(t, err)
+	require.Len(t, hours, 2)
+
+	assert.Equal(t, "Germany", hours[0].Scope)
+	assert.Equal(t, [7]bool{false, true, true, true, true, true, false}, hours[0].Days)
+	assert.Equal(t, 8*time.Hour, hours[0].Open)
+	assert.Equal(t, 18*time.Hour, hours[0].Close)
+	assert.Equal(t, "Europe/Berlin", hours[0].Location.String())
+	assert.Equal(t, [7]bool{true, false, false, false, false, false, true}, hours[1].Days)
+	assert.Equal(t, 24*time.Hour, hours[1].Close)
+
+	for _, bad := range []string{
+		"Germany,someday,08:00,18:00,Europe/Berlin",
+		"Germany,mon-fri,18:00,08:00,Europe/Berlin",
+		"Germany,mon-fri,8am,18:00,Europe/Berlin",
+		"Germany,mon-fri,08:00,18:00,Mars/Olympus",
+	} {
+		_, err := service.ParseWorkingHours(strings.NewReader(bad))
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseHolidays(t *testing.T) {
+	holidays, err := service.ParseHolidays(strings.NewReader(`date,scope,name
+2026-12-25,Germany,Christmas Day
+2026-01-01,*,New Year's Day
+`))
+	require.NoError(t, err)
+	require.Len(t, holidays, 2)
+	assert.Equal(t, time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), holidays[0].Date)
+	assert.Equal(t, "*", holidays[1].Scope)
+
+	_, err = service.ParseHolidays(strings.NewReader("25.12.2026,Germany,Christmas Day"))
+	assert.Error(t, err)
+}
+
+func TestCalendar_Span(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	cal := service.NewCalendar(
+		[]models.WorkingHours{{Scope: "Germany", Days: [7]bool{false, true, true, true, true, true, false}, Open: 8 * time.Hour, Close: 18 * time.Hour, Location: berlin}},
+		[]models.Holiday{{Scope: "germany", Date: time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Christmas Day"}},
+	)
+
+	// inside working hours the span starts right away
+	start, end := cal.Span(time.Date(2026, 10, 14, 10, 0, 0, 0, berlin), "Germany")
+	assert.True(t, start.Equal(time.Date(2026, 10, 14, 10, 0, 0, 0, berlin)))
+	assert.True(t, end.Equal(time.Date(2026, 10, 14, 18, 0, 0, 0, berlin)))
+
+	// Friday evening moves on to Monday morning
+	start, end = cal.Span(time.Date(2026, 10, 16, 19, 0, 0, 0, berlin), "Germany")
+	assert.True(t, start.Equal(time.Date(2026, 10, 19, 8, 0, 0, 0, berlin)))
+	assert.True(t, end.Equal(time.Date(2026, 10, 19, 18, 0, 0, 0, berlin)))
+
+	// Christmas falls on a Friday
+	start, _ = cal.Span(time.Date(2026, 12, 24, 18, 30, 0, 0, berlin), "Germany")
+	assert.True(t, start.Equal(time.Date(2026, 12, 28, 8, 0, 0, 0, berlin)))
+
+	// places without hours work around the clock
+	at := time.Date(2026, 12, 25, 3, 0, 0, 0, time.UTC)
+	start, end = cal.Span(at, "Atlantis")
+	assert.True(t, start.Equal(at))
+	assert.True(t, end.Equal(time.Date(2026, 12, 26, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestExtendedCalculator_DeliveryWindow(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "Paris").Return(&models.CountryCoordinates{Name: "Paris", Country: "France", Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "London").Return(&models.CountryCoordinates{Name: "London", Country: "UK", Latitude: 51.51, Longitude: -0.13}, nil)
+	countryRepo.On("GetNames", mock.Anything).Return([]string{"Paris", "London"}, nil)
+	express := models.Tariff{
+		Code:              "EXPRESS",
+		Name:              "Express",
+		BaseRate:          500,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         50,
+	}
+	tariffRepo.On("GetByCode", mock.Anything, "EXPRESS").Return(&express, nil)
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	saturday := func() time.Time { return time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC) }
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   tariffRepo,
+		Clock:     saturday,
+	})
+
+	pkg := models.Package{From: "Paris", To: "London", Weight: 1, Length: 10, Width: 10, Height: 10}
+	result, err := calc.CalculateByTariffCode(context.Background(), pkg, "EXPRESS")
+	require.NoError(t, err)
+	assert.Nil(t, result.DeliveryWindow, "no calendar, no window")
+
+	calc = service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   tariffRepo,
+		Clock:     saturday,
+		Calendar: service.NewCalendar(
+			[]models.WorkingHours{{Scope: "France", Days: [7]bool{false, true, true, true, true, true, false}, Open: 8*time.Hour + 30*time.Minute, Close: 18 * time.Hour, Location: paris}},
+			nil,
+		),
+	})
+	result, err = calc.CalculateByTariffCode(context.Background(), pkg, "EXPRESS")
+	require.NoError(t, err)
+	require.NotNil(t, result.DeliveryWindow)
+
+	// picked up on Saturday, the parcel leaves Paris on Monday morning and
+	// London takes deliveries around the clock
+	departure := time.Date(2026, 10, 19, 8, 30, 0, 0, paris)
+	arrival := departure.Add(time.Duration(result.EstimatedHours) * time.Hour)
+	assert.True(t, result.DeliveryWindow.From.Equal(arrival))
+	assert.True(t, result.DeliveryWindow.To.Equal(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// CatalogManager exports the live tariffs and cities and rolls a catalog
+// file out in one transaction.
+type CatalogManager struct {
+	calc *ExtendedCalculator
+	tx   repository.Transactor
+}
+
+func NewCatalogManager(calc *ExtendedCalculator, tx repository.Transactor) *CatalogManager {
+	return &CatalogManager{calc: calc, tx: tx}
+}
+
+func (m *CatalogManager) Export(ctx context.Context) (models.Catalog, error) {
+	tariffs, err := m.calc.tariffRepo.GetAll(ctx)
+	if err != nil {
+		return models.Catalog{}, fmt.Errorf("failed to read tariffs: %w", err)
+	}
+	cities, err := m.calc.repository.GetAll(ctx)
+	if err != nil {
+		return models.Catalog{}, fmt.Errorf("failed to read cities: %w", err)
+	}
+	if tariffs == nil {
+		tariffs = []models.Tariff{}
+	}
+	return models.Catalog{Tariffs: tariffs, Cities: cities}, nil
+}
+
+// Diff lists what applying the catalog would change. Entries missing from
+// the file are only deleted with prune.
+func (m *CatalogManager) Diff(ctx context.Context, catalog models.Catalog, prune bool) ([]models.CatalogChange, error) {
+	if err := ValidateCatalog(catalog); err != nil {
+		return nil, err
+	}
+	live, err := m.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return DiffCatalog(live, catalog, prune), nil
+}
+
+// Apply brings the live data in line with the catalog: changed tariffs get
+// a new version effective now and cities are replaced. Either every change
+// lands or none does.
+func (m *CatalogManager) Apply(ctx context.Context, catalog models.Catalog, prune bool) ([]models.CatalogChange, error) {
+	if err := ValidateCatalog(catalog); err != nil {
+		return nil, err
+	}
+	var changes []models.CatalogChange
+	err := m.tx.WithTransaction(ctx, func(ctx context.Context) error {
+		live, err := m.Export(ctx)
+		if err != nil {
+			return err
+		}
+		changes = DiffCatalog(live, catalog, prune)
+		tariffs := make(map[string]models.Tariff, len(catalog.Tariffs))
+		for _, t := range catalog.Tariffs {
+			tariffs[t.Code] = t
+		}
+		cities := make(map[string]models.CountryCoordinates, len(catalog.Cities))
+		for _, c := range catalog.Cities {
+			cities[c.Name] = c
+		}
+		for _, change := range changes {
+			if err := m.apply(ctx, change, tariffs, cities); err != nil {
+				return fmt.Errorf("%s: %w", change, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+func (m *CatalogManager) apply(ctx context.Context, change models.CatalogChange, tariffs map[string]models.Tariff, cities map[string]models.CountryCoordinates) error {
+	switch {
+	case change.Kind == catalogTariff && change.Action == models.CatalogDelete:
+		return m.calc.DeleteTariff(ctx, change.Key)
+	case change.Kind == catalogTariff:
+		tariff := tariffs[change.Key]
+		_, err := m.calc.CreateTariff(ctx, &tariff)
+		return err
+	case change.Action == models.CatalogDelete:
+		return m.calc.repository.Delete(ctx, change.Key)
+	default:
+		return m.calc.repository.Upsert(ctx, cities[change.Key])
+	}
+}
+
+const (
+	catalogTariff = "tariff"
+	catalogCity   = "city"
+)
+
+// ValidateCatalog reports every problem in the catalog at once.
+func ValidateCatalog(catalog models.Catalog) error {
+	var errs []error
+	codes := make(map[string]bool)
+	for i, t := range catalog.Tariffs {
+		if err := t.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("tariff #%d %s: %w", i+1, t.Code, err))
+		}
+		if t.Code != "" && codes[t.Code] {
+			errs = append(errs, fmt.Errorf("tariff #%d: duplicate code %s", i+1, t.Code))
+		}
+		codes[t.Code] = true
+	}
+	names := make(map[string]bool)
+	for i, c := range catalog.Cities {
+		name := strings.TrimSpace(c.Name)
+		switch {
+		case name == "":
+			errs = append(errs, fmt.Errorf("city #%d: name is required", i+1))
+		case name != c.Name:
+			errs = append(errs, fmt.Errorf("city #%d %q: name has surrounding spaces", i+1, c.Name))
+		case names[models.SearchKey(name)]:
+			errs = append(errs, fmt.Errorf("city #%d: duplicate name %s", i+1, name))
+		}
+		names[models.SearchKey(name)] = true
+		if math.Abs(c.Latitude) > 90 || math.Abs(c.Longitude) > 180 {
+			errs = append(errs, fmt.Errorf("city #%d %s: coordinates %.4f, %.4f are out of range", i+1, c.Name, c.Latitude, c.Longitude))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", models.ErrInvalidCatalog, errors.Join(errs...))
+	}
+	return nil
+}
+
+// DiffCatalog compares the live catalog with the wanted one, tariffs first,
+// each sorted by key. A nil section of wanted isn't managed by the file and
+// is left alone.
+func DiffCatalog(live, wanted models.Catalog, prune bool) []models.CatalogChange {
+	var changes []models.CatalogChange
+	if wanted.Tariffs != nil {
+		have := make(map[string]models.Tariff, len(live.Tariffs))
+		for _, t := range live.Tariffs {
+			have[t.Code] = t
+		}
+		want := make(map[string]models.Tariff, len(wanted.Tariffs))
+		for _, t := range wanted.Tariffs {
+			want[t.Code] = t
+		}
+		changes = append(changes, diffEntries(catalogTariff, have, want, prune)...)
+	}
+	if wanted.Cities != nil {
+		have := make(map[string]models.CountryCoordinates, len(live.Cities))
+		for _, c := range live.Cities {
+			have[c.Name] = c
+		}
+		want := make(map[string]models.CountryCoordinates, len(wanted.Cities))
+		for _, c := range wanted.Cities {
+			want[c.Name] = c
+		}
+		changes = append(changes, diffEntries(catalogCity, have, want, prune)...)
+	}
+	return changes
+}
+
+func diffEntries[T any](kind string, have, want map[string]T, prune bool) []models.CatalogChange {
+	var changes []models.CatalogChange
+	for key, w := range want {
+		h, ok := have[key]
+		if !ok {
+			changes = append(changes, models.CatalogChange{Kind: kind, Key: key, Action: models.CatalogCreate})
+			continue
+		}
+		if fields := changedFields("", reflect.ValueOf(h), reflect.ValueOf(w)); len(fields) > 0 {
+			changes = append(changes, models.CatalogChange{Kind: kind, Key: key, Action: models.CatalogUpdate, Fields: fields})
+		}
+	}
+	if prune {
+		for key := range have {
+			if _, ok := want[key]; !ok {
+				changes = append(changes, models.CatalogChange{Kind: kind, Key: key, Action: models.CatalogDelete})
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// changedFields names the catalog fields that differ, by their YAML names.
+// Fields kept out of the file, such as tariff versions, are ignored, and an
+// empty list equals a missing one.
+func changedFields(prefix string, a, b reflect.Value) []string {
+	var fields []string
+	for i := 0; i < a.NumField(); i++ {
+		name, _, _ := strings.Cut(a.Type().Field(i).Tag.Get("yaml"), ",")
+		if name == "-" || name == "" {
+			continue
+		}
+		x, y := a.Field(i), b.Field(i)
+		switch {
+		case x.Kind() == reflect.Struct:
+			fields = append(fields, changedFields(prefix+name+".", x, y)...)
+		case x.Kind() == reflect.Slice && x.Len() == 0 && y.Len() == 0:
+		case !reflect.DeepEqual(x.Interface(), y.Interface()):
+			fields = append(fields, prefix+name)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	TariffsFile = "tariffs.csv"
+	CitiesFile  = "cities.csv"
+	listSep     = ";"
+)
+
+var (
+	tariffColumns = []string{"code", "name", "base_rate", "price_per_km", "price_per_kg", "currency",
+		"volumetric_divider", "speed_kmph", "pickup_surcharge", "pricing_mode", "distance_provider",
+		"fuel_surcharge", "max_weight", "max_volumetric_weight", "max_dimension", "max_girth",
+		"origins", "destinations", "prohibited_categories"}
+	cityColumns = []string{"name", "code", "latitude", "longitude", "zone", "aliases"}
+)
+
+// LoadCatalog reads a YAML catalog, or a directory holding tariffs.csv
+// and/or cities.csv. A missing CSV leaves that section out of the catalog.
+func LoadCatalog(path string) (models.Catalog, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return models.Catalog{}, err
+	}
+	if !info.IsDir() {
+		f, err := os.Open(path)
+		if err != nil {
+			return models.Catalog{}, err
+		}
+		defer f.Close()
+		return ParseCatalogYAML(f)
+	}
+
+	var catalog models.Catalog
+	if f, err := os.Open(filepath.Join(path, TariffsFile)); err == nil {
+		catalog.Tariffs, err = ParseTariffsCSV(f)
+		f.Close()
+		if err != nil {
+			return models.Catalog{}, fmt.Errorf("%s: %w", TariffsFile, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return models.Catalog{}, err
+	}
+	if f, err := os.Open(filepath.Join(path, CitiesFile)); err == nil {
+		catalog.Cities, err = ParseCitiesCSV(f)
+		f.Close()
+		if err != nil {
+			return models.Catalog{}, fmt.Errorf("%s: %w", CitiesFile, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return models.Catalog{}, err
+	}
+	if catalog.Tariffs == nil && catalog.Cities == nil {
+		return models.Catalog{}, fmt.Errorf("neither %s nor %s found in %s", TariffsFile, CitiesFile, path)
+	}
+	return catalog, nil
+}
+
+// ParseCatalogYAML rejects unknown keys so a misspelt field doesn't quietly
+// reset a price.
+func ParseCatalogYAML(r io.Reader) (models.Catalog, error) {
+	var catalog models.Catalog
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	if err := dec.Decode(&catalog); err != nil && err != io.EOF {
+		return models.Catalog{}, err
+	}
+	return catalog, nil
+}
+
+func WriteCatalogYAML(w io.Writer, catalog models.Catalog) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(catalog); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// SaveCatalogCSV writes tariffs.csv and cities.csv into dir.
+func SaveCatalogCSV(dir string, catalog models.Catalog) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(dir, TariffsFile), func(w io.Writer) error {
+		return WriteTariffsCSV(w, catalog.Tariffs)
+	}); err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(dir, CitiesFile), func(w io.Writer) error {
+		return WriteCitiesCSV(w, catalog.Cities)
+	})
+}
+
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// WriteTariffsCSV writes one row per tariff with its limits flattened; list
+// limits are joined with ";".
+func WriteTariffsCSV(w io.Writer, tariffs []models.Tariff) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(tariffColumns); err != nil {
+		return err
+	}
+	for _, t := range tariffs {
+		l := t.Limits
+		err := writer.Write([]string{
+			t.Code, t.Name, formatFloat(t.BaseRate), formatFloat(t.PricePerKm), formatFloat(t.PricePerKg), t.Currency,
+			formatFloat(t.VolumetricDivider), formatFloat(t.SpeedKmph), formatFloat(t.PickupSurcharge), t.PricingMode, t.DistanceProvider,
+			strconv.FormatBool(t.FuelSurcharge), formatFloat(l.MaxWeight), formatFloat(l.MaxVolumetricWeight), strconv.Itoa(l.MaxDimension), strconv.Itoa(l.MaxGirth),
+			strings.Join(l.Origins, listSep), strings.Join(l.Destinations, listSep), strings.Join(l.ProhibitedCategories, listSep),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func ParseTariffsCSV(r io.Reader) ([]models.Tariff, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(tariffColumns)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	tariffs := []models.Tariff{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return tariffs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], tariffColumns[0]) {
+			continue
+		}
+		p := fieldParser{record: record}
+		t := models.Tariff{
+			Code:              strings.TrimSpace(record[0]),
+			Name:              strings.TrimSpace(record[1]),
+			BaseRate:          p.float(2),
+			PricePerKm:        p.float(3),
+			PricePerKg:        p.float(4),
+			Currency:          strings.TrimSpace(record[5]),
+			VolumetricDivider: p.float(6),
+			SpeedKmph:         p.float(7),
+			PickupSurcharge:   p.float(8),
+			PricingMode:       strings.TrimSpace(record[9]),
+			DistanceProvider:  strings.TrimSpace(record[10]),
+			FuelSurcharge:     p.bool(11),
+			Limits: models.TariffLimits{
+				MaxWeight:            p.float(12),
+				MaxVolumetricWeight:  p.float(13),
+				MaxDimension:         p.int(14),
+				MaxGirth:             p.int(15),
+				Origins:              splitList(record[16]),
+				Destinations:         splitList(record[17]),
+				ProhibitedCategories: splitList(record[18]),
+			},
+		}
+		if p.err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, p.err)
+		}
+		tariffs = append(tariffs, t)
+	}
+}
+
+// WriteCitiesCSV writes one row per city; aliases are joined with ";".
+func WriteCitiesCSV(w io.Writer, cities []models.CountryCoordinates) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(cityColumns); err != nil {
+		return err
+	}
+	for _, c := range cities {
+		err := writer.Write([]string{
+			c.Name, c.Code, formatFloat(c.Latitude), formatFloat(c.Longitude), c.Zone, strings.Join(c.Aliases, listSep),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func ParseCitiesCSV(r io.Reader) ([]models.CountryCoordinates, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(cityColumns)
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	cities := []models.CountryCoordinates{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return cities, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], cityColumns[0]) {
+			continue
+		}
+		p := fieldParser{record: record}
+		c := models.CountryCoordinates{
+			Name:      strings.TrimSpace(record[0]),
+			Code:      strings.TrimSpace(record[1]),
+			Latitude:  p.float(2),
+			Longitude: p.float(3),
+			Zone:      strings.TrimSpace(record[4]),
+			Aliases:   splitList(record[5]),
+		}
+		if p.err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, p.err)
+		}
+		cities = append(cities, c)
+	}
+}
+
+// fieldParser reads numeric columns and keeps the first error, so a row is
+// checked once after all its fields are read. Empty columns are zero.
+type fieldParser struct {
+	record []string
+	err    error
+}
+
+func (p *fieldParser) float(i int) float64 {
+	s := strings.TrimSpace(p.record[i])
+	if s == "" || p.err != nil {
+		return 0
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		p.err = fmt.Errorf("invalid number %q", s)
+	}
+	return v
+}
+
+func (p *fieldParser) int(i int) int {
+	s := strings.TrimSpace(p.record[i])
+	if s == "" || p.err != nil {
+		return 0
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		p.err = fmt.Errorf("invalid integer %q", s)
+	}
+	return v
+}
+
+func (p *fieldParser) bool(i int) bool {
+	s := strings.TrimSpace(p.record[i])
+	if s == "" || p.err != nil {
+		return false
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		p.err = fmt.Errorf("invalid boolean %q", s)
+	}
+	return v
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, listSep) {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
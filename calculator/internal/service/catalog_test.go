@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransactor struct {
+	calls int
+}
+
+func (f *fakeTransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	f.calls++
+	return fn(ctx)
+}
+
+func catalogTariff(code string, baseRate float64) models.Tariff {
+	return models.Tariff{
+		Code:              code,
+		Name:              strings.ToLower(code),
+		BaseRate:          baseRate,
+		PricePerKm:        5,
+		PricePerKg:        50,
+		Currency:          "RUB",
+		VolumetricDivider: 5000,
+		SpeedKmph:         60,
+	}
+}
+
+func TestValidateCatalog(t *testing.T) {
+	assert.NoError(t, service.ValidateCatalog(models.Catalog{
+		Tariffs: []models.Tariff{catalogTariff("STANDARD", 300)},
+		Cities:  []models.CountryCoordinates{{Name: "Moscow", Latitude: 55.75, Longitude: 37.62}},
+	}))
+
+	broken := catalogTariff("EXPRESS", 0)
+	err := service.ValidateCatalog(models.Catalog{
+		Tariffs: []models.Tariff{catalogTariff("STANDARD", 300), catalogTariff("STANDARD", 300), broken},
+		Cities: []models.CountryCoordinates{
+			{Name: "Moscow", Latitude: 55.75, Longitude: 37.62},
+			{Name: "moscow", Latitude: 55.75, Longitude: 37.62},
+			{Name: "Nowhere", Latitude: 95, Longitude: 0},
+		},
+	})
+	require.ErrorIs(t, err, models.ErrInvalidCatalog)
+	assert.Contains(t, err.Error(), "duplicate code STANDARD")
+	assert.Contains(t, err.Error(), "EXPRESS: base_rate must be positive")
+	assert.Contains(t, err.Error(), "duplicate name moscow")
+	assert.Contains(t, err.Error(), "Nowhere: coordinates")
+}
+
+func TestDiffCatalog(t *testing.T) {
+	limited := catalogTariff("EXPRESS", 600)
+	limited.Limits.MaxWeight = 30
+	live := models.Catalog{
+		Tariffs: []models.Tariff{catalogTariff("STANDARD", 300), catalogTariff("EXPRESS", 600), catalogTariff("ECONOMY", 200)},
+		Cities: []models.CountryCoordinates{
+			{Name: "Moscow", Code: "MSK", Latitude: 55.75, Longitude: 37.62, Aliases: []string{}},
+			{Name: "Kazan", Latitude: 55.79, Longitude: 49.12},
+		},
+	}
+	live.Tariffs[0].Version = 3
+	wanted := models.Catalog{
+		Tariffs: []models.Tariff{catalogTariff("STANDARD", 300), limited, catalogTariff("CARGO", 900)},
+		Cities:  []models.CountryCoordinates{{Name: "Moscow", Code: "MSK", Latitude: 55.75, Longitude: 37.62}},
+	}
+
+	changes := service.DiffCatalog(live, wanted, false)
+	require.Len(t, changes, 2, "versions and empty lists are not changes; nothing is deleted without prune")
+	assert.Equal(t, models.CatalogChange{Kind: "tariff", Key: "CARGO", Action: models.CatalogCreate}, changes[0])
+	assert.Equal(t, models.CatalogChange{Kind: "tariff", Key: "EXPRESS", Action: models.CatalogUpdate, Fields: []string{"limits.max_weight"}}, changes[1])
+
+	changes = service.DiffCatalog(live, wanted, true)
+	require.Len(t, changes, 4)
+	assert.Equal(t, "- tariff ECONOMY", changes[1].String())
+	assert.Equal(t, "~ tariff EXPRESS [limits.max_weight]", changes[2].String())
+	assert.Equal(t, "- city Kazan", changes[3].String())
+
+	// a catalog of tariffs alone leaves the cities alone, even with prune
+	changes = service.DiffCatalog(live, models.Catalog{Tariffs: live.Tariffs}, true)
+	assert.Empty(t, changes)
+}
+
+func TestCatalogYAML_RoundTrip(t *testing.T) {
+	tariff := catalogTariff("EXPRESS", 600)
+	tariff.Limits = models.TariffLimits{MaxWeight: 30, Destinations: []string{"Moscow", "Saint Petersburg"}}
+	tariff.Version = 4
+	catalog := models.Catalog{
+		Tariffs: []models.Tariff{tariff},
+		Cities:  []models.CountryCoordinates{{Name: "Moscow", Code: "MSK", Latitude: 55.75, Longitude: 37.62, Zone: "RU-C", Aliases: []string{"Москва"}}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, service.WriteCatalogYAML(&buf, catalog))
+	assert.NotContains(t, buf.String(), "version")
+
+	parsed, err := service.ParseCatalogYAML(&buf)
+	require.NoError(t, err)
+	assert.Empty(t, service.DiffCatalog(catalog, parsed, true))
+
+	_, err = service.ParseCatalogYAML(strings.NewReader("tariffs:\n  - code: X\n    base_rat: 10\n"))
+	assert.Error(t, err, "misspelt keys are rejected")
+}
+
+func TestCatalogCSV_RoundTrip(t *testing.T) {
+	tariff := catalogTariff("EXPRESS", 600.5)
+	tariff.PricingMode = models.PricingZone
+	tariff.FuelSurcharge = true
+	tariff.Limits = models.TariffLimits{MaxDimension: 120, Origins: []string{"Moscow", "Kazan"}, ProhibitedCategories: []string{"batteries"}}
+	cities := []models.CountryCoordinates{{Name: "Saint Petersburg", Code: "SPB", Latitude: 59.94, Longitude: 30.31, Aliases: []string{"Piter", "СПб"}}}
+
+	var buf bytes.Buffer
+	require.NoError(t, service.WriteTariffsCSV(&buf, []models.Tariff{tariff}))
+	tariffs, err := service.ParseTariffsCSV(&buf)
+	require.NoError(t, err)
+	require.Len(t, tariffs, 1)
+	assert.Equal(t, tariff, tariffs[0])
+
+	buf.Reset()
+	require.NoError(t, service.WriteCitiesCSV(&buf, cities))
+	parsed, err := service.ParseCitiesCSV(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, cities, parsed)
+
+	_, err = service.ParseCitiesCSV(strings.NewReader("Moscow,MSK,north,37.62,,"))
+	assert.Error(t, err)
+}
+
+func TestCatalogManager_Apply(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+	standard := catalogTariff("STANDARD", 300)
+	economy := catalogTariff("ECONOMY", 200)
+	tariffRepo.On("GetAll", mock.Anything).Return([]models.Tariff{standard, economy}, nil)
+	tariffRepo.On("GetByCode", mock.Anything, mock.Anything).Return(&standard, nil)
+	countryRepo.On("GetAll", mock.Anything).Return([]models.CountryCoordinates{{Name: "Moscow", Latitude: 55.75, Longitude: 37.62}}, nil)
+
+	tx := &fakeTransactor{}
+	manager := service.NewCatalogManager(service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo}), tx)
+	catalog := models.Catalog{
+		Tariffs: []models.Tariff{catalogTariff("STANDARD", 350), economy},
+		Cities:  []models.CountryCoordinates{{Name: "Moscow", Latitude: 55.75, Longitude: 37.62}, {Name: "Kazan", Latitude: 55.79, Longitude: 49.12}},
+	}
+
+	changes, err := manager.Diff(context.Background(), catalog, false)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	tariffRepo.AssertNotCalled(t, "CreateTariff", mock.Anything, mock.Anything)
+
+	tariffRepo.On("CreateTariff", mock.Anything, mock.MatchedBy(func(t *models.Tariff) bool {
+		return t.Code == "STANDARD" && t.BaseRate == 350
+	})).Return(nil, nil).Once()
+	countryRepo.On("Upsert", mock.Anything, catalog.Cities[1]).Return(errors.New("write conflict")).Once()
+
+	changes, err = manager.Apply(context.Background(), catalog, false)
+	assert.ErrorContains(t, err, "city Kazan: write conflict")
+	assert.Nil(t, changes)
+	assert.Equal(t, 1, tx.calls)
+
+	_, err = manager.Apply(context.Background(), models.Catalog{Tariffs: []models.Tariff{catalogTariff("STANDARD", 0)}}, false)
+	assert.ErrorIs(t, err, models.ErrInvalidCatalog)
+	assert.Equal(t, 1, tx.calls, "an invalid catalog never opens a transaction")
+
+	tariffRepo.On("DeleteTariff", mock.Anything, "ECONOMY").Return(nil).Once()
+	changes, err = manager.Apply(context.Background(), models.Catalog{Tariffs: []models.Tariff{standard}}, true)
+	require.NoError(t, err)
+	assert.Equal(t, []models.CatalogChange{{Kind: "tariff", Key: "ECONOMY", Action: models.CatalogDelete}}, changes)
+	tariffRepo.AssertExpectations(t)
+	countryRepo.AssertExpectations(t)
+}
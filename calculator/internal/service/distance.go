@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/graph"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// DistanceProvider measures how far a parcel travels between two cities.
+type DistanceProvider interface {
+	Distance(ctx context.Context, from, to *models.CountryCoordinates) (float64, error)
+}
+
+// HaversineProvider takes the great-circle distance between city centres.
+type HaversineProvider struct{}
+
+func (HaversineProvider) Distance(_ context.Context, from, to *models.CountryCoordinates) (float64, error) {
+	return haversine(from.Latitude, from.Longitude, to.Latitude, to.Longitude), nil
+}
+
+// RoadGraphProvider finds the shortest road distance over a city-to-city
+// graph. Results are cached per city pair since the graph never changes
+// once loaded.
+type RoadGraphProvider struct {
+	graph *graph.Graph
+
+	mu    sync.RWMutex
+	cache map[[2]string]roadDistance
+}
+
+type roadDistance struct {
+	km float64
+	ok bool
+}
+
+func NewRoadGraphProvider(g *graph.Graph) *RoadGraphProvider {
+	return &RoadGraphProvider{
+		graph: g,
+		cache: make(map[[2]string]roadDistance),
+	}
+}
+
+func (p *RoadGraphProvider) Distance(_ context.Context, from, to *models.CountryCoordinates) (float64, error) {
+	a, b := models.SearchKey(from.Name), models.SearchKey(to.Name)
+	if a == b {
+		return 0, nil
+	}
+	if b < a {
+		a, b = b, a
+	}
+	key := [2]string{a, b}
+
+	p.mu.RLock()
+	cached, hit := p.cache[key]
+	p.mu.RUnlock()
+	if !hit {
+		_, km, err := p.graph.ShortestPath(a, b, nil)
+		if err != nil && !errors.Is(err, graph.ErrNoPath) {
+			return 0, err
+		}
+		cached = roadDistance{km: km, ok: err == nil}
+		p.mu.Lock()
+		p.cache[key] = cached
+		p.mu.Unlock()
+	}
+	if !cached.ok {
+		return 0, fmt.Errorf("%w: %s -> %s", models.ErrNoRoadRoute, from.Name, to.Name)
+	}
+	return cached.km, nil
+}
+
+// LoadRoadGraph reads a CSV of from,to,distance_km road segments; a header
+// line is skipped. Segments are two-way.
+func LoadRoadGraph(path string) (*graph.Graph, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseRoadGraph(f)
+}
+
+func ParseRoadGraph(r io.Reader) (*graph.Graph, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	g := graph.New()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return g, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "from") {
+			continue
+		}
+		from, to := models.SearchKey(record[0]), models.SearchKey(record[1])
+		if from == "" || to == "" || from == to {
+			return nil, fmt.Errorf("line %d: invalid segment %q -> %q", line, record[0], record[1])
+		}
+		km, err := strconv.ParseFloat(record[2], 64)
+		if err != nil || km <= 0 {
+			return nil, fmt.Errorf("line %d: invalid distance %q", line, record[2])
+		}
+		g.AddEdge(from, to, km)
+		g.AddEdge(to, from, km)
+	}
+}
+
+// distance measures the lane with the tariff's provider, haversine unless
+// the tariff says otherwise.
+func (c *DefaultCalculator) distance(ctx context.Context, tariff models.Tariff, from, to *models.CountryCoordinates) (float64, error) {
+	name := tariff.DistanceProvider
+	if name == "" {
+		name = models.DistanceHaversine
+	}
+	provider, ok := c.distances[name]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", models.ErrUnknownDistanceProvider, name)
+	}
+	return provider.Distance(ctx, from, to)
+}
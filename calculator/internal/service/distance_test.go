@@ -0,0 +1,129 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const roadEdges = `from,to,distance_km
+# comments are skipped
+Germany,Poland,573
+Poland,Belarus,546
+Belarus,Russia,717
+Germany,France,1054
+`
+
+func TestRoadGraphProvider_Distance(t *testing.T) {
+	g, err := service.ParseRoadGraph(strings.NewReader(roadEdges))
+	assert.NoError(t, err)
+	provider := service.NewRoadGraphProvider(g)
+
+	km, err := provider.Distance(context.Background(), &models.CountryCoordinates{Name: "France"}, &models.CountryCoordinates{Name: "russia"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1054.0+573+546+717, km)
+
+	again, err := provider.Distance(context.Background(), &models.CountryCoordinates{Name: "Russia"}, &models.CountryCoordinates{Name: "France"})
+	assert.NoError(t, err)
+	assert.Equal(t, km, again)
+
+	_, err = provider.Distance(context.Background(), &models.CountryCoordinates{Name: "France"}, &models.CountryCoordinates{Name: "Iceland"})
+	assert.ErrorIs(t, err, models.ErrNoRoadRoute)
+}
+
+func TestParseRoadGraph_Invalid(t *testing.T) {
+	_, err := service.ParseRoadGraph(strings.NewReader("Germany,Poland,-5\n"))
+	assert.Error(t, err)
+	_, err = service.ParseRoadGraph(strings.NewReader("Germany,Germany,10\n"))
+	assert.Error(t, err)
+}
+
+func TestExtendedCalculator_RoadDistanceProvider(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Name: "France", Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Germany").Return(&models.CountryCoordinates{Name: "Germany", Latitude: 52.52, Longitude: 13.40}, nil)
+	base := models.Tariff{
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         80,
+	}
+	air, road := base, base
+	air.Code, air.Name = "AIR", "Air"
+	road.Code, road.Name, road.DistanceProvider = "ROAD", "Road", models.DistanceRoad
+	tariffRepo.On("GetByCode", mock.Anything, "AIR").Return(&air, nil)
+	tariffRepo.On("GetByCode", mock.Anything, "ROAD").Return(&road, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+	pkg := models.Package{From: "France", To: "Germany", Weight: 1, Length: 10, Width: 10, Height: 10}
+
+	_, err := calc.CalculateByTariffCode(context.Background(), pkg, "ROAD")
+	assert.ErrorIs(t, err, models.ErrUnknownDistanceProvider)
+
+	g, err := service.ParseRoadGraph(strings.NewReader(roadEdges))
+	assert.NoError(t, err)
+	calc = service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   tariffRepo,
+		Distances: map[string]service.DistanceProvider{models.DistanceRoad: service.NewRoadGraphProvider(g)},
+	})
+
+	byAir, err := calc.CalculateByTariffCode(context.Background(), pkg, "AIR")
+	assert.NoError(t, err)
+	byRoad, err := calc.CalculateByTariffCode(context.Background(), pkg, "ROAD")
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 878, byAir.DistanceKm, 5)
+	assert.Equal(t, 1054.0, byRoad.DistanceKm)
+	assert.Greater(t, byRoad.Cost, byAir.Cost)
+	assert.Greater(t, byRoad.EstimatedHours, byAir.EstimatedHours)
+}
+
+func TestExtendedCalculator_RoadTariffIgnoresHubRoute(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Name: "France", Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Germany").Return(&models.CountryCoordinates{Name: "Germany", Latitude: 52.52, Longitude: 13.40}, nil)
+	tariffRepo.On("GetByCode", mock.Anything, "ROAD").Return(&models.Tariff{
+		Code:              "ROAD",
+		Name:              "Road",
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         80,
+		DistanceProvider:  models.DistanceRoad,
+	}, nil)
+
+	g, err := service.ParseRoadGraph(strings.NewReader(roadEdges))
+	assert.NoError(t, err)
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   tariffRepo,
+		Router:    service.NewHubRouter(hubNetwork(), countryRepo),
+		Distances: map[string]service.DistanceProvider{models.DistanceRoad: service.NewRoadGraphProvider(g)},
+	})
+
+	result, err := calc.CalculateByTariffCode(context.Background(), models.Package{From: "France", To: "Germany", Weight: 1, Length: 10, Width: 10, Height: 10}, "ROAD")
+	assert.NoError(t, err)
+	assert.Empty(t, result.Legs)
+	assert.Equal(t, 1054.0, result.DistanceKm)
+
+	codes := map[string]float64{}
+	for _, item := range result.LineItems {
+		codes[item.Code] = item.Amount
+	}
+	assert.NotContains(t, codes, "hub_legs")
+	assert.Equal(t, 1054.0, codes["distance"])
+}
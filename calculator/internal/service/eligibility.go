@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// eligibility reports every limit of the tariff the parcel breaks as a
+// single IneligibleError.
+func eligibility(tariff models.Tariff, pkg models.Package, from, to *models.CountryCoordinates) error {
+	volumetric, _ := weights(tariff, pkg)
+	if reasons := tariff.Limits.Check(pkg, volumetric, from, to); len(reasons) > 0 {
+		return &models.IneligibleError{Tariff: tariff.Code, Reasons: reasons}
+	}
+	return nil
+}
+
+// GetEligibleTariffs narrows the tariffs in force to those that accept the
+// parcel. Either city may be left empty to skip its route limits.
+func (c *ExtendedCalculator) GetEligibleTariffs(ctx context.Context, pkg models.Package) ([]models.Tariff, error) {
+	tariffs, err := c.tariffRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var from, to *models.CountryCoordinates
+	if pkg.From != "" {
+		if from, err = c.repository.GetCoordinates(ctx, pkg.From); err != nil {
+			return nil, c.cityError(ctx, "from", pkg.From, err)
+		}
+	}
+	if pkg.To != "" {
+		if to, err = c.repository.GetCoordinates(ctx, pkg.To); err != nil {
+			return nil, c.cityError(ctx, "to", pkg.To, err)
+		}
+	}
+
+	var eligible []models.Tariff
+	for _, tariff := range tariffs {
+		if eligibility(tariff, pkg, from, to) == nil {
+			eligible = append(eligible, tariff)
+		}
+	}
+	return eligible, nil
+}
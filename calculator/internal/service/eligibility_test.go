@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTariffLimits_Check(t *testing.T) {
+	limits := models.TariffLimits{
+		MaxWeight:            30,
+		MaxVolumetricWeight:  25,
+		MaxDimension:         120,
+		MaxGirth:             300,
+		Origins:              []string{"EU"},
+		Destinations:         []string{"Москва", "GB"},
+		ProhibitedCategories: []string{"Batteries"},
+	}
+	paris := &models.CountryCoordinates{Name: "Paris", Zone: "EU"}
+	moscow := &models.CountryCoordinates{Name: "Moscow", Zone: "RU", Aliases: []string{"Москва"}}
+	london := &models.CountryCoordinates{Name: "London", Code: "GB", Zone: "UK"}
+	tokyo := &models.CountryCoordinates{Name: "Tokyo", Zone: "ASIA"}
+
+	ok := models.Package{Weight: 10, Length: 50, Width: 40, Height: 30, Category: "books"}
+	assert.Empty(t, limits.Check(ok, 12, paris, moscow))
+	assert.Empty(t, limits.Check(ok, 12, paris, london))
+	assert.Empty(t, limits.Check(ok, 12, nil, nil), "unresolved ends skip route limits")
+
+	bad := models.Package{Weight: 40, Length: 130, Width: 60, Height: 50, Category: "batteries"}
+	reasons := limits.Check(bad, 97.5, tokyo, tokyo)
+	assert.Len(t, reasons, 7)
+	assert.Contains(t, reasons[3], "girth 350 cm")
+
+	longest, girth := models.Girth(models.Package{Length: 10, Width: 100, Height: 20})
+	assert.Equal(t, 100, longest)
+	assert.Equal(t, 160, girth)
+}
+
+func TestExtendedCalculator_IneligibleTariff(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Name: "France", Latitude: 48.85, Longitude: 2.35, Zone: "EU"}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Name: "UK", Latitude: 51.51, Longitude: -0.13, Zone: "UK"}, nil)
+
+	base := models.Tariff{
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         60,
+	}
+	express, freight := base, base
+	express.Code, express.Name = "EXPRESS", "Express"
+	express.Limits = models.TariffLimits{MaxWeight: 20, Destinations: []string{"EU"}}
+	freight.Code, freight.Name = "FREIGHT", "Freight"
+	tariffRepo.On("GetByCode", mock.Anything, "EXPRESS").Return(&express, nil)
+	tariffRepo.On("GetAll", mock.Anything).Return([]models.Tariff{express, freight}, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+	crate := models.Package{From: "France", To: "UK", Weight: 500, Length: 100, Width: 80, Height: 80}
+
+	_, err := calc.CalculateByTariffCode(context.Background(), crate, "EXPRESS")
+	assert.ErrorIs(t, err, models.ErrNotEligible)
+	var ineligible *models.IneligibleError
+	assert.True(t, errors.As(err, &ineligible))
+	assert.Equal(t, "EXPRESS", ineligible.Tariff)
+	assert.Len(t, ineligible.Reasons, 2)
+
+	quotes, err := calc.QuoteAllTariffs(context.Background(), crate)
+	assert.NoError(t, err)
+	assert.Equal(t, "FREIGHT", quotes[0].TariffCode)
+	assert.False(t, quotes[1].Eligible)
+	assert.Contains(t, quotes[1].Reason, "weight 500.00 kg exceeds 20.00 kg")
+
+	eligible, err := calc.GetEligibleTariffs(context.Background(), models.Package{Weight: 5, To: "UK"})
+	assert.NoError(t, err)
+	assert.Len(t, eligible, 1)
+	assert.Equal(t, "FREIGHT", eligible[0].Code)
+
+	eligible, err = calc.GetEligibleTariffs(context.Background(), models.Package{Weight: 5})
+	assert.NoError(t, err)
+	assert.Len(t, eligible, 2)
+}
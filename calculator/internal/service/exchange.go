@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// convert re-prices the result in the target currency. Every line item is
+// converted and rounded on its own and the cost is their sum, so the
+// breakdown still adds up after conversion.
+func (c *DefaultCalculator) convert(ctx context.Context, result models.CalculationResult, target string) (models.CalculationResult, error) {
+	target = strings.ToUpper(strings.TrimSpace(target))
+	if target == "" || strings.EqualFold(target, result.Currency) {
+		return result, nil
+	}
+	at := result.PricedAt
+	if at.IsZero() {
+		at = c.now()
+	}
+	rate, err := c.exchangeRate(ctx, strings.ToUpper(result.Currency), target, at)
+	if err != nil {
+		return models.CalculationResult{}, err
+	}
+
+	converted := result
+	converted.LineItems = make([]models.LineItem, 0, len(result.LineItems))
+	var total float64
+	for _, item := range result.LineItems {
+		item.Amount = models.RoundAmount(item.Amount*rate, target)
+		total += item.Amount
+		converted.LineItems = append(converted.LineItems, item)
+	}
+	converted.Cost = models.RoundAmount(total, target)
+	converted.Currency = target
+	converted.OriginalCost = result.Cost
+	converted.OriginalCurrency = result.Currency
+	converted.ExchangeRate = rate
+	return converted, nil
+}
+
+// exchangeRate uses the direct rate for the pair and falls back to the
+// inverse of the opposite one, rounded to six places.
+func (c *DefaultCalculator) exchangeRate(ctx context.Context, base, quote string, at time.Time) (float64, error) {
+	if c.rates == nil {
+		return 0, fmt.Errorf("%w: %s -> %s", models.ErrExchangeRateNotFound, base, quote)
+	}
+	rate, err := c.rates.GetRate(ctx, base, quote, at)
+	if err == nil {
+		return rate.Rate, nil
+	}
+	if !errors.Is(err, models.ErrExchangeRateNotFound) {
+		return 0, err
+	}
+	inverse, err := c.rates.GetRate(ctx, quote, base, at)
+	if err != nil {
+		if errors.Is(err, models.ErrExchangeRateNotFound) {
+			return 0, fmt.Errorf("%w: %s -> %s", models.ErrExchangeRateNotFound, base, quote)
+		}
+		return 0, err
+	}
+	return math.Round(1/inverse.Rate*1e6) / 1e6, nil
+}
+
+// LoadExchangeRates reads a CSV of date,base,quote,rate lines; a header line
+// is skipped.
+func LoadExchangeRates(path string) ([]models.ExchangeRate, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseExchangeRates(f)
+}
+
+func ParseExchangeRates(r io.Reader) ([]models.ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+	reader.Comment = '#'
+
+	var rates []models.ExchangeRate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rates, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "date") {
+			continue
+		}
+		date, err := time.Parse("2006-01-02", record[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, record[0])
+		}
+		value, err := strconv.ParseFloat(record[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid rate %q", line, record[3])
+		}
+		rate := models.ExchangeRate{Base: record[1], Quote: record[2], Rate: value, Date: date}
+		rate.Normalize()
+		if err := rate.Validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		rates = append(rates, rate)
+	}
+}
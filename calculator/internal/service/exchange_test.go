@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockExchangeRepo struct {
+	mock.Mock
+}
+
+func (m *mockExchangeRepo) GetRate(ctx context.Context, base, quote string, at time.Time) (*models.ExchangeRate, error) {
+	args := m.Called(ctx, base, quote)
+	rate, _ := args.Get(0).(*models.ExchangeRate)
+	return rate, args.Error(1)
+}
+
+func (m *mockExchangeRepo) GetAll(ctx context.Context) ([]models.ExchangeRate, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.ExchangeRate), args.Error(1)
+}
+
+func (m *mockExchangeRepo) SetRates(ctx context.Context, rates []models.ExchangeRate) error {
+	return nil
+}
+
+func TestExtendedCalculator_TargetCurrency(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "Moscow").Return(&models.CountryCoordinates{Latitude: 55.75, Longitude: 37.61}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Saint Petersburg").Return(&models.CountryCoordinates{Latitude: 59.93, Longitude: 30.31}, nil)
+
+	rates := new(mockExchangeRepo)
+	rates.On("GetRate", mock.Anything, "USD", "RUB").Return(&models.ExchangeRate{Base: "USD", Quote: "RUB", Rate: 80}, nil)
+	rates.On("GetRate", mock.Anything, "RUB", "USD").Return(nil, models.ErrExchangeRateNotFound)
+	rates.On("GetRate", mock.Anything, "RUB", "JPY").Return(&models.ExchangeRate{Base: "RUB", Quote: "JPY", Rate: 1.537}, nil)
+	rates.On("GetRate", mock.Anything, "RUB", "GBP").Return(nil, models.ErrExchangeRateNotFound)
+	rates.On("GetRate", mock.Anything, "GBP", "RUB").Return(nil, models.ErrExchangeRateNotFound)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   new(mockTariffRepo),
+		Rates:     rates,
+		Clock:     func() time.Time { return time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC) },
+	})
+	pkg := models.Package{From: "Moscow", To: "Saint Petersburg", Weight: 3, Length: 30, Width: 20, Height: 10}
+
+	original, err := calc.Calculate(context.Background(), pkg)
+	assert.NoError(t, err)
+	assert.Equal(t, "RUB", original.Currency)
+	assert.Zero(t, original.ExchangeRate)
+
+	pkg.TargetCurrency = "usd"
+	converted, err := calc.Calculate(context.Background(), pkg)
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", converted.Currency)
+	assert.Equal(t, "RUB", converted.OriginalCurrency)
+	assert.Equal(t, original.Cost, converted.OriginalCost)
+	assert.Equal(t, 0.0125, converted.ExchangeRate)
+	var sum float64
+	for i, item := range converted.LineItems {
+		assert.Equal(t, models.RoundAmount(original.LineItems[i].Amount*0.0125, "USD"), item.Amount)
+		sum += item.Amount
+	}
+	assert.Equal(t, models.RoundAmount(sum, "USD"), converted.Cost)
+
+	pkg.TargetCurrency = "JPY"
+	yen, err := calc.Calculate(context.Background(), pkg)
+	assert.NoError(t, err)
+	for _, item := range yen.LineItems {
+		assert.Equal(t, float64(int(item.Amount)), item.Amount)
+	}
+
+	pkg.TargetCurrency = "GBP"
+	_, err = calc.Calculate(context.Background(), pkg)
+	assert.ErrorIs(t, err, models.ErrExchangeRateNotFound)
+}
+
+func TestParseExchangeRates(t *testing.T) {
+	rates, err := service.ParseExchangeRates(strings.NewReader("date,base,quote,rate\n2025-01-01, usd, rub, 101.68\n# comment\n2025-02-01,EUR,RUB,106.1\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ExchangeRate{
+		{Base: "USD", Quote: "RUB", Rate: 101.68, Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Base: "EUR", Quote: "RUB", Rate: 106.1, Date: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}, rates)
+
+	_, err = service.ParseExchangeRates(strings.NewReader("2025-01-01,USD,USD,1\n"))
+	assert.Error(t, err)
+}
+
+func TestRoundAmount(t *testing.T) {
+	assert.Equal(t, 1.01, models.RoundAmount(1.005, "USD"))
+	assert.Equal(t, -2.5, models.RoundAmount(-2.499, "EUR"))
+	assert.Equal(t, 13.0, models.RoundAmount(12.5, "JPY"))
+}
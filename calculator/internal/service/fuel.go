@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/sirupsen/logrus"
+)
+
+// fuelRate is the index percentage in force at the instant; before the
+// first entry there is no surcharge.
+func (c *DefaultCalculator) fuelRate(ctx context.Context, at time.Time) float64 {
+	if c.fuel == nil {
+		return 0
+	}
+	entry, err := c.fuel.GetAt(ctx, at)
+	if err != nil {
+		if !errors.Is(err, models.ErrFuelIndexNotFound) {
+			logrus.Printf("Failed to load fuel surcharge index, pricing without it: %v", err)
+		}
+		return 0
+	}
+	return entry.Percent
+}
+
+// addFuelSurcharge charges the percentage on everything priced so far and
+// records the rate, so the invoice can be reproduced later.
+func addFuelSurcharge(result *models.CalculationResult, tariff models.Tariff, percent float64) {
+	if !tariff.FuelSurcharge || percent <= 0 {
+		return
+	}
+	result.FuelSurchargeRate = percent
+	result.AddLineItems(models.LineItem{
+		Code:        "fuel",
+		Description: fmt.Sprintf("Fuel surcharge %g%%", percent),
+		Amount:      result.Cost * percent / 100,
+	})
+}
@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockFuelIndexRepo struct {
+	mock.Mock
+}
+
+func (m *mockFuelIndexRepo) GetAt(ctx context.Context, at time.Time) (*models.FuelIndex, error) {
+	args := m.Called(ctx, at)
+	if entry := args.Get(0); entry != nil {
+		return entry.(*models.FuelIndex), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockFuelIndexRepo) GetAll(ctx context.Context) ([]models.FuelIndex, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.FuelIndex), args.Error(1)
+}
+
+func (m *mockFuelIndexRepo) Set(ctx context.Context, entries []models.FuelIndex) error {
+	return m.Called(ctx, entries).Error(0)
+}
+
+func TestExtendedCalculator_FuelSurcharge(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "Moscow").Return(&models.CountryCoordinates{Name: "Moscow", Latitude: 55.75, Longitude: 37.62}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Kazan").Return(&models.CountryCoordinates{Name: "Kazan", Latitude: 55.79, Longitude: 49.12}, nil)
+	countryRepo.On("GetNames", mock.Anything).Return([]string{"Moscow", "Kazan"}, nil)
+	standard := catalogTariff("STANDARD", 300)
+	fuelled := catalogTariff("EXPRESS", 300)
+	fuelled.FuelSurcharge = true
+	tariffRepo.On("GetByCode", mock.Anything, "STANDARD").Return(&standard, nil)
+	tariffRepo.On("GetByCode", mock.Anything, "EXPRESS").Return(&fuelled, nil)
+
+	now := time.Date(2026, 10, 17, 10, 0, 0, 0, time.UTC)
+	fuel := new(mockFuelIndexRepo)
+	fuel.On("GetAt", mock.Anything, now).Return(&models.FuelIndex{Percent: 12.5, EffectiveFrom: now.AddDate(0, 0, -3)}, nil).Once()
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   tariffRepo,
+		Fuel:      fuel,
+		Clock:     func() time.Time { return now },
+	})
+	pkg := models.Package{From: "Moscow", To: "Kazan", Weight: 1, Length: 10, Width: 10, Height: 10}
+
+	plain, err := calc.CalculateByTariffCode(context.Background(), pkg, "STANDARD")
+	require.NoError(t, err)
+	assert.Zero(t, plain.FuelSurchargeRate, "tariffs that don't opt in pay no surcharge")
+	for _, item := range plain.LineItems {
+		assert.NotEqual(t, "fuel", item.Code)
+	}
+
+	fuel.On("GetAt", mock.Anything, now).Return(&models.FuelIndex{Percent: 12.5, EffectiveFrom: now.AddDate(0, 0, -3)}, nil).Once()
+	result, err := calc.CalculateByTariffCode(context.Background(), pkg, "EXPRESS")
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, result.FuelSurchargeRate)
+	last := result.LineItems[len(result.LineItems)-1]
+	assert.Equal(t, "fuel", last.Code)
+	assert.Equal(t, "Fuel surcharge 12.5%", last.Description)
+	assert.InDelta(t, plain.Cost*0.125, last.Amount, 0.01)
+	assert.InDelta(t, plain.Cost+last.Amount, result.Cost, 0.01)
+
+	// before the first entry of the index there is nothing to charge
+	fuel.On("GetAt", mock.Anything, now).Return(nil, models.ErrFuelIndexNotFound).Once()
+	result, err = calc.CalculateByTariffCode(context.Background(), pkg, "EXPRESS")
+	require.NoError(t, err)
+	assert.Zero(t, result.FuelSurchargeRate)
+	assert.Equal(t, plain.Cost, result.Cost)
+	fuel.AssertExpectations(t)
+}
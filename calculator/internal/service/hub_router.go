@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/graph"
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+var ErrNoHubRoute = errors.New("no hub route between locations")
+
+type HubRouter interface {
+	Route(ctx context.Context, from, to string) (*models.HubRoute, error)
+	// Invalidate drops the cached hub network after it has been changed.
+	Invalidate()
+}
+
+// hubNetworkTTL bounds how long a cached network can miss changes made
+// through other replicas.
+const hubNetworkTTL = time.Minute
+
+type hubRouter struct {
+	hubs      repository.HubRepository
+	countries repository.CountryRepository
+	now       func() time.Time
+
+	mu      sync.Mutex
+	network *hubNetwork
+}
+
+// hubNetwork is the routing graph built from the stored hubs and links.
+type hubNetwork struct {
+	hubs       []models.Hub
+	byCode     map[string]models.Hub
+	graph      *graph.Graph
+	linkByPair map[[2]string]models.HubLink
+	maxSpeed   float64
+	loadedAt   time.Time
+}
+
+func NewHubRouter(hubs repository.HubRepository, countries repository.CountryRepository) HubRouter {
+	return &hubRouter{
+		hubs:      hubs,
+		countries: countries,
+		now:       time.Now,
+	}
+}
+
+func (r *hubRouter) Invalidate() {
+	r.mu.Lock()
+	r.network = nil
+	r.mu.Unlock()
+}
+
+// Route finds the fastest leg sequence between the hubs serving from and to.
+// Links are treated as two-way, weighted by transit time; A* uses the
+// great-circle distance at the fastest link speed as its heuristic.
+func (r *hubRouter) Route(ctx context.Context, from, to string) (*models.HubRoute, error) {
+	network, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(network.hubs) == 0 {
+		return nil, ErrNoHubRoute
+	}
+
+	origin, err := r.hubFor(ctx, network.hubs, from)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := r.hubFor(ctx, network.hubs, to)
+	if err != nil {
+		return nil, err
+	}
+	if origin.Code == destination.Code {
+		return nil, ErrNoHubRoute
+	}
+
+	byCode := network.byCode
+	var heuristic func(string) float64
+	if network.maxSpeed > 0 {
+		heuristic = func(code string) float64 {
+			return hubDistance(byCode[code], destination) / network.maxSpeed
+		}
+	}
+
+	path, _, err := network.graph.ShortestPath(origin.Code, destination.Code, heuristic)
+	if err != nil {
+		return nil, ErrNoHubRoute
+	}
+
+	route := &models.HubRoute{}
+	for i := 0; i < len(path)-1; i++ {
+		link := network.linkByPair[[2]string{path[i], path[i+1]}]
+		leg := models.Leg{
+			FromHub:      path[i],
+			ToHub:        path[i+1],
+			DistanceKm:   math.Round(hubDistance(byCode[path[i]], byCode[path[i+1]])*100) / 100,
+			TransitHours: link.TransitHours,
+			Cost:         link.Cost,
+		}
+		route.Legs = append(route.Legs, leg)
+		route.TotalDistance += leg.DistanceKm
+		route.TotalHours += leg.TransitHours
+		route.TotalCost += leg.Cost
+	}
+	return route, nil
+}
+
+// load returns the cached network, rebuilding it when it was invalidated
+// or has outlived hubNetworkTTL. The graph is read-only once built.
+func (r *hubRouter) load(ctx context.Context) (*hubNetwork, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.network != nil && r.now().Sub(r.network.loadedAt) < hubNetworkTTL {
+		return r.network, nil
+	}
+
+	hubs, err := r.hubs.GetHubs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	links, err := r.hubs.GetLinks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	network := &hubNetwork{
+		hubs:       hubs,
+		byCode:     make(map[string]models.Hub, len(hubs)),
+		graph:      graph.New(),
+		linkByPair: make(map[[2]string]models.HubLink, len(links)*2),
+		loadedAt:   r.now(),
+	}
+	for _, h := range hubs {
+		network.byCode[h.Code] = h
+	}
+	for _, l := range links {
+		a, okA := network.byCode[l.From]
+		b, okB := network.byCode[l.To]
+		if !okA || !okB || l.TransitHours <= 0 {
+			continue
+		}
+		network.graph.AddEdge(l.From, l.To, l.TransitHours)
+		network.graph.AddEdge(l.To, l.From, l.TransitHours)
+		network.linkByPair[[2]string{l.From, l.To}] = l
+		network.linkByPair[[2]string{l.To, l.From}] = l
+		network.maxSpeed = math.Max(network.maxSpeed, hubDistance(a, b)/l.TransitHours)
+	}
+	r.network = network
+	return network, nil
+}
+
+// hubFor picks the hub located in the given country, or the nearest hub to
+// its coordinates when the country has none.
+func (r *hubRouter) hubFor(ctx context.Context, hubs []models.Hub, location string) (models.Hub, error) {
+	for _, h := range hubs {
+		if strings.EqualFold(h.Country, location) {
+			return h, nil
+		}
+	}
+
+	coords, err := r.countries.GetCoordinates(ctx, location)
+	if err != nil {
+		return models.Hub{}, err
+	}
+	nearest, best := hubs[0], math.Inf(1)
+	for _, h := range hubs {
+		if d := haversine(coords.Latitude, coords.Longitude, h.Latitude, h.Longitude); d < best {
+			nearest, best = h, d
+		}
+	}
+	return nearest, nil
+}
+
+func hubDistance(a, b models.Hub) float64 {
+	return haversine(a.Latitude, a.Longitude, b.Latitude, b.Longitude)
+}
@@ -0,0 +1,143 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockHubRepo struct {
+	mock.Mock
+}
+
+func (m *mockHubRepo) GetHubs(ctx context.Context) ([]models.Hub, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Hub), args.Error(1)
+}
+
+func (m *mockHubRepo) GetLinks(ctx context.Context) ([]models.HubLink, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.HubLink), args.Error(1)
+}
+
+func (m *mockHubRepo) CreateHub(ctx context.Context, hub *models.Hub) (*models.Hub, error) {
+	return hub, nil
+}
+
+func (m *mockHubRepo) CreateLink(ctx context.Context, link *models.HubLink) (*models.HubLink, error) {
+	return link, nil
+}
+
+func hubNetwork() *mockHubRepo {
+	repo := new(mockHubRepo)
+	repo.On("GetHubs", mock.Anything).Return([]models.Hub{
+		{Code: "PAR", Country: "France", Latitude: 48.85, Longitude: 2.35},
+		{Code: "BRU", Country: "Belgium", Latitude: 50.85, Longitude: 4.35},
+		{Code: "LON", Country: "UK", Latitude: 51.51, Longitude: -0.13},
+		{Code: "BER", Country: "Germany", Latitude: 52.52, Longitude: 13.40},
+	}, nil)
+	repo.On("GetLinks", mock.Anything).Return([]models.HubLink{
+		{From: "PAR", To: "LON", TransitHours: 20, Cost: 100},
+		{From: "PAR", To: "BRU", TransitHours: 4, Cost: 30},
+		{From: "BRU", To: "LON", TransitHours: 6, Cost: 40},
+		{From: "BRU", To: "BER", TransitHours: 10, Cost: 60},
+	}, nil)
+	return repo
+}
+
+func TestHubRouter_PicksFastestLegs(t *testing.T) {
+	router := service.NewHubRouter(hubNetwork(), new(mockCountryRepo))
+
+	route, err := router.Route(context.Background(), "france", "UK")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PAR", "BRU", "LON"}, route.Hubs())
+	assert.Equal(t, 10.0, route.TotalHours)
+	assert.Equal(t, 70.0, route.TotalCost)
+	assert.Greater(t, route.TotalDistance, 400.0)
+}
+
+func TestHubRouter_UsesNearestHubForUnknownCountry(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "Poland").Return(&models.CountryCoordinates{Latitude: 52.23, Longitude: 21.01}, nil)
+	router := service.NewHubRouter(hubNetwork(), countryRepo)
+
+	route, err := router.Route(context.Background(), "Poland", "France")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BER", "BRU", "PAR"}, route.Hubs())
+}
+
+func TestHubRouter_NoRoute(t *testing.T) {
+	repo := new(mockHubRepo)
+	repo.On("GetHubs", mock.Anything).Return([]models.Hub{
+		{Code: "PAR", Country: "France", Latitude: 48.85, Longitude: 2.35},
+		{Code: "LON", Country: "UK", Latitude: 51.51, Longitude: -0.13},
+	}, nil)
+	repo.On("GetLinks", mock.Anything).Return([]models.HubLink{}, nil)
+	router := service.NewHubRouter(repo, new(mockCountryRepo))
+
+	_, err := router.Route(context.Background(), "France", "UK")
+	assert.ErrorIs(t, err, service.ErrNoHubRoute)
+}
+
+func TestHubRouter_CachesNetworkUntilInvalidated(t *testing.T) {
+	repo := hubNetwork()
+	router := service.NewHubRouter(repo, new(mockCountryRepo))
+
+	for i := 0; i < 3; i++ {
+		_, err := router.Route(context.Background(), "France", "UK")
+		assert.NoError(t, err)
+	}
+	repo.AssertNumberOfCalls(t, "GetHubs", 1)
+	repo.AssertNumberOfCalls(t, "GetLinks", 1)
+
+	router.Invalidate()
+	_, err := router.Route(context.Background(), "France", "UK")
+	assert.NoError(t, err)
+	repo.AssertNumberOfCalls(t, "GetHubs", 2)
+}
+
+func TestExtendedCalculator_PricesByLegs(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+	tariffRepo.On("GetByCode", mock.Anything, "FAST").Return(&models.Tariff{
+		Code:              "FAST",
+		Name:              "Fast",
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         80,
+	}, nil)
+
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+
+	direct, err := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo}).CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	assert.Empty(t, direct.Legs)
+
+	router := service.NewHubRouter(hubNetwork(), countryRepo)
+	viaHubs, err := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo, Router: router}).CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	assert.Len(t, viaHubs.Legs, 2)
+	assert.Equal(t, 10, viaHubs.EstimatedHours)
+
+	// the links are charged in place of the per-km rate, never on top of it
+	codes := map[string]float64{}
+	for _, item := range viaHubs.LineItems {
+		codes[item.Code] = item.Amount
+	}
+	assert.NotContains(t, codes, "distance")
+	assert.Equal(t, 70.0, codes["hub_legs"])
+	assert.Equal(t, 100+70+30.0, codes["base"]+codes["hub_legs"]+codes["weight"])
+	assert.Greater(t, viaHubs.DistanceKm, 400.0)
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+const (
+	defaultCourierSpeedKmph = 40
+	maxTwoOptPasses         = 50
+)
+
+type RouteOptimizer interface {
+	OptimizeRoute(ctx context.Context, req models.RouteRequest) (models.RoutePlan, error)
+}
+
+type routeOptimizer struct {
+	repository repository.CountryRepository
+}
+
+func NewRouteOptimizer(rep repository.CountryRepository) RouteOptimizer {
+	return &routeOptimizer{repository: rep}
+}
+
+// OptimizeRoute resolves stop coordinates that were not supplied by the caller
+// and plans the route. Stops with explicit coordinates never touch the
+// repository, so the optimizer also works without the geo database.
+func (o *routeOptimizer) OptimizeRoute(ctx context.Context, req models.RouteRequest) (models.RoutePlan, error) {
+	if err := o.resolve(ctx, &req.Depot); err != nil {
+		return models.RoutePlan{}, err
+	}
+	for i := range req.Stops {
+		if err := o.resolve(ctx, &req.Stops[i]); err != nil {
+			return models.RoutePlan{}, err
+		}
+	}
+	return PlanRoute(req), nil
+}
+
+func (o *routeOptimizer) resolve(ctx context.Context, stop *models.Stop) error {
+	if stop.HasCoordinates() {
+		return nil
+	}
+	if o.repository == nil || stop.Location == "" {
+		return fmt.Errorf("stop %q: %w", stop.ID, models.ErrStopNotLocated)
+	}
+	coords, err := o.repository.GetCoordinates(ctx, stop.Location)
+	if err != nil {
+		return fmt.Errorf("stop %q: %w", stop.ID, err)
+	}
+	stop.Latitude = coords.Latitude
+	stop.Longitude = coords.Longitude
+	return nil
+}
+
+// PlanRoute builds a route with nearest neighbour and improves it with 2-opt.
+// Stops that do not fit the vehicle or cannot be reached inside their time
+// window are returned as unassigned.
+func PlanRoute(req models.RouteRequest) models.RoutePlan {
+	if req.SpeedKmph <= 0 {
+		req.SpeedKmph = defaultCourierSpeedKmph
+	}
+	if req.StartTime.IsZero() {
+		req.StartTime = time.Now()
+	}
+
+	points := make([]models.Stop, 0, len(req.Stops)+1)
+	points = append(points, req.Depot)
+	points = append(points, req.Stops...)
+	dist := distanceMatrix(points)
+
+	p := &planner{req: req, points: points, dist: dist}
+	order, unassigned := p.nearestNeighbour()
+	order = p.twoOpt(order)
+	return p.plan(order, unassigned)
+}
+
+type planner struct {
+	req    models.RouteRequest
+	points []models.Stop
+	dist   [][]float64
+}
+
+func distanceMatrix(points []models.Stop) [][]float64 {
+	dist := make([][]float64, len(points))
+	for i := range points {
+		dist[i] = make([]float64, len(points))
+		for j := range points {
+			if i != j {
+				dist[i][j] = haversine(points[i].Latitude, points[i].Longitude, points[j].Latitude, points[j].Longitude)
+			}
+		}
+	}
+	return dist
+}
+
+func (p *planner) travel(km float64) time.Duration {
+	return time.Duration(km / p.req.SpeedKmph * float64(time.Hour))
+}
+
+// arrive returns the arrival time at point to, the departure time after
+// waiting for the window to open and serving the stop, and whether the
+// window end was respected.
+func (p *planner) arrive(from, to int, at time.Time) (time.Time, time.Time, bool) {
+	arrival := at.Add(p.travel(p.dist[from][to]))
+	stop := p.points[to]
+	if !stop.WindowEnd.IsZero() && arrival.After(stop.WindowEnd) {
+		return arrival, arrival, false
+	}
+	start := arrival
+	if start.Before(stop.WindowStart) {
+		start = stop.WindowStart
+	}
+	return arrival, start.Add(time.Duration(stop.ServiceMinutes) * time.Minute), true
+}
+
+func (p *planner) nearestNeighbour() ([]int, []int) {
+	visited := make([]bool, len(p.points))
+	var order, unassigned []int
+
+	load := 0.0
+	for i := 1; i < len(p.points); i++ {
+		if p.req.Capacity > 0 && p.points[i].Weight > p.req.Capacity {
+			visited[i] = true
+			unassigned = append(unassigned, i)
+		}
+	}
+
+	// "Nearest" is measured in time rather than kilometres: travel plus the
+	// wait for the window to open. Without windows this is plain distance.
+	current, clock := 0, p.req.StartTime
+	for {
+		next := -1
+		var nextDeparture time.Time
+		var nextCost time.Duration
+		for i := 1; i < len(p.points); i++ {
+			if visited[i] {
+				continue
+			}
+			if p.req.Capacity > 0 && load+p.points[i].Weight > p.req.Capacity {
+				continue
+			}
+			_, departure, ok := p.arrive(current, i, clock)
+			if !ok {
+				continue
+			}
+			cost := departure.Sub(clock) - time.Duration(p.points[i].ServiceMinutes)*time.Minute
+			if next == -1 || cost < nextCost {
+				next, nextDeparture, nextCost = i, departure, cost
+			}
+		}
+		if next == -1 {
+			break
+		}
+		visited[next] = true
+		load += p.points[next].Weight
+		order = append(order, next)
+		current, clock = next, nextDeparture
+	}
+
+	for i := 1; i < len(p.points); i++ {
+		if !visited[i] {
+			unassigned = append(unassigned, i)
+		}
+	}
+	return order, unassigned
+}
+
+func (p *planner) length(order []int) float64 {
+	total, prev := 0.0, 0
+	for _, i := range order {
+		total += p.dist[prev][i]
+		prev = i
+	}
+	if p.req.ReturnToDepot {
+		total += p.dist[prev][0]
+	}
+	return total
+}
+
+func (p *planner) feasible(order []int) bool {
+	clock, prev := p.req.StartTime, 0
+	for _, i := range order {
+		_, departure, ok := p.arrive(prev, i, clock)
+		if !ok {
+			return false
+		}
+		clock, prev = departure, i
+	}
+	return true
+}
+
+func (p *planner) twoOpt(order []int) []int {
+	best := append([]int(nil), order...)
+	bestLen := p.length(best)
+
+	for pass := 0; pass < maxTwoOptPasses; pass++ {
+		improved := false
+		for i := 0; i < len(best)-1; i++ {
+			for j := i + 1; j < len(best); j++ {
+				candidate := append([]int(nil), best...)
+				reverse(candidate[i : j+1])
+				candidateLen := p.length(candidate)
+				if candidateLen < bestLen-1e-9 && p.feasible(candidate) {
+					best, bestLen = candidate, candidateLen
+					improved = true
+				}
+			}
+		}
+		if !improved {
+			break
+		}
+	}
+	return best
+}
+
+func reverse(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+func (p *planner) plan(order, unassigned []int) models.RoutePlan {
+	result := models.RoutePlan{}
+	clock, prev := p.req.StartTime, 0
+	for n, i := range order {
+		arrival, departure, _ := p.arrive(prev, i, clock)
+		result.Stops = append(result.Stops, models.PlannedStop{
+			Stop:             p.points[i],
+			Sequence:         n + 1,
+			DistanceFromPrev: math.Round(p.dist[prev][i]*100) / 100,
+			ETA:              arrival,
+			Departure:        departure,
+		})
+		clock, prev = departure, i
+	}
+	if p.req.ReturnToDepot && len(order) > 0 {
+		clock = clock.Add(p.travel(p.dist[prev][0]))
+	}
+	for _, i := range unassigned {
+		result.Unassigned = append(result.Unassigned, p.points[i])
+	}
+	result.TotalDistance = math.Round(p.length(order)*100) / 100
+	result.FinishTime = clock
+	return result
+}
@@ -0,0 +1,98 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func stopIDs(plan models.RoutePlan) []string {
+	var ids []string
+	for _, s := range plan.Stops {
+		ids = append(ids, s.Stop.ID)
+	}
+	return ids
+}
+
+func TestPlanRoute_OrdersStopsAlongTheLine(t *testing.T) {
+	start := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	plan := service.PlanRoute(models.RouteRequest{
+		Depot: models.Stop{ID: "depot", Latitude: 55.0, Longitude: 37.0},
+		Stops: []models.Stop{
+			{ID: "c", Latitude: 55.0, Longitude: 37.3},
+			{ID: "a", Latitude: 55.0, Longitude: 37.1},
+			{ID: "d", Latitude: 55.0, Longitude: 37.4},
+			{ID: "b", Latitude: 55.0, Longitude: 37.2},
+		},
+		StartTime: start,
+		SpeedKmph: 40,
+	})
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, stopIDs(plan))
+	assert.Empty(t, plan.Unassigned)
+	assert.InDelta(t, 25.5, plan.TotalDistance, 0.5)
+	for i := 1; i < len(plan.Stops); i++ {
+		assert.True(t, plan.Stops[i].ETA.After(plan.Stops[i-1].ETA))
+	}
+	assert.Equal(t, plan.Stops[len(plan.Stops)-1].Departure, plan.FinishTime)
+}
+
+func TestPlanRoute_RespectsTimeWindows(t *testing.T) {
+	start := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	plan := service.PlanRoute(models.RouteRequest{
+		Depot: models.Stop{ID: "depot", Latitude: 55.0, Longitude: 37.0},
+		Stops: []models.Stop{
+			{ID: "near", Latitude: 55.0, Longitude: 37.1, WindowStart: start.Add(3 * time.Hour)},
+			{ID: "far", Latitude: 55.0, Longitude: 37.5, WindowEnd: start.Add(time.Hour)},
+			{ID: "late", Latitude: 56.0, Longitude: 37.0, WindowEnd: start.Add(10 * time.Minute)},
+		},
+		StartTime: start,
+		SpeedKmph: 40,
+	})
+
+	assert.Equal(t, []string{"far", "near"}, stopIDs(plan))
+	assert.False(t, plan.Stops[1].Departure.Before(start.Add(3*time.Hour)))
+	assert.Len(t, plan.Unassigned, 1)
+	assert.Equal(t, "late", plan.Unassigned[0].ID)
+}
+
+func TestPlanRoute_RespectsCapacity(t *testing.T) {
+	plan := service.PlanRoute(models.RouteRequest{
+		Depot: models.Stop{ID: "depot", Latitude: 55.0, Longitude: 37.0},
+		Stops: []models.Stop{
+			{ID: "a", Latitude: 55.0, Longitude: 37.1, Weight: 6},
+			{ID: "b", Latitude: 55.0, Longitude: 37.2, Weight: 6},
+			{ID: "huge", Latitude: 55.0, Longitude: 37.3, Weight: 50},
+		},
+		Capacity: 10,
+	})
+
+	assert.Equal(t, []string{"a"}, stopIDs(plan))
+	assert.Len(t, plan.Unassigned, 2)
+}
+
+func TestRouteOptimizer_ResolvesLocations(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+
+	optimizer := service.NewRouteOptimizer(countryRepo)
+	plan, err := optimizer.OptimizeRoute(context.Background(), models.RouteRequest{
+		Depot: models.Stop{ID: "depot", Location: "France"},
+		Stops: []models.Stop{
+			{ID: "london", Location: "UK"},
+			{ID: "paris-office", Latitude: 48.86, Longitude: 2.34},
+		},
+		ReturnToDepot: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"paris-office", "london"}, stopIDs(plan))
+	assert.Greater(t, plan.TotalDistance, 600.0)
+	countryRepo.AssertExpectations(t)
+}
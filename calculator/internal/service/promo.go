@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// applyPromo takes the package's promo code off the price as a negative line
+// item. A code that doesn't apply leaves the price alone and says why in
+// PromoRejected, so the client can show it next to the quote.
+func (c *DefaultCalculator) applyPromo(ctx context.Context, result models.CalculationResult, pkg models.Package, tariffCode string) models.CalculationResult {
+	code := models.NormalizePromoCode(pkg.PromoCode)
+	if code == "" {
+		return result
+	}
+	at := result.PricedAt
+	if at.IsZero() {
+		at = c.now()
+	}
+
+	discount, err := c.promoDiscount(ctx, code, result, at, tariffCode, pkg)
+	if err != nil {
+		result.PromoRejected = err.Error()
+		return result
+	}
+	result.AddLineItems(models.LineItem{
+		Code:        "promo",
+		Description: "Promo code " + code,
+		Amount:      -discount,
+	})
+	result.PromoCode = code
+	return result
+}
+
+func (c *DefaultCalculator) promoDiscount(ctx context.Context, code string, result models.CalculationResult, at time.Time, tariffCode string, pkg models.Package) (float64, error) {
+	if c.promos == nil {
+		return 0, models.ErrPromoNotFound
+	}
+	promo, err := c.promos.GetByCode(ctx, code)
+	if err != nil {
+		return 0, err
+	}
+	if err := promo.Applies(at, tariffCode, pkg.From, pkg.To); err != nil {
+		return 0, err
+	}
+
+	var fixed float64
+	if promo.Type == models.PromoFixed {
+		rate := 1.0
+		if !strings.EqualFold(promo.Currency, result.Currency) {
+			if rate, err = c.exchangeRate(ctx, strings.ToUpper(promo.Currency), strings.ToUpper(result.Currency), at); err != nil {
+				return 0, fmt.Errorf("%w: %v", models.ErrPromoNotApplicable, err)
+			}
+		}
+		fixed = models.RoundAmount(promo.Value*rate, result.Currency)
+	}
+	return promo.Discount(result.Cost, fixed), nil
+}
+
+// Promotions redeems promo codes when an order is placed.
+type Promotions struct {
+	repo repository.PromotionRepository
+	now  func() time.Time
+}
+
+func NewPromotions(repo repository.PromotionRepository) *Promotions {
+	return &Promotions{repo: repo, now: time.Now}
+}
+
+type Redemption struct {
+	Code       string
+	UserID     string
+	PackageID  string
+	TariffCode string
+	From       string
+	To         string
+	FirstOrder bool
+}
+
+// Redeem re-checks the code against the order and takes one use of it.
+func (p *Promotions) Redeem(ctx context.Context, r Redemption) error {
+	promo, err := p.repo.GetByCode(ctx, models.NormalizePromoCode(r.Code))
+	if err != nil {
+		return err
+	}
+	if err := promo.Applies(p.now(), r.TariffCode, r.From, r.To); err != nil {
+		return err
+	}
+	if promo.FirstOrderOnly && !r.FirstOrder {
+		return fmt.Errorf("%w: only valid for the first order", models.ErrPromoNotApplicable)
+	}
+	return p.repo.Redeem(ctx, promo, r.UserID, r.PackageID)
+}
+
+func (p *Promotions) Release(ctx context.Context, code, packageID string) error {
+	return p.repo.Release(ctx, models.NormalizePromoCode(code), packageID)
+}
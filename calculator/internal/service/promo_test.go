@@ -0,0 +1,155 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPromoRepo struct {
+	mock.Mock
+}
+
+func (m *mockPromoRepo) GetByCode(ctx context.Context, code string) (*models.Promotion, error) {
+	args := m.Called(ctx, code)
+	promo, _ := args.Get(0).(*models.Promotion)
+	return promo, args.Error(1)
+}
+
+func (m *mockPromoRepo) GetAll(ctx context.Context) ([]models.Promotion, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.Promotion), args.Error(1)
+}
+
+func (m *mockPromoRepo) Create(ctx context.Context, promo *models.Promotion) (*models.Promotion, error) {
+	return promo, nil
+}
+
+func (m *mockPromoRepo) SetActive(ctx context.Context, code string, active bool) error {
+	return nil
+}
+
+func (m *mockPromoRepo) Redeem(ctx context.Context, promo *models.Promotion, userID, packageID string) error {
+	args := m.Called(ctx, promo.Code, userID, packageID)
+	return args.Error(0)
+}
+
+func (m *mockPromoRepo) Release(ctx context.Context, code, packageID string) error {
+	args := m.Called(ctx, code, packageID)
+	return args.Error(0)
+}
+
+func TestExtendedCalculator_PromoCode(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+
+	base := models.Tariff{BaseRate: 100, PricePerKm: 1, PricePerKg: 20, Currency: "EUR", VolumetricDivider: 4000, SpeedKmph: 60}
+	fast, slow := base, base
+	fast.Code, fast.Name = "FAST", "Fast"
+	slow.Code, slow.Name = "SLOW", "Slow"
+	tariffRepo := new(mockTariffRepo)
+	tariffRepo.On("GetByCode", mock.Anything, "FAST").Return(&fast, nil)
+	tariffRepo.On("GetAll", mock.Anything).Return([]models.Tariff{fast, slow}, nil)
+
+	promos := new(mockPromoRepo)
+	promos.On("GetByCode", mock.Anything, "SPRING10").Return(&models.Promotion{
+		Code: "SPRING10", Type: models.PromoPercent, Value: 10, Tariffs: []string{"FAST"}, Active: true,
+	}, nil)
+	promos.On("GetByCode", mock.Anything, "FIVEOFF").Return(&models.Promotion{
+		Code: "FIVEOFF", Type: models.PromoFixed, Value: 5, Currency: "USD", Active: true,
+	}, nil)
+	promos.On("GetByCode", mock.Anything, "NOPE").Return(nil, models.ErrPromoNotFound)
+
+	rates := new(mockExchangeRepo)
+	rates.On("GetRate", mock.Anything, "USD", "EUR").Return(&models.ExchangeRate{Base: "USD", Quote: "EUR", Rate: 0.9}, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries: countryRepo,
+		Tariffs:   tariffRepo,
+		Rates:     rates,
+		Promos:    promos,
+		Clock:     func() time.Time { return time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC) },
+	})
+	pkg := models.Package{From: "France", To: "UK", Weight: 2}
+
+	full, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+
+	pkg.PromoCode = " spring10 "
+	discounted, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	assert.Equal(t, "SPRING10", discounted.PromoCode)
+	assert.Empty(t, discounted.PromoRejected)
+	assert.InDelta(t, full.Cost*0.9, discounted.Cost, 0.01)
+	last := discounted.LineItems[len(discounted.LineItems)-1]
+	assert.Equal(t, "promo", last.Code)
+	assert.Less(t, last.Amount, 0.0)
+
+	pkg.PromoCode = "FIVEOFF"
+	fixed, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	assert.InDelta(t, full.Cost-4.5, fixed.Cost, 0.001)
+
+	pkg.PromoCode = "NOPE"
+	unknown, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	assert.Equal(t, full.Cost, unknown.Cost)
+	assert.Empty(t, unknown.PromoCode)
+	assert.Equal(t, models.ErrPromoNotFound.Error(), unknown.PromoRejected)
+
+	pkg.PromoCode = "SPRING10"
+	quotes, err := calc.QuoteAllTariffs(context.Background(), pkg)
+	assert.NoError(t, err)
+	byCode := map[string]models.TariffQuote{}
+	for _, q := range quotes {
+		byCode[q.TariffCode] = q
+	}
+	assert.Equal(t, "SPRING10", byCode["FAST"].PromoCode)
+	assert.Empty(t, byCode["SLOW"].PromoCode)
+	assert.Contains(t, byCode["SLOW"].PromoRejected, "not valid for tariff SLOW")
+	assert.True(t, byCode["FAST"].Cheapest)
+}
+
+func TestPromotions_Redeem(t *testing.T) {
+	promos := new(mockPromoRepo)
+	promos.On("GetByCode", mock.Anything, "WELCOME").Return(&models.Promotion{
+		Code: "WELCOME", Type: models.PromoPercent, Value: 20, FirstOrderOnly: true, Active: true,
+		Routes: []models.PromoRoute{{From: "Moscow"}},
+	}, nil)
+	promos.On("Redeem", mock.Anything, "WELCOME", "user-1", "PKG-1").Return(nil)
+
+	p := service.NewPromotions(promos)
+
+	err := p.Redeem(context.Background(), service.Redemption{Code: "welcome", UserID: "user-1", PackageID: "PKG-2", From: "Moscow", To: "Kazan"})
+	assert.ErrorIs(t, err, models.ErrPromoNotApplicable)
+
+	err = p.Redeem(context.Background(), service.Redemption{Code: "welcome", UserID: "user-1", PackageID: "PKG-3", From: "Kazan", To: "Moscow", FirstOrder: true})
+	assert.ErrorIs(t, err, models.ErrPromoNotApplicable)
+
+	err = p.Redeem(context.Background(), service.Redemption{Code: "welcome", UserID: "user-1", PackageID: "PKG-1", From: "Moscow", To: "Kazan", FirstOrder: true})
+	assert.NoError(t, err)
+	promos.AssertNumberOfCalls(t, "Redeem", 1)
+}
+
+func TestPromotion_Applies(t *testing.T) {
+	end := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	promo := models.Promotion{
+		Code: "APRIL", Type: models.PromoFixed, Value: 100, Currency: "RUB", Active: true,
+		ValidFrom: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), ValidTo: &end, MaxUses: 10, Used: 9,
+	}
+
+	assert.NoError(t, promo.Applies(time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), "FAST", "A", "B"))
+	assert.ErrorIs(t, promo.Applies(time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC), "FAST", "A", "B"), models.ErrPromoNotApplicable)
+	assert.ErrorIs(t, promo.Applies(end, "FAST", "A", "B"), models.ErrPromoNotApplicable)
+
+	promo.Used = 10
+	assert.ErrorIs(t, promo.Applies(time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC), "FAST", "A", "B"), models.ErrPromoExhausted)
+
+	assert.Equal(t, 50.0, promo.Discount(50, 100))
+}
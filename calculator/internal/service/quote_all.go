@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/sirupsen/logrus"
+)
+
+// QuoteAllTariffs prices the package with every tariff currently in force.
+// Eligible quotes come first, cheapest to most expensive in a common
+// currency; the cheapest and the fastest of them are flagged for the
+// checkout page.
+func (c *ExtendedCalculator) QuoteAllTariffs(ctx context.Context, pkg models.Package) ([]models.TariffQuote, error) {
+	tariffs, err := c.tariffRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	from, to, err := c.coordinates(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	route, _ := c.route(ctx, pkg)
+	pc := c.pricingContext(ctx)
+
+	quotes := make([]models.TariffQuote, len(tariffs))
+	var wg sync.WaitGroup
+	for i, tariff := range tariffs {
+		wg.Add(1)
+		go func(i int, tariff models.Tariff) {
+			defer wg.Done()
+			quote := models.TariffQuote{TariffCode: tariff.Code, TariffName: tariff.Name}
+			if err := tariff.Validate(); err != nil {
+				quote.Reason = "tariff is misconfigured: " + err.Error()
+				quotes[i] = quote
+				return
+			}
+			result, err := c.priceWith(ctx, tariff, pkg, from, to, route, pc)
+			if err == nil {
+				result = c.applyPromo(ctx, result, pkg, tariff.Code)
+				result, err = c.convert(ctx, result, pkg.TargetCurrency)
+			}
+			if err != nil {
+				quote.Reason = err.Error()
+				var ineligible *models.IneligibleError
+				if errors.As(err, &ineligible) {
+					quote.Reason = strings.Join(ineligible.Reasons, "; ")
+				}
+				quotes[i] = quote
+				return
+			}
+			quote.CalculationResult = result
+			quote.Eligible = true
+			quotes[i] = quote
+		}(i, tariff)
+	}
+	wg.Wait()
+	return c.rank(ctx, quotes), nil
+}
+
+type rankedQuote struct {
+	quote      models.TariffQuote
+	cost       float64
+	comparable bool
+}
+
+// tier puts the quotes compared on price first, then the eligible ones that
+// couldn't be converted, then the ineligible ones.
+func (r rankedQuote) tier() int {
+	switch {
+	case r.comparable:
+		return 0
+	case r.quote.Eligible:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// rank orders the eligible quotes by their price in one currency and flags
+// the cheapest and the fastest of them. A quote that can't be converted into
+// that currency is left out of the comparison.
+func (c *ExtendedCalculator) rank(ctx context.Context, quotes []models.TariffQuote) []models.TariffQuote {
+	currency := c.comparisonCurrency(quotes)
+	ranked := make([]rankedQuote, len(quotes))
+	for i, q := range quotes {
+		ranked[i].quote = q
+		if !q.Eligible {
+			continue
+		}
+		converted, err := c.convert(ctx, q.CalculationResult, currency)
+		if err != nil {
+			logrus.Printf("Quote for tariff '%s' left out of the comparison: %v", q.TariffCode, err)
+			continue
+		}
+		ranked[i].cost = converted.Cost
+		ranked[i].comparable = true
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].tier() != ranked[j].tier() {
+			return ranked[i].tier() < ranked[j].tier()
+		}
+		return ranked[i].comparable && ranked[i].cost < ranked[j].cost
+	})
+
+	fastest := -1
+	for i, r := range ranked {
+		if !r.comparable {
+			break
+		}
+		if fastest == -1 || r.quote.EstimatedHours < ranked[fastest].quote.EstimatedHours {
+			fastest = i
+		}
+	}
+	if fastest != -1 {
+		ranked[0].quote.Cheapest = true
+		ranked[fastest].quote.Fastest = true
+	}
+
+	for i := range ranked {
+		quotes[i] = ranked[i].quote
+	}
+	return quotes
+}
+
+// comparisonCurrency is the currency all eligible quotes share, or the
+// default tariff's when they are priced in different ones.
+func (c *ExtendedCalculator) comparisonCurrency(quotes []models.TariffQuote) string {
+	var currency string
+	for _, q := range quotes {
+		if !q.Eligible {
+			continue
+		}
+		switch {
+		case currency == "":
+			currency = q.Currency
+		case !strings.EqualFold(currency, q.Currency):
+			return c.defaultTariff.Currency
+		}
+	}
+	return currency
+}
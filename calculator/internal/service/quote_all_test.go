@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExtendedCalculator_QuoteAllTariffs(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+
+	base := models.Tariff{
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+	}
+	express, economy, broken := base, base, base
+	express.Code, express.Name, express.BaseRate, express.SpeedKmph = "EXPRESS", "Express", 500, 200
+	economy.Code, economy.Name, economy.SpeedKmph = "ECONOMY", "Economy", 40
+	broken.Code, broken.Name = "BROKEN", "Broken"
+	tariffRepo.On("GetAll", mock.Anything).Return([]models.Tariff{broken, express, economy}, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+
+	quotes, err := calc.QuoteAllTariffs(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 3)
+
+	assert.Equal(t, "ECONOMY", quotes[0].TariffCode)
+	assert.True(t, quotes[0].Eligible)
+	assert.True(t, quotes[0].Cheapest)
+	assert.False(t, quotes[0].Fastest)
+
+	assert.Equal(t, "EXPRESS", quotes[1].TariffCode)
+	assert.True(t, quotes[1].Fastest)
+	assert.Greater(t, quotes[1].Cost, quotes[0].Cost)
+	assert.Less(t, quotes[1].EstimatedHours, quotes[0].EstimatedHours)
+
+	assert.Equal(t, "BROKEN", quotes[2].TariffCode)
+	assert.False(t, quotes[2].Eligible)
+	assert.NotEmpty(t, quotes[2].Reason)
+	assert.False(t, quotes[2].Cheapest)
+}
+
+func TestExtendedCalculator_QuoteAllTariffs_ComparesInOneCurrency(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+	rates := new(mockExchangeRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+	rates.On("GetRate", mock.Anything, "USD", "RUB").Return(&models.ExchangeRate{Base: "USD", Quote: "RUB", Rate: 80}, nil)
+	rates.On("GetRate", mock.Anything, "GBP", "RUB").Return(nil, models.ErrExchangeRateNotFound)
+	rates.On("GetRate", mock.Anything, "RUB", "GBP").Return(nil, models.ErrExchangeRateNotFound)
+
+	base := models.Tariff{PricePerKm: 1, PricePerKg: 20, VolumetricDivider: 4000}
+	dollar, ruble, pound := base, base, base
+	dollar.Code, dollar.Name, dollar.Currency, dollar.BaseRate, dollar.SpeedKmph = "DOLLAR", "Dollar", "USD", 100, 100
+	ruble.Code, ruble.Name, ruble.Currency, ruble.BaseRate, ruble.PricePerKm, ruble.SpeedKmph = "RUBLE", "Ruble", "RUB", 500, 50, 40
+	pound.Code, pound.Name, pound.Currency, pound.BaseRate, pound.SpeedKmph = "POUND", "Pound", "GBP", 10, 500
+	tariffRepo.On("GetAll", mock.Anything).Return([]models.Tariff{pound, dollar, ruble}, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo, Rates: rates})
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+
+	quotes, err := calc.QuoteAllTariffs(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 3)
+
+	assert.Equal(t, "RUBLE", quotes[0].TariffCode)
+	assert.Greater(t, quotes[0].Cost, quotes[1].Cost, "cheaper in rubles despite the bigger number")
+	assert.True(t, quotes[0].Cheapest)
+
+	assert.Equal(t, "DOLLAR", quotes[1].TariffCode)
+	assert.True(t, quotes[1].Fastest)
+	assert.Equal(t, "USD", quotes[1].Currency, "quotes keep their own currency")
+
+	assert.Equal(t, "POUND", quotes[2].TariffCode)
+	assert.True(t, quotes[2].Eligible)
+	assert.False(t, quotes[2].Cheapest)
+	assert.False(t, quotes[2].Fastest)
+}
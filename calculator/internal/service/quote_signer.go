@@ -0,0 +1,94 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// QuoteSigner issues self-contained quote tokens: the quote itself followed
+// by its HMAC, so any replica holding the secret can check one without a
+// lookup.
+type QuoteSigner struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewQuoteSigner(secret string, ttl time.Duration) *QuoteSigner {
+	if ttl <= 0 {
+		ttl = 15 * time.Minute
+	}
+	return &QuoteSigner{secret: []byte(secret), ttl: ttl}
+}
+
+func (s *QuoteSigner) Issue(pkg models.Package, tariffCode string, result models.CalculationResult) (string, time.Time, error) {
+	quote := models.Quote{
+		ID:                uuid.NewString(),
+		TariffCode:        tariffCode,
+		TariffVersion:     result.TariffVersion,
+		Cost:              result.Cost,
+		Currency:          result.Currency,
+		EstimatedHours:    result.EstimatedHours,
+		From:              pkg.From,
+		To:                pkg.To,
+		Weight:            pkg.Weight,
+		Length:            pkg.Length,
+		Width:             pkg.Width,
+		Height:            pkg.Height,
+		Pickup:            pkg.Pickup,
+		Category:          pkg.Category,
+		Legs:              result.Legs,
+		LineItems:         result.LineItems,
+		DistanceKm:        result.DistanceKm,
+		ChargeableWeight:  result.ChargeableWeight,
+		VolumetricWeight:  result.VolumetricWeight,
+		PricedAt:          result.PricedAt,
+		OriginalCost:      result.OriginalCost,
+		OriginalCurrency:  result.OriginalCurrency,
+		ExchangeRate:      result.ExchangeRate,
+		PromoCode:         result.PromoCode,
+		DeliveryWindow:    result.DeliveryWindow,
+		FuelSurchargeRate: result.FuelSurchargeRate,
+		ExpiresAt:         time.Now().Add(s.ttl).UTC(),
+	}
+	payload, err := json.Marshal(quote)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + s.sign(body), quote.ExpiresAt, nil
+}
+
+func (s *QuoteSigner) Verify(token string, pkg models.Package, tariffCode string) (*models.Quote, error) {
+	body, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(body))) {
+		return nil, models.ErrQuoteInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, models.ErrQuoteInvalid
+	}
+	var quote models.Quote
+	if err := json.Unmarshal(payload, &quote); err != nil {
+		return nil, models.ErrQuoteInvalid
+	}
+	if !time.Now().Before(quote.ExpiresAt) {
+		return nil, models.ErrQuoteExpired
+	}
+	if !quote.Matches(pkg, tariffCode) {
+		return nil, models.ErrQuoteMismatch
+	}
+	return &quote, nil
+}
+
+func (s *QuoteSigner) sign(body string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
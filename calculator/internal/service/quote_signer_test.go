@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuoteSigner_IssueAndVerify(t *testing.T) {
+	signer := service.NewQuoteSigner("secret", time.Minute)
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+	result := models.CalculationResult{Cost: 812.4, EstimatedHours: 8, Currency: "EUR", TariffVersion: 2}
+
+	token, expiresAt, err := signer.Issue(pkg, "FAST", result)
+	assert.NoError(t, err)
+	assert.True(t, expiresAt.After(time.Now()))
+
+	quote, err := signer.Verify(token, pkg, "FAST")
+	assert.NoError(t, err)
+	assert.Equal(t, 812.4, quote.Cost)
+	assert.Equal(t, "EUR", quote.Currency)
+	assert.Equal(t, 8, quote.EstimatedHours)
+	assert.Equal(t, 2, quote.TariffVersion)
+
+	heavier := pkg
+	heavier.Weight = 3
+	_, err = signer.Verify(token, heavier, "FAST")
+	assert.ErrorIs(t, err, models.ErrQuoteMismatch)
+
+	_, err = signer.Verify(token, pkg, "ECONOMY")
+	assert.ErrorIs(t, err, models.ErrQuoteMismatch)
+
+	_, err = service.NewQuoteSigner("other", time.Minute).Verify(token, pkg, "FAST")
+	assert.ErrorIs(t, err, models.ErrQuoteInvalid)
+
+	_, err = signer.Verify(token[:len(token)-2]+"xx", pkg, "FAST")
+	assert.ErrorIs(t, err, models.ErrQuoteInvalid)
+}
+
+func TestQuoteSigner_Expired(t *testing.T) {
+	signer := service.NewQuoteSigner("secret", time.Millisecond)
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+
+	token, _, err := signer.Issue(pkg, "FAST", models.CalculationResult{Cost: 100, Currency: "EUR"})
+	assert.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+	_, err = signer.Verify(token, pkg, "FAST")
+	assert.ErrorIs(t, err, models.ErrQuoteExpired)
+}
+
+func TestQuoteSigner_BindsCategory(t *testing.T) {
+	signer := service.NewQuoteSigner("secret", time.Minute)
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10, Category: "books"}
+
+	token, _, err := signer.Issue(pkg, "FAST", models.CalculationResult{Cost: 100, Currency: "EUR"})
+	assert.NoError(t, err)
+
+	same := pkg
+	same.Category = " Books "
+	_, err = signer.Verify(token, same, "FAST")
+	assert.NoError(t, err)
+
+	batteries := pkg
+	batteries.Category = "batteries"
+	_, err = signer.Verify(token, batteries, "FAST")
+	assert.ErrorIs(t, err, models.ErrQuoteMismatch)
+
+	uncategorized := pkg
+	uncategorized.Category = ""
+	_, err = signer.Verify(token, uncategorized, "FAST")
+	assert.ErrorIs(t, err, models.ErrQuoteMismatch)
+}
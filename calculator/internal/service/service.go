@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math"
 	"time"
 
@@ -13,14 +15,29 @@ import (
 type Calculator interface {
 	Calculate(ctx context.Context, pkg models.Package) (models.CalculationResult, error)
 	CalculateByTariffCode(ctx context.Context, pkg models.Package, code string) (models.CalculationResult, error)
+	QuoteAllTariffs(ctx context.Context, pkg models.Package) ([]models.TariffQuote, error)
 	GetTariffs(ctx context.Context) ([]models.Tariff, error)
+	GetEligibleTariffs(ctx context.Context, pkg models.Package) ([]models.Tariff, error)
+	GetTariffVersions(ctx context.Context, code string) ([]models.Tariff, error)
 	CreateTariff(ctx context.Context, tariff *models.Tariff) (*models.Tariff, error)
+	UpdateTariff(ctx context.Context, code string, expectedVersion int, patch models.TariffPatch) (*models.Tariff, error)
 	DeleteTariff(ctx context.Context, code string) error
+	GetTariffAudit(ctx context.Context, code string) ([]models.TariffAudit, error)
+	CalculateBatch(ctx context.Context, items <-chan BatchItem, workers int) <-chan BatchResult
 }
 
 type DefaultCalculator struct {
 	defaultTariff models.Tariff
 	repository    repository.CountryRepository
+	router        HubRouter
+	surcharges    repository.SurchargeRuleRepository
+	zones         repository.ZoneMatrixRepository
+	rates         repository.ExchangeRateRepository
+	promos        repository.PromotionRepository
+	distances     map[string]DistanceProvider
+	calendar      *Calendar
+	fuel          repository.FuelIndexRepository
+	now           func() time.Time
 }
 
 func NewCalculator(rep repository.CountryRepository) *DefaultCalculator {
@@ -34,123 +51,305 @@ func NewCalculator(rep repository.CountryRepository) *DefaultCalculator {
 			Currency:          "RUB",
 			VolumetricDivider: 5000,
 			SpeedKmph:         60,
+			PickupSurcharge:   150,
+			Version:           1,
 		},
 		repository: rep,
+		distances: map[string]DistanceProvider{
+			models.DistanceHaversine: HaversineProvider{},
+		},
+		now: time.Now,
 	}
 }
 
 func (c *DefaultCalculator) Calculate(ctx context.Context, pkg models.Package) (models.CalculationResult, error) {
-	from, err := c.repository.GetCoordinates(ctx, pkg.From)
+	result, err := c.calculate(ctx, pkg)
 	if err != nil {
-		logrus.Printf("Failed to get coordinates for origin country '%s': %v", pkg.From, err)
-		return fallbackResult(pkg, c.defaultTariff.Currency), nil
+		return result, err
 	}
+	result = c.applyPromo(ctx, result, pkg, c.defaultTariff.Code)
+	return c.convert(ctx, result, pkg.TargetCurrency)
+}
 
-	to, err := c.repository.GetCoordinates(ctx, pkg.To)
+func (c *DefaultCalculator) calculate(ctx context.Context, pkg models.Package) (models.CalculationResult, error) {
+	from, to, err := c.coordinates(ctx, pkg)
 	if err != nil {
-		logrus.Printf("Failed to get coordinates for destination country '%s': %v", pkg.To, err)
-		return fallbackResult(pkg, c.defaultTariff.Currency), nil
-	}
-
-	distance := haversine(from.Latitude, from.Longitude, to.Latitude, to.Longitude)
-	volumetricWeight := float64(pkg.Length*pkg.Width*pkg.Height) / c.defaultTariff.VolumetricDivider
-	effectiveWeight := math.Max(pkg.Weight, volumetricWeight)
-	cost := c.defaultTariff.BaseRate +
-		distance*c.defaultTariff.PricePerKm +
-		effectiveWeight*c.defaultTariff.PricePerKg
-	cost *= timeMultiplier() * zoneMultiplier(distance)
-	speed := c.defaultTariff.SpeedKmph
-	if speed <= 0 {
-		speed = 50
+		return models.CalculationResult{}, err
 	}
-	estimatedHours := int(math.Ceil(distance / float64(speed) * timeDelayMultiplier(distance)))
-	if estimatedHours < 6 {
-		estimatedHours = 6
+	return c.quote(ctx, c.defaultTariff, pkg, from, to)
+}
+
+// quote prices by hub legs when the network connects both ends and falls
+// back to the great-circle distance otherwise.
+func (c *DefaultCalculator) quote(ctx context.Context, tariff models.Tariff, pkg models.Package, from, to *models.CountryCoordinates) (models.CalculationResult, error) {
+	var route *models.HubRoute
+	if tariff.HubRouted() {
+		route, _ = c.route(ctx, pkg)
+	}
+	return c.priceWith(ctx, tariff, pkg, from, to, route, c.pricingContext(ctx))
+}
+
+// zoneRate looks up the tariff's matrix cell for the lane.
+func (c *DefaultCalculator) zoneRate(ctx context.Context, tariffCode, fromZone, toZone string) (*models.ZoneRate, error) {
+	if c.zones == nil || fromZone == "" || toZone == "" {
+		return nil, fmt.Errorf("%w: %s -> %s", models.ErrZoneRateNotFound, fromZone, toZone)
+	}
+	rate, err := c.zones.GetRate(ctx, tariffCode, fromZone, toZone)
+	if err != nil {
+		if errors.Is(err, models.ErrZoneRateNotFound) {
+			return nil, fmt.Errorf("%w: %s -> %s", models.ErrZoneRateNotFound, fromZone, toZone)
+		}
+		return nil, err
 	}
+	return rate, nil
+}
 
-	return models.CalculationResult{
-		Cost:           math.Round(cost*100) / 100,
-		EstimatedHours: estimatedHours,
-		Currency:       c.defaultTariff.Currency,
-	}, nil
+// route returns nil when the package can't travel through the hub network.
+func (c *DefaultCalculator) route(ctx context.Context, pkg models.Package) (*models.HubRoute, error) {
+	if c.router == nil {
+		return nil, ErrNoHubRoute
+	}
+	route, err := c.router.Route(ctx, pkg.From, pkg.To)
+	if err != nil {
+		if !errors.Is(err, ErrNoHubRoute) {
+			logrus.Printf("Hub routing failed for '%s' -> '%s': %v", pkg.From, pkg.To, err)
+		}
+		return nil, err
+	}
+	return route, nil
 }
 
 type ExtendedCalculator struct {
 	DefaultCalculator
 	tariffRepo repository.TariffRepository
+	audit      repository.TariffAuditRepository
 }
 
-func NewExtendedCalculator(countryRepo repository.CountryRepository, tariffRepo repository.TariffRepository) *ExtendedCalculator {
-	return &ExtendedCalculator{
-		DefaultCalculator: *NewCalculator(countryRepo),
-		tariffRepo:        tariffRepo,
+// CalculatorDeps wires an ExtendedCalculator. Countries and Tariffs are
+// required; every other dependency switches its feature off when left nil.
+// Distances adds providers tariffs can select by name next to haversine;
+// Clock, when set, is what surcharges and tariff versions are resolved
+// against, so a price can be reproduced for a given instant.
+type CalculatorDeps struct {
+	Countries  repository.CountryRepository
+	Tariffs    repository.TariffRepository
+	Router     HubRouter
+	Surcharges repository.SurchargeRuleRepository
+	Zones      repository.ZoneMatrixRepository
+	Rates      repository.ExchangeRateRepository
+	Promos     repository.PromotionRepository
+	Audit      repository.TariffAuditRepository
+	Fuel       repository.FuelIndexRepository
+	Calendar   *Calendar
+	Distances  map[string]DistanceProvider
+	Clock      func() time.Time
+}
+
+func NewExtendedCalculator(deps CalculatorDeps) *ExtendedCalculator {
+	calc := &ExtendedCalculator{
+		DefaultCalculator: *NewCalculator(deps.Countries),
+		tariffRepo:        deps.Tariffs,
+		audit:             deps.Audit,
+	}
+	calc.router = deps.Router
+	calc.surcharges = deps.Surcharges
+	calc.zones = deps.Zones
+	calc.rates = deps.Rates
+	calc.promos = deps.Promos
+	calc.fuel = deps.Fuel
+	calc.calendar = deps.Calendar
+	for name, provider := range deps.Distances {
+		calc.distances[name] = provider
 	}
+	if deps.Clock != nil {
+		calc.now = deps.Clock
+	}
+	return calc
 }
 
 func (c *ExtendedCalculator) CalculateByTariffCode(ctx context.Context, pkg models.Package, code string) (models.CalculationResult, error) {
-	tariff, err := c.tariffRepo.GetByCode(ctx, code)
+	result, err := c.calculateByTariffCode(ctx, pkg, code)
 	if err != nil {
-		return fallbackResult(pkg, c.defaultTariff.Currency), nil
+		return result, err
 	}
+	result = c.applyPromo(ctx, result, pkg, code)
+	return c.convert(ctx, result, pkg.TargetCurrency)
+}
 
-	from, err := c.repository.GetCoordinates(ctx, pkg.From)
+func (c *ExtendedCalculator) calculateByTariffCode(ctx context.Context, pkg models.Package, code string) (models.CalculationResult, error) {
+	tariff, err := c.tariffRepo.GetByCode(ctx, code, c.now())
 	if err != nil {
-		return fallbackResult(pkg, tariff.Currency), nil
+		if errors.Is(err, models.ErrTariffNotFound) {
+			return models.CalculationResult{}, fmt.Errorf("%w: %s", models.ErrTariffNotFound, code)
+		}
+		return models.CalculationResult{}, err
 	}
 
-	to, err := c.repository.GetCoordinates(ctx, pkg.To)
+	from, to, err := c.coordinates(ctx, pkg)
 	if err != nil {
-		return fallbackResult(pkg, tariff.Currency), nil
+		return models.CalculationResult{}, err
 	}
+	return c.quote(ctx, *tariff, pkg, from, to)
+}
 
-	distance := haversine(from.Latitude, from.Longitude, to.Latitude, to.Longitude)
-	logrus.Printf("Distance '%s': %0.2f", pkg.To, distance)
-	volumetricWeight := float64(pkg.Length*pkg.Width*pkg.Height) / tariff.VolumetricDivider
-	effectiveWeight := math.Max(pkg.Weight, volumetricWeight)
-	cost := tariff.BaseRate +
-		distance*tariff.PricePerKm +
-		effectiveWeight*tariff.PricePerKg
-	cost *= timeMultiplier() * zoneMultiplier(distance)
+func (c *ExtendedCalculator) GetTariffs(ctx context.Context) ([]models.Tariff, error) {
+	return c.tariffRepo.GetAll(ctx)
+}
+
+func (c *ExtendedCalculator) GetTariffVersions(ctx context.Context, code string) ([]models.Tariff, error) {
+	return c.tariffRepo.GetVersions(ctx, code)
+}
+
+// priceWith picks the tariff's pricing mode: zone-priced tariffs read the
+// lane from the zone matrix, the rest go by hub legs or distance. Only tariffs
+// without a distance provider of their own use the hub route.
+func (c *DefaultCalculator) priceWith(ctx context.Context, tariff models.Tariff, pkg models.Package, from, to *models.CountryCoordinates, route *models.HubRoute, pc pricingContext) (models.CalculationResult, error) {
+	if !tariff.HubRouted() {
+		route = nil
+	}
+	if err := eligibility(tariff, pkg, from, to); err != nil {
+		return models.CalculationResult{}, err
+	}
+	lane := models.SurchargeInput{
+		At:       pc.at,
+		From:     pkg.From,
+		To:       pkg.To,
+		FromZone: from.Zone,
+		ToZone:   to.Zone,
+		Tariff:   tariff.Code,
+	}
+
+	var result models.CalculationResult
+	switch {
+	case tariff.ZonePriced():
+		rate, err := c.zoneRate(ctx, tariff.Code, from.Zone, to.Zone)
+		if err != nil {
+			return models.CalculationResult{}, err
+		}
+		lane.DistanceKm, err = c.distance(ctx, tariff, from, to)
+		if err != nil {
+			return models.CalculationResult{}, err
+		}
+		result = priceByZone(tariff, *rate, pkg, lane, pc)
+		if route != nil {
+			result.Legs = route.Legs
+		}
+	case route != nil:
+		result = priceByLegs(tariff, route, pkg, lane, pc)
+	default:
+		distance, err := c.distance(ctx, tariff, from, to)
+		if err != nil {
+			return models.CalculationResult{}, err
+		}
+		lane.DistanceKm = distance
+		result = price(tariff, pkg, lane, pc)
+	}
+	addFuelSurcharge(&result, tariff, pc.fuel)
+	result.TariffVersion = tariff.Version
+	result.DeliveryWindow = c.deliveryWindow(pc.at, result.EstimatedHours, from, to, route)
+	return result, nil
+}
+
+func weights(tariff models.Tariff, pkg models.Package) (volumetric, chargeable float64) {
+	volumetric = float64(pkg.Length*pkg.Width*pkg.Height) / tariff.VolumetricDivider
+	return volumetric, math.Max(pkg.Weight, volumetric)
+}
+
+func price(tariff models.Tariff, pkg models.Package, lane models.SurchargeInput, pc pricingContext) models.CalculationResult {
+	distance := lane.DistanceKm
 	speed := tariff.SpeedKmph
 	if speed <= 0 {
 		speed = 50
 	}
-	estimatedHours := int(math.Ceil(distance / float64(speed) * timeDelayMultiplier(distance)))
+	haul := models.LineItem{Code: "distance", Description: fmt.Sprintf("%.1f km x %.2f", distance, tariff.PricePerKm), Amount: distance * tariff.PricePerKm}
+	return priceHaul(tariff, pkg, lane, pc, haul, distance/speed)
+}
+
+// priceByLegs charges the cost of the hub links in place of the per-km
+// rate; the route's distance is still what distance surcharges see.
+func priceByLegs(tariff models.Tariff, route *models.HubRoute, pkg models.Package, lane models.SurchargeInput, pc pricingContext) models.CalculationResult {
+	lane.DistanceKm = route.TotalDistance
+	haul := models.LineItem{Code: "hub_legs", Description: fmt.Sprintf("%d hub legs", len(route.Legs)), Amount: route.TotalCost}
+	result := priceHaul(tariff, pkg, lane, pc, haul, route.TotalHours)
+	result.EstimatedHours = int(math.Ceil(route.TotalHours))
+	if result.EstimatedHours < 6 {
+		result.EstimatedHours = 6
+	}
+	result.Legs = route.Legs
+	return result
+}
+
+// priceHaul prices the base rate, the haul and the chargeable weight, then
+// applies the surcharges to that subtotal and the transit hours.
+func priceHaul(tariff models.Tariff, pkg models.Package, lane models.SurchargeInput, pc pricingContext, haul models.LineItem, transitHours float64) models.CalculationResult {
+	volumetricWeight, effectiveWeight := weights(tariff, pkg)
+	subtotal := tariff.BaseRate +
+		haul.Amount +
+		effectiveWeight*tariff.PricePerKg
+
+	items := []models.LineItem{
+		{Code: "base", Description: "Base rate", Amount: tariff.BaseRate},
+		haul,
+		{Code: "weight", Description: fmt.Sprintf("%.2f kg x %.2f", effectiveWeight, tariff.PricePerKg), Amount: effectiveWeight * tariff.PricePerKg},
+	}
+
+	lane.WeightKg = effectiveWeight
+	surcharges, hours := ApplySurcharges(pc.rules, lane, subtotal, transitHours)
+	items = append(items, surcharges...)
+	if pkg.Pickup {
+		items = append(items, models.LineItem{Code: "pickup", Description: "Courier pick-up", Amount: tariff.PickupSurcharge})
+	}
+
+	estimatedHours := int(math.Ceil(hours))
 	if estimatedHours < 6 {
 		estimatedHours = 6
 	}
 
-	return models.CalculationResult{
-		Cost:           math.Round(cost*100) / 100,
-		EstimatedHours: estimatedHours,
-		Currency:       tariff.Currency,
-	}, nil
-}
-
-func (c *ExtendedCalculator) GetTariffs(ctx context.Context) ([]models.Tariff, error) {
-	return c.tariffRepo.GetAll(ctx)
+	result := models.CalculationResult{
+		EstimatedHours:   estimatedHours,
+		Currency:         tariff.Currency,
+		DistanceKm:       round2(lane.DistanceKm),
+		ChargeableWeight: round2(effectiveWeight),
+		VolumetricWeight: round2(volumetricWeight),
+		PricedAt:         pc.at,
+	}
+	result.AddLineItems(items...)
+	return result
 }
 
-func (c *ExtendedCalculator) CreateTariff(ctx context.Context, tariff *models.Tariff) (*models.Tariff, error) {
-	return c.tariffRepo.CreateTariff(ctx, tariff)
-}
+// priceByZone charges the matrix rates for the lane. Transit time is the
+// matrix's promise, so only price surcharges are applied on top, and none of
+// the distance-band ones.
+func priceByZone(tariff models.Tariff, rate models.ZoneRate, pkg models.Package, lane models.SurchargeInput, pc pricingContext) models.CalculationResult {
+	volumetricWeight, effectiveWeight := weights(tariff, pkg)
+	subtotal := rate.BaseRate + effectiveWeight*rate.PricePerKg
 
-func (c *ExtendedCalculator) DeleteTariff(ctx context.Context, code string) error {
-	return c.tariffRepo.DeleteTariff(ctx, code)
-}
+	items := []models.LineItem{
+		{Code: "base", Description: fmt.Sprintf("Zone %s -> %s base rate", rate.FromZone, rate.ToZone), Amount: rate.BaseRate},
+		{Code: "weight", Description: fmt.Sprintf("%.2f kg x %.2f", effectiveWeight, rate.PricePerKg), Amount: effectiveWeight * rate.PricePerKg},
+	}
 
-func fallbackResult(pkg models.Package, currency string) models.CalculationResult {
-	volumetricWeight := float64(pkg.Length*pkg.Width*pkg.Height) / 5000
-	effectiveWeight := math.Max(pkg.Weight, volumetricWeight)
-	base := 500.0
-	weightFee := effectiveWeight * 50
-	cost := base + weightFee
+	lane.WeightKg = effectiveWeight
+	surcharges, _ := ApplySurcharges(withoutDistanceBands(pc.rules), lane, subtotal, 0)
+	items = append(items, surcharges...)
+	if pkg.Pickup {
+		items = append(items, models.LineItem{Code: "pickup", Description: "Courier pick-up", Amount: tariff.PickupSurcharge})
+	}
 
-	return models.CalculationResult{
-		Cost:           math.Round(cost*100) / 100,
-		EstimatedHours: 72,
-		Currency:       currency,
+	result := models.CalculationResult{
+		EstimatedHours:   rate.TransitDays * 24,
+		Currency:         tariff.Currency,
+		DistanceKm:       round2(lane.DistanceKm),
+		ChargeableWeight: round2(effectiveWeight),
+		VolumetricWeight: round2(volumetricWeight),
+		PricedAt:         pc.at,
 	}
+	result.AddLineItems(items...)
+	return result
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
 }
 
 func haversine(lat1, lon1, lat2, lon2 float64) float64 {
@@ -164,42 +363,3 @@ func haversine(lat1, lon1, lat2, lon2 float64) float64 {
 	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
 	return R * c
 }
-
-func timeMultiplier() float64 {
-	h := time.Now().Hour()
-	m := 1.0
-	if h >= 22 || h < 6 {
-		m += 0.15
-	}
-	weekday := time.Now().Weekday()
-	if weekday == time.Saturday || weekday == time.Sunday {
-		m += 0.2
-	}
-	return m
-}
-
-func zoneMultiplier(distance float64) float64 {
-	switch {
-	case distance < 100:
-		return 1.0
-	case distance < 500:
-		return 1.15
-	case distance < 1500:
-		return 1.3
-	default:
-		return 1.5
-	}
-}
-
-func timeDelayMultiplier(distance float64) float64 {
-	switch {
-	case distance < 100:
-		return 1.2
-	case distance < 500:
-		return 1.5
-	case distance < 1500:
-		return 1.75
-	default:
-		return 2.0
-	}
-}
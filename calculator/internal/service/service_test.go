@@ -3,6 +3,7 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/calculator/internal/service"
 	"github.com/maksroxx/DeliveryService/calculator/models"
@@ -19,21 +20,64 @@ func (m *mockCountryRepo) GetCoordinates(ctx context.Context, country string) (*
 	return args.Get(0).(*models.CountryCoordinates), args.Error(1)
 }
 
+func (m *mockCountryRepo) GetNames(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockCountryRepo) Search(ctx context.Context, query string, limit int) ([]models.CountryCoordinates, error) {
+	return nil, nil
+}
+
+func (m *mockCountryRepo) Nearest(ctx context.Context, latitude, longitude float64, limit int) ([]models.NearbyCity, error) {
+	return nil, nil
+}
+
+func (m *mockCountryRepo) SetAliases(ctx context.Context, name string, aliases []string) (*models.CountryCoordinates, error) {
+	return nil, nil
+}
+
+func (m *mockCountryRepo) EnsureSearchKeys(ctx context.Context) error {
+	return nil
+}
+
+func (m *mockCountryRepo) GetAll(ctx context.Context) ([]models.CountryCoordinates, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.CountryCoordinates), args.Error(1)
+}
+
+func (m *mockCountryRepo) Upsert(ctx context.Context, city models.CountryCoordinates) error {
+	return m.Called(ctx, city).Error(0)
+}
+
+func (m *mockCountryRepo) Delete(ctx context.Context, name string) error {
+	return m.Called(ctx, name).Error(0)
+}
+
 type mockTariffRepo struct {
 	mock.Mock
 }
 
-func (m *mockTariffRepo) GetByCode(ctx context.Context, code string) (*models.Tariff, error) {
+func (m *mockTariffRepo) GetByCode(ctx context.Context, code string, at time.Time) (*models.Tariff, error) {
 	args := m.Called(ctx, code)
 	return args.Get(0).(*models.Tariff), args.Error(1)
 }
 
+func (m *mockTariffRepo) GetVersions(ctx context.Context, code string) ([]models.Tariff, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).([]models.Tariff), args.Error(1)
+}
+
 func (m *mockTariffRepo) CreateTariff(ctx context.Context, tariff *models.Tariff) (*models.Tariff, error) {
-	return nil, nil
+	args := m.Called(ctx, tariff)
+	if args.Error(1) != nil {
+		return nil, args.Error(1)
+	}
+	return tariff, nil
 }
 
 func (m *mockTariffRepo) DeleteTariff(ctx context.Context, code string) error {
-	return nil
+	return m.Called(ctx, code).Error(0)
 }
 
 func (m *mockTariffRepo) GetAll(ctx context.Context) ([]models.Tariff, error) {
@@ -88,11 +132,12 @@ func TestExtendedCalculator_CalculateByTariffCode(t *testing.T) {
 		Currency:          "EUR",
 		VolumetricDivider: 4000,
 		SpeedKmph:         80,
+		Version:           3,
 	}
 
 	tariffRepo.On("GetByCode", mock.Anything, "FAST").Return(tariff, nil)
 
-	extCalc := service.NewExtendedCalculator(countryRepo, tariffRepo)
+	extCalc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
 
 	pkg := models.Package{
 		From:   "France",
@@ -109,27 +154,140 @@ func TestExtendedCalculator_CalculateByTariffCode(t *testing.T) {
 	assert.Greater(t, result.Cost, 0.0)
 	assert.GreaterOrEqual(t, result.EstimatedHours, 6)
 	assert.Equal(t, "EUR", result.Currency)
+	assert.Equal(t, 3, result.TariffVersion)
 }
 
-func TestDefaultCalculator_FallbackOnError(t *testing.T) {
+func TestDefaultCalculator_UnknownCity(t *testing.T) {
 	countryRepo := new(mockCountryRepo)
 
-	countryRepo.On("GetCoordinates", mock.Anything, "Unknown").Return(&models.CountryCoordinates{}, assert.AnError)
+	countryRepo.On("GetCoordinates", mock.Anything, "Moscow").Return(&models.CountryCoordinates{Latitude: 55.75, Longitude: 37.61}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Kazn").Return((*models.CountryCoordinates)(nil), models.ErrCityNotFound)
+	countryRepo.On("GetNames", mock.Anything).Return([]string{"Moscow", "Kazan", "Kaliningrad", "Khabarovsk"}, nil)
 
 	calculator := service.NewCalculator(countryRepo)
 
 	pkg := models.Package{
-		From:   "Unknown",
-		To:     "Unknown",
+		From:   "Moscow",
+		To:     "Kazn",
 		Weight: 3,
 		Length: 30,
 		Width:  20,
 		Height: 15,
 	}
 
-	result, err := calculator.Calculate(context.Background(), pkg)
+	_, err := calculator.Calculate(context.Background(), pkg)
+
+	assert.ErrorIs(t, err, models.ErrCityNotFound)
+	var unknown *models.UnknownCityError
+	assert.ErrorAs(t, err, &unknown)
+	assert.Equal(t, "to", unknown.Field)
+	assert.Equal(t, []string{"Kazan"}, unknown.Suggestions)
+}
+
+func TestExtendedCalculator_UnknownTariff(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	tariffRepo.On("GetByCode", mock.Anything, "FAZT").Return((*models.Tariff)(nil), models.ErrTariffNotFound)
+
+	extCalc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+	_, err := extCalc.CalculateByTariffCode(context.Background(), pkg, "FAZT")
+
+	assert.ErrorIs(t, err, models.ErrTariffNotFound)
+	countryRepo.AssertNotCalled(t, "GetCoordinates", mock.Anything, mock.Anything)
+}
+
+func TestSuggestCities(t *testing.T) {
+	names := []string{"Paris", "Parma", "Prague", "Saint Petersburg", "Санкт-Петербург"}
 
+	assert.Equal(t, []string{"Paris", "Parma"}, service.SuggestCities("pari", names, 3))
+	assert.Equal(t, []string{"Saint Petersburg", "Санкт-Петербург"}, service.SuggestCities("Saint Petersberg", names, 3))
+	assert.Equal(t, []string{"Санкт-Петербург", "Saint Petersburg"}, service.SuggestCities("Sankt-Peterburg", names, 3))
+	assert.Equal(t, []string{"Санкт-Петербург"}, service.SuggestCities("санкт петербург", names, 1))
+	assert.Empty(t, service.SuggestCities("Tokyo", names, 3))
+}
+
+func TestSearchKey(t *testing.T) {
+	assert.Equal(t, "sankt peterburg", models.SearchKey("Санкт-Петербург"))
+	assert.Equal(t, "sankt peterburg", models.SearchKey("  sankt   peterburg "))
+	assert.Equal(t, "moskva", models.SearchKey("Москва"))
+	assert.Equal(t, "nizhniy novgorod", models.SearchKey("Нижний Новгород"))
+	assert.Equal(t, "st petersburg", models.SearchKey("St. Petersburg"))
+
+	assert.Equal(t, []string{"moskva", "moscow"}, models.SearchKeys("Москва", []string{"Moscow", "MOSKVA"}))
+}
+
+func TestExtendedCalculator_PickupSurcharge(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+
+	tariffRepo.On("GetByCode", mock.Anything, "FAST").Return(&models.Tariff{
+		Code:              "FAST",
+		Name:              "Fast",
+		BaseRate:          100,
+		PricePerKm:        10,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         80,
+		PickupSurcharge:   250,
+	}, nil)
+
+	extCalc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+	withoutPickup, err := extCalc.CalculateByTariffCode(context.Background(), pkg, "FAST")
 	assert.NoError(t, err)
-	assert.Equal(t, 72, result.EstimatedHours)
-	assert.Greater(t, result.Cost, 0.0)
+
+	pkg.Pickup = true
+	withPickup, err := extCalc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 250, withPickup.Cost-withoutPickup.Cost, 0.01)
+}
+
+func TestExtendedCalculator_Breakdown(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+	tariffRepo.On("GetByCode", mock.Anything, "FAST").Return(&models.Tariff{
+		Code:              "FAST",
+		Name:              "Fast",
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 1000,
+		SpeedKmph:         80,
+		PickupSurcharge:   50,
+	}, nil)
+
+	extCalc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo})
+
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10, Pickup: true}
+	result, err := extCalc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 343, result.DistanceKm, 5)
+	assert.Equal(t, 3.0, result.VolumetricWeight)
+	assert.Equal(t, 3.0, result.ChargeableWeight)
+
+	var total float64
+	codes := map[string]float64{}
+	for _, item := range result.LineItems {
+		total += item.Amount
+		codes[item.Code] = item.Amount
+	}
+	assert.InDelta(t, result.Cost, total, 0.001)
+	assert.Equal(t, 100.0, codes["base"])
+	assert.Equal(t, 60.0, codes["weight"])
+	assert.Equal(t, 50.0, codes["pickup"])
+	assert.Greater(t, codes["zone_regional"], 0.0)
 }
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/sirupsen/logrus"
+)
+
+const maxCitySuggestions = 3
+
+// coordinates resolves both ends of the package. A city missing from the
+// directory comes back as an UnknownCityError with "did you mean" names.
+func (c *DefaultCalculator) coordinates(ctx context.Context, pkg models.Package) (from, to *models.CountryCoordinates, err error) {
+	from, err = c.repository.GetCoordinates(ctx, pkg.From)
+	if err != nil {
+		return nil, nil, c.cityError(ctx, "from", pkg.From, err)
+	}
+	to, err = c.repository.GetCoordinates(ctx, pkg.To)
+	if err != nil {
+		return nil, nil, c.cityError(ctx, "to", pkg.To, err)
+	}
+	return from, to, nil
+}
+
+func (c *DefaultCalculator) cityError(ctx context.Context, field, name string, err error) error {
+	if !errors.Is(err, models.ErrCityNotFound) {
+		return err
+	}
+	unknown := &models.UnknownCityError{Field: field, Name: name}
+	names, err := c.repository.GetNames(ctx)
+	if err != nil {
+		logrus.Printf("Failed to load city names for suggestions: %v", err)
+		return unknown
+	}
+	unknown.Suggestions = SuggestCities(name, names, maxCitySuggestions)
+	return unknown
+}
+
+// SuggestCities returns up to limit known names closest to the given one by
+// edit distance between search keys, so case and script don't count. Names
+// too far off to be a typo are skipped.
+func SuggestCities(name string, names []string, limit int) []string {
+	query := models.SearchKey(name)
+	if query == "" {
+		return nil
+	}
+	maxDistance := utf8.RuneCountInString(query) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for _, known := range names {
+		d := levenshtein(query, models.SearchKey(known))
+		if d <= maxDistance {
+			candidates = append(candidates, candidate{known, d})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].distance != candidates[j].distance {
+			return candidates[i].distance < candidates[j].distance
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	var out []string
+	for _, c := range candidates {
+		if len(out) == limit {
+			break
+		}
+		out = append(out, c.name)
+	}
+	return out
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/sirupsen/logrus"
+)
+
+// pricingContext pins everything besides the tariff and the parcel that a
+// price depends on, so the same context always yields the same price.
+type pricingContext struct {
+	at    time.Time
+	rules []models.SurchargeRule
+	fuel  float64
+}
+
+func (c *DefaultCalculator) pricingContext(ctx context.Context) pricingContext {
+	pc := pricingContext{at: c.now(), rules: DefaultSurchargeRules()}
+	pc.fuel = c.fuelRate(ctx, pc.at)
+	if c.surcharges == nil {
+		return pc
+	}
+	rules, err := c.surcharges.GetAll(ctx)
+	if err != nil {
+		logrus.Printf("Failed to load surcharge rules, using defaults: %v", err)
+		return pc
+	}
+	pc.rules = rules
+	return pc
+}
+
+// ApplySurcharges runs the enabled rules matching the input over the subtotal
+// and the transit hours. Rules of the same priority are each applied to the
+// amount entering that priority and add up; priorities compound in ascending
+// order. Every price rule that fires becomes a line item.
+func ApplySurcharges(rules []models.SurchargeRule, in models.SurchargeInput, subtotal, hours float64) ([]models.LineItem, float64) {
+	var matched []models.SurchargeRule
+	for _, rule := range rules {
+		if rule.Enabled && rule.Matches(in) {
+			matched = append(matched, rule)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].Priority < matched[j].Priority })
+
+	var items []models.LineItem
+	amount := subtotal
+	for i := 0; i < len(matched); {
+		baseAmount, baseHours := amount, hours
+		priority := matched[i].Priority
+		for ; i < len(matched) && matched[i].Priority == priority; i++ {
+			rule := matched[i]
+			switch rule.Action.Target {
+			case models.SurchargeTargetETA:
+				if rule.Action.Type == models.SurchargeMultiplier {
+					hours += baseHours * (rule.Action.Value - 1)
+				} else {
+					hours += rule.Action.Value
+				}
+			default:
+				delta := rule.Action.Value
+				if rule.Action.Type == models.SurchargeMultiplier {
+					delta = baseAmount * (rule.Action.Value - 1)
+				}
+				amount += delta
+				items = append(items, models.LineItem{Code: rule.ID, Description: rule.Name, Amount: delta})
+			}
+		}
+	}
+	return items, hours
+}
+
+// withoutDistanceBands drops the rules keyed on distance; a zone matrix
+// already prices the lane, so they would charge for the distance twice.
+func withoutDistanceBands(rules []models.SurchargeRule) []models.SurchargeRule {
+	kept := make([]models.SurchargeRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Condition.Distance == nil {
+			kept = append(kept, rule)
+		}
+	}
+	return kept
+}
+
+// DefaultSurchargeRules reproduce the multipliers the calculator used to
+// hard-code; they apply until rules are stored in the database.
+func DefaultSurchargeRules() []models.SurchargeRule {
+	price := func(id, name string, priority int, value float64, cond models.SurchargeCondition) models.SurchargeRule {
+		return models.SurchargeRule{
+			ID:        id,
+			Name:      name,
+			Priority:  priority,
+			Enabled:   true,
+			Condition: cond,
+			Action:    models.SurchargeAction{Type: models.SurchargeMultiplier, Target: models.SurchargeTargetPrice, Value: value},
+		}
+	}
+	eta := func(id, name string, value float64, band models.Band) models.SurchargeRule {
+		rule := price(id, name, 10, value, models.SurchargeCondition{Distance: &band})
+		rule.Action.Target = models.SurchargeTargetETA
+		return rule
+	}
+	return []models.SurchargeRule{
+		price("night", "Night surcharge", 10, 1.15, models.SurchargeCondition{Hours: &models.HourRange{From: 22, To: 6}}),
+		price("weekend", "Weekend surcharge", 10, 1.2, models.SurchargeCondition{Weekdays: []int{int(time.Saturday), int(time.Sunday)}}),
+		price("zone_regional", "Regional zone surcharge", 20, 1.15, models.SurchargeCondition{Distance: &models.Band{Min: 100, Max: 500}}),
+		price("zone_national", "National zone surcharge", 20, 1.3, models.SurchargeCondition{Distance: &models.Band{Min: 500, Max: 1500}}),
+		price("zone_international", "International zone surcharge", 20, 1.5, models.SurchargeCondition{Distance: &models.Band{Min: 1500}}),
+		eta("delay_local", "Local handling delay", 1.2, models.Band{Max: 100}),
+		eta("delay_regional", "Regional handling delay", 1.5, models.Band{Min: 100, Max: 500}),
+		eta("delay_national", "National handling delay", 1.75, models.Band{Min: 500, Max: 1500}),
+		eta("delay_international", "International handling delay", 2.0, models.Band{Min: 1500}),
+	}
+}
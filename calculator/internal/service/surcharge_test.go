@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockSurchargeRepo struct {
+	mock.Mock
+}
+
+func (m *mockSurchargeRepo) GetAll(ctx context.Context) ([]models.SurchargeRule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.SurchargeRule), args.Error(1)
+}
+
+func (m *mockSurchargeRepo) Create(ctx context.Context, rule *models.SurchargeRule) (*models.SurchargeRule, error) {
+	return rule, nil
+}
+
+func (m *mockSurchargeRepo) Update(ctx context.Context, rule *models.SurchargeRule) (*models.SurchargeRule, error) {
+	return rule, nil
+}
+
+func (m *mockSurchargeRepo) Delete(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *mockSurchargeRepo) SeedDefaults(ctx context.Context, rules []models.SurchargeRule) error {
+	return nil
+}
+
+func TestApplySurcharges_PriorityGroups(t *testing.T) {
+	rules := []models.SurchargeRule{
+		{ID: "zone", Name: "Zone", Priority: 20, Enabled: true,
+			Action: models.SurchargeAction{Type: models.SurchargeMultiplier, Target: models.SurchargeTargetPrice, Value: 1.5}},
+		{ID: "night", Name: "Night", Priority: 10, Enabled: true,
+			Condition: models.SurchargeCondition{Hours: &models.HourRange{From: 22, To: 6}},
+			Action:    models.SurchargeAction{Type: models.SurchargeMultiplier, Target: models.SurchargeTargetPrice, Value: 1.1}},
+		{ID: "weekend", Name: "Weekend", Priority: 10, Enabled: true,
+			Condition: models.SurchargeCondition{Weekdays: []int{int(time.Saturday), int(time.Sunday)}},
+			Action:    models.SurchargeAction{Type: models.SurchargeMultiplier, Target: models.SurchargeTargetPrice, Value: 1.2}},
+		{ID: "heavy", Name: "Heavy parcel", Priority: 30, Enabled: true,
+			Condition: models.SurchargeCondition{Weight: &models.Band{Min: 30}},
+			Action:    models.SurchargeAction{Type: models.SurchargeFixed, Target: models.SurchargeTargetPrice, Value: 40}},
+		{ID: "disabled", Name: "Disabled", Priority: 10, Enabled: false,
+			Action: models.SurchargeAction{Type: models.SurchargeFixed, Target: models.SurchargeTargetPrice, Value: 1000}},
+		{ID: "slow", Name: "Slow lane", Priority: 10, Enabled: true,
+			Condition: models.SurchargeCondition{Tariffs: []string{"ECONOMY"}},
+			Action:    models.SurchargeAction{Type: models.SurchargeFixed, Target: models.SurchargeTargetETA, Value: 24}},
+	}
+
+	saturdayNight := time.Date(2025, 3, 8, 23, 0, 0, 0, time.UTC)
+	items, hours := service.ApplySurcharges(rules, models.SurchargeInput{At: saturdayNight, WeightKg: 35, Tariff: "economy"}, 100, 10)
+
+	expected := map[string]float64{"night": 10, "weekend": 20, "zone": 65, "heavy": 40}
+	assert.Len(t, items, len(expected))
+	for i, code := range []string{"night", "weekend", "zone", "heavy"} {
+		assert.Equal(t, code, items[i].Code)
+		assert.InDelta(t, expected[code], items[i].Amount, 1e-9)
+	}
+	assert.Equal(t, 34.0, hours)
+
+	mondayNoon := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	items, hours = service.ApplySurcharges(rules, models.SurchargeInput{At: mondayNoon, WeightKg: 2, Tariff: "FAST"}, 100, 10)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "zone", items[0].Code)
+	assert.Equal(t, 10.0, hours)
+}
+
+func TestExtendedCalculator_DeterministicWithClock(t *testing.T) {
+	countryRepo := new(mockCountryRepo)
+	tariffRepo := new(mockTariffRepo)
+	surchargeRepo := new(mockSurchargeRepo)
+
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13}, nil)
+	tariffRepo.On("GetByCode", mock.Anything, "FAST").Return(&models.Tariff{
+		Code:              "FAST",
+		Name:              "Fast",
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "EUR",
+		VolumetricDivider: 4000,
+		SpeedKmph:         80,
+	}, nil)
+	surchargeRepo.On("GetAll", mock.Anything).Return(service.DefaultSurchargeRules(), nil)
+
+	sundayNight := time.Date(2025, 3, 9, 23, 30, 0, 0, time.UTC)
+	now := sundayNight
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries:  countryRepo,
+		Tariffs:    tariffRepo,
+		Surcharges: surchargeRepo,
+		Clock:      func() time.Time { return now },
+	})
+	pkg := models.Package{From: "France", To: "UK", Weight: 1.5, Length: 20, Width: 15, Height: 10}
+
+	night, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	again, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+	assert.Equal(t, night.Cost, again.Cost)
+	assert.Equal(t, sundayNight, night.PricedAt)
+
+	now = time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	day, err := calc.CalculateByTariffCode(context.Background(), pkg, "FAST")
+	assert.NoError(t, err)
+
+	// night and weekend add up to 35% on top of the subtotal before the zone surcharge
+	assert.InDelta(t, 1.35, night.Cost/day.Cost, 0.01)
+}
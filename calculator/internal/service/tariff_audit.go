@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/sirupsen/logrus"
+)
+
+type actorKey struct{}
+
+// WithActor tags the context with the user a tariff change is recorded
+// against.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func actorFrom(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+func (c *ExtendedCalculator) CreateTariff(ctx context.Context, tariff *models.Tariff) (*models.Tariff, error) {
+	prev, err := c.tariffRepo.GetByCode(ctx, tariff.Code, c.now())
+	if err != nil && !errors.Is(err, models.ErrTariffNotFound) {
+		return nil, err
+	}
+	created, err := c.tariffRepo.CreateTariff(ctx, tariff)
+	if err != nil {
+		return nil, err
+	}
+	c.record(ctx, models.TariffCreated, tariff.Code, prev, created)
+	return created, nil
+}
+
+// UpdateTariff applies a partial change as a new version of the tariff. The
+// caller names the version it edited; if someone else has published one
+// since, the update fails with ErrTariffVersionConflict instead of silently
+// overwriting their change.
+func (c *ExtendedCalculator) UpdateTariff(ctx context.Context, code string, expectedVersion int, patch models.TariffPatch) (*models.Tariff, error) {
+	if patch.Empty() {
+		return nil, fmt.Errorf("%w: nothing to update", models.ErrInvalidTariff)
+	}
+	versions, err := c.tariffRepo.GetVersions(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+	current := versions[len(versions)-1]
+	if current.ValidTo != nil && !current.ValidTo.After(c.now()) {
+		return nil, fmt.Errorf("%w: %s", models.ErrTariffNotFound, code)
+	}
+	if current.Version != expectedVersion {
+		return nil, fmt.Errorf("%w: %s is at version %d, not %d", models.ErrTariffVersionConflict,
+			code, current.Version, expectedVersion)
+	}
+
+	next := patch.Apply(current)
+	if err := next.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", models.ErrInvalidTariff, err)
+	}
+	// two updates racing from the same version both ask for version+1;
+	// the unique (code, version) index lets only one of them through
+	updated, err := c.tariffRepo.CreateTariff(ctx, &next)
+	if err != nil {
+		return nil, err
+	}
+	c.record(ctx, models.TariffUpdated, code, &current, updated)
+	return updated, nil
+}
+
+func (c *ExtendedCalculator) DeleteTariff(ctx context.Context, code string) error {
+	prev, err := c.tariffRepo.GetByCode(ctx, code, c.now())
+	if err != nil && !errors.Is(err, models.ErrTariffNotFound) {
+		return err
+	}
+	if err := c.tariffRepo.DeleteTariff(ctx, code); err != nil {
+		return err
+	}
+	c.record(ctx, models.TariffDeleted, code, prev, nil)
+	return nil
+}
+
+func (c *ExtendedCalculator) GetTariffAudit(ctx context.Context, code string) ([]models.TariffAudit, error) {
+	if c.audit == nil {
+		return nil, nil
+	}
+	return c.audit.GetByCode(ctx, code)
+}
+
+// record writes the audit entry after the change went through. A failed
+// write is logged rather than undoing a change that is already live.
+func (c *ExtendedCalculator) record(ctx context.Context, action, code string, before, after *models.Tariff) {
+	if c.audit == nil {
+		return
+	}
+	entry := models.TariffAudit{
+		TariffCode: code,
+		Action:     action,
+		Actor:      actorFrom(ctx),
+		At:         c.now(),
+		Before:     before,
+		After:      after,
+	}
+	if err := c.audit.Record(ctx, entry); err != nil {
+		logrus.Printf("Failed to record %s of tariff %s by %q: %v", action, code, entry.Actor, err)
+	}
+}
@@ -0,0 +1,113 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockTariffAuditRepo struct {
+	mock.Mock
+}
+
+func (m *mockTariffAuditRepo) Record(ctx context.Context, entry models.TariffAudit) error {
+	return m.Called(ctx, entry).Error(0)
+}
+
+func (m *mockTariffAuditRepo) GetByCode(ctx context.Context, code string) ([]models.TariffAudit, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).([]models.TariffAudit), args.Error(1)
+}
+
+func standardTariff(version int) models.Tariff {
+	return models.Tariff{
+		Code:              "STANDARD",
+		Name:              "Standard",
+		BaseRate:          100,
+		PricePerKm:        1,
+		PricePerKg:        20,
+		Currency:          "RUB",
+		VolumetricDivider: 5000,
+		SpeedKmph:         60,
+		Version:           version,
+		ValidFrom:         time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestExtendedCalculator_UpdateTariff(t *testing.T) {
+	tariffRepo := new(mockTariffRepo)
+	audit := new(mockTariffAuditRepo)
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: new(mockCountryRepo), Tariffs: tariffRepo, Audit: audit})
+
+	v1, v2 := standardTariff(1), standardTariff(2)
+	v1.ValidTo = &v2.ValidFrom
+	tariffRepo.On("GetVersions", mock.Anything, "STANDARD").Return([]models.Tariff{v1, v2}, nil)
+	tariffRepo.On("CreateTariff", mock.Anything, mock.AnythingOfType("*models.Tariff")).
+		Run(func(args mock.Arguments) { args.Get(1).(*models.Tariff).Version = 3 }).
+		Return(nil, nil)
+	audit.On("Record", mock.Anything, mock.Anything).Return(nil)
+
+	rate := 150.0
+	ctx := service.WithActor(context.Background(), "moderator-1")
+	updated, err := calc.UpdateTariff(ctx, "STANDARD", 2, models.TariffPatch{BaseRate: &rate})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, updated.Version)
+	assert.Equal(t, 150.0, updated.BaseRate)
+	assert.Equal(t, v2.PricePerKg, updated.PricePerKg, "fields outside the patch are kept")
+	assert.True(t, updated.ValidFrom.IsZero(), "the repository decides when the version starts")
+
+	entry := audit.Calls[0].Arguments.Get(1).(models.TariffAudit)
+	assert.Equal(t, models.TariffUpdated, entry.Action)
+	assert.Equal(t, "moderator-1", entry.Actor)
+	assert.Equal(t, 100.0, entry.Before.BaseRate)
+	assert.Equal(t, 150.0, entry.After.BaseRate)
+}
+
+func TestExtendedCalculator_UpdateTariff_StaleVersion(t *testing.T) {
+	tariffRepo := new(mockTariffRepo)
+	audit := new(mockTariffAuditRepo)
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: new(mockCountryRepo), Tariffs: tariffRepo, Audit: audit})
+
+	tariffRepo.On("GetVersions", mock.Anything, "STANDARD").Return([]models.Tariff{standardTariff(1), standardTariff(2)}, nil)
+
+	rate := 150.0
+	_, err := calc.UpdateTariff(context.Background(), "STANDARD", 1, models.TariffPatch{BaseRate: &rate})
+	assert.ErrorIs(t, err, models.ErrTariffVersionConflict)
+	tariffRepo.AssertNotCalled(t, "CreateTariff", mock.Anything, mock.Anything)
+	audit.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+}
+
+func TestExtendedCalculator_UpdateTariff_Invalid(t *testing.T) {
+	tariffRepo := new(mockTariffRepo)
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: new(mockCountryRepo), Tariffs: tariffRepo})
+
+	_, err := calc.UpdateTariff(context.Background(), "STANDARD", 1, models.TariffPatch{})
+	assert.ErrorIs(t, err, models.ErrInvalidTariff)
+
+	tariffRepo.On("GetVersions", mock.Anything, "STANDARD").Return([]models.Tariff{standardTariff(1)}, nil)
+	rate := -1.0
+	_, err = calc.UpdateTariff(context.Background(), "STANDARD", 1, models.TariffPatch{PricePerKm: &rate})
+	assert.ErrorIs(t, err, models.ErrInvalidTariff)
+}
+
+func TestExtendedCalculator_DeleteTariff_Audited(t *testing.T) {
+	tariffRepo := new(mockTariffRepo)
+	audit := new(mockTariffAuditRepo)
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: new(mockCountryRepo), Tariffs: tariffRepo, Audit: audit})
+
+	current := standardTariff(2)
+	tariffRepo.On("GetByCode", mock.Anything, "STANDARD").Return(&current, nil)
+	tariffRepo.On("DeleteTariff", mock.Anything, "STANDARD").Return(nil)
+	audit.On("Record", mock.Anything, mock.MatchedBy(func(e models.TariffAudit) bool {
+		return e.Action == models.TariffDeleted && e.Actor == "moderator-1" && e.Before.Version == 2 && e.After == nil
+	})).Return(nil)
+
+	err := calc.DeleteTariff(service.WithActor(context.Background(), "moderator-1"), "STANDARD")
+	assert.NoError(t, err)
+	audit.AssertExpectations(t)
+}
@@ -0,0 +1,138 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockZoneRepo struct {
+	mock.Mock
+}
+
+func (m *mockZoneRepo) GetRate(ctx context.Context, tariffCode, fromZone, toZone string) (*models.ZoneRate, error) {
+	args := m.Called(ctx, tariffCode, fromZone, toZone)
+	rate, _ := args.Get(0).(*models.ZoneRate)
+	return rate, args.Error(1)
+}
+
+func (m *mockZoneRepo) GetMatrix(ctx context.Context, tariffCode string) ([]models.ZoneRate, error) {
+	args := m.Called(ctx, tariffCode)
+	return args.Get(0).([]models.ZoneRate), args.Error(1)
+}
+
+func (m *mockZoneRepo) SetRate(ctx context.Context, rate *models.ZoneRate) (*models.ZoneRate, error) {
+	return rate, nil
+}
+
+func (m *mockZoneRepo) DeleteRate(ctx context.Context, tariffCode, fromZone, toZone string) error {
+	return nil
+}
+
+func zonePricedSetup() (*mockCountryRepo, *mockTariffRepo, *mockSurchargeRepo, *mockZoneRepo) {
+	countryRepo := new(mockCountryRepo)
+	countryRepo.On("GetCoordinates", mock.Anything, "France").Return(&models.CountryCoordinates{Latitude: 48.85, Longitude: 2.35, Zone: "EU"}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "UK").Return(&models.CountryCoordinates{Latitude: 51.51, Longitude: -0.13, Zone: "UK"}, nil)
+	countryRepo.On("GetCoordinates", mock.Anything, "Japan").Return(&models.CountryCoordinates{Latitude: 35.68, Longitude: 139.69, Zone: "ASIA"}, nil)
+
+	surchargeRepo := new(mockSurchargeRepo)
+	surchargeRepo.On("GetAll", mock.Anything).Return([]models.SurchargeRule{
+		{ID: "channel", Name: "Channel crossing", Priority: 10, Enabled: true,
+			Condition: models.SurchargeCondition{FromZones: []string{"EU"}, ToZones: []string{"UK"}},
+			Action:    models.SurchargeAction{Type: models.SurchargeFixed, Target: models.SurchargeTargetPrice, Value: 25}},
+	}, nil)
+
+	zoneRepo := new(mockZoneRepo)
+	zoneRepo.On("GetRate", mock.Anything, "ZONAL", "EU", "UK").
+		Return(&models.ZoneRate{TariffCode: "ZONAL", FromZone: "EU", ToZone: "UK", BaseRate: 400, PricePerKg: 30, TransitDays: 2}, nil)
+	zoneRepo.On("GetRate", mock.Anything, "ZONAL", "EU", "ASIA").Return(nil, models.ErrZoneRateNotFound)
+
+	return countryRepo, new(mockTariffRepo), surchargeRepo, zoneRepo
+}
+
+var zonalTariff = models.Tariff{
+	Code:              "ZONAL",
+	Name:              "Zonal",
+	Currency:          "EUR",
+	VolumetricDivider: 4000,
+	SpeedKmph:         60,
+	PricingMode:       models.PricingZone,
+	Version:           2,
+}
+
+func TestExtendedCalculator_ZonePricing(t *testing.T) {
+	countryRepo, tariffRepo, surchargeRepo, zoneRepo := zonePricedSetup()
+	tariff := zonalTariff
+	tariffRepo.On("GetByCode", mock.Anything, "ZONAL").Return(&tariff, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries:  countryRepo,
+		Tariffs:    tariffRepo,
+		Surcharges: surchargeRepo,
+		Zones:      zoneRepo,
+		Clock:      func() time.Time { return time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC) },
+	})
+	pkg := models.Package{From: "France", To: "UK", Weight: 2, Length: 20, Width: 15, Height: 10}
+
+	result, err := calc.CalculateByTariffCode(context.Background(), pkg, "ZONAL")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 485.0, result.Cost)
+	assert.Equal(t, 48, result.EstimatedHours)
+	assert.Equal(t, 2, result.TariffVersion)
+	codes := map[string]float64{}
+	for _, item := range result.LineItems {
+		codes[item.Code] = item.Amount
+	}
+	assert.Equal(t, map[string]float64{"base": 400, "weight": 60, "channel": 25}, codes)
+
+	_, err = calc.CalculateByTariffCode(context.Background(), models.Package{From: "France", To: "Japan", Weight: 1}, "ZONAL")
+	assert.ErrorIs(t, err, models.ErrZoneRateNotFound)
+}
+
+func TestExtendedCalculator_ZonePricing_SkipsDistanceBands(t *testing.T) {
+	countryRepo, tariffRepo, _, zoneRepo := zonePricedSetup()
+	tariff := zonalTariff
+	tariffRepo.On("GetByCode", mock.Anything, "ZONAL").Return(&tariff, nil)
+	surchargeRepo := new(mockSurchargeRepo)
+	surchargeRepo.On("GetAll", mock.Anything).Return(service.DefaultSurchargeRules(), nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries:  countryRepo,
+		Tariffs:    tariffRepo,
+		Surcharges: surchargeRepo,
+		Zones:      zoneRepo,
+		Clock:      func() time.Time { return time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC) },
+	})
+
+	result, err := calc.CalculateByTariffCode(context.Background(), models.Package{From: "France", To: "UK", Weight: 2, Length: 20, Width: 15, Height: 10}, "ZONAL")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 460.0, result.Cost)
+	assert.Greater(t, result.DistanceKm, 300.0)
+	for _, item := range result.LineItems {
+		assert.NotContains(t, item.Code, "zone_")
+	}
+}
+
+func TestExtendedCalculator_QuoteAllTariffs_MissingZoneRate(t *testing.T) {
+	countryRepo, tariffRepo, surchargeRepo, zoneRepo := zonePricedSetup()
+	distance := models.Tariff{Code: "ROAD", Name: "Road", BaseRate: 100, PricePerKm: 1, PricePerKg: 20, Currency: "EUR", VolumetricDivider: 4000, SpeedKmph: 60}
+	tariffRepo.On("GetAll", mock.Anything).Return([]models.Tariff{zonalTariff, distance}, nil)
+
+	calc := service.NewExtendedCalculator(service.CalculatorDeps{Countries: countryRepo, Tariffs: tariffRepo, Surcharges: surchargeRepo, Zones: zoneRepo})
+	quotes, err := calc.QuoteAllTariffs(context.Background(), models.Package{From: "France", To: "Japan", Weight: 1})
+
+	assert.NoError(t, err)
+	assert.Len(t, quotes, 2)
+	assert.Equal(t, "ROAD", quotes[0].TariffCode)
+	assert.True(t, quotes[0].Eligible)
+	assert.Equal(t, "ZONAL", quotes[1].TariffCode)
+	assert.False(t, quotes[1].Eligible)
+	assert.Contains(t, quotes[1].Reason, "EU -> ASIA")
+}
@@ -0,0 +1,197 @@
+package transport_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/middleware"
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/internal/transport"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type deleteRecorder struct {
+	service.Calculator
+	deleted []string
+}
+
+func (d *deleteRecorder) DeleteTariff(ctx context.Context, code string) error {
+	d.deleted = append(d.deleted, code)
+	return nil
+}
+
+func TestTariffWrites_RequireModerator(t *testing.T) {
+	calc := &deleteRecorder{}
+	server := transport.NewGRPCServer(calc, nil, logrus.New())
+	interceptor := middleware.AuthInterceptor()
+	deleteTariff := func(ctx context.Context, req any) (any, error) {
+		return server.DeleteTariff(ctx, req.(*calculatorpb.TariffCodeRequest))
+	}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want codes.Code
+	}{
+		{name: "anonymous", md: metadata.Pairs("role", "moderator"), want: codes.Unauthenticated},
+		{name: "customer", md: metadata.Pairs("authorization", "user-1", "role", "user"), want: codes.PermissionDenied},
+		{name: "no role", md: metadata.Pairs("authorization", "user-1"), want: codes.PermissionDenied},
+		{name: "moderator", md: metadata.Pairs("authorization", "mod-1", "role", "moderator"), want: codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			_, err := interceptor(ctx, &calculatorpb.TariffCodeRequest{Code: "STANDARD"}, &grpc.UnaryServerInfo{}, deleteTariff)
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+	assert.Equal(t, []string{"STANDARD"}, calc.deleted)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	interceptor := middleware.StreamAuthInterceptor()
+
+	var userID any
+	handler := func(srv any, stream grpc.ServerStream) error {
+		userID = stream.Context().Value(middleware.GRPCUserIDKey())
+		return nil
+	}
+
+	anonymous := metadata.NewIncomingContext(context.Background(), metadata.Pairs("role", "user"))
+	err := interceptor(nil, &fakeServerStream{ctx: anonymous}, &grpc.StreamServerInfo{}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Nil(t, userID)
+
+	signedIn := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "user-1"))
+	err = interceptor(nil, &fakeServerStream{ctx: signedIn}, &grpc.StreamServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", userID)
+}
+
+// assertModeratorOnly checks that call turns away anonymous callers and
+// customers before it touches any storage.
+func assertModeratorOnly(t *testing.T, call func(ctx context.Context) error) {
+	t.Helper()
+	customer := context.WithValue(context.Background(), middleware.GRPCUserIDKey(), "user-1")
+	customer = context.WithValue(customer, middleware.GRPCRoleKey(), "user")
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(call(context.Background())))
+	assert.Equal(t, codes.PermissionDenied, status.Code(call(customer)))
+}
+
+func TestSurchargeWrites_RequireModerator(t *testing.T) {
+	server := transport.NewSurchargeGRPCServer(nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.CreateSurchargeRule(ctx, &calculatorpb.SurchargeRule{})
+		return err
+	})
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.UpdateSurchargeRule(ctx, &calculatorpb.SurchargeRule{})
+		return err
+	})
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.DeleteSurchargeRule(ctx, &calculatorpb.SurchargeRuleID{})
+		return err
+	})
+}
+
+func TestZoneWrites_RequireModerator(t *testing.T) {
+	server := transport.NewZoneGRPCServer(nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.SetZoneRate(ctx, &calculatorpb.ZoneRate{})
+		return err
+	})
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.DeleteZoneRate(ctx, &calculatorpb.ZoneRateKey{})
+		return err
+	})
+}
+
+func TestExchangeRateWrites_RequireModerator(t *testing.T) {
+	server := transport.NewExchangeGRPCServer(nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.SetExchangeRates(ctx, &calculatorpb.ExchangeRateList{})
+		return err
+	})
+}
+
+func TestFuelIndexWrites_RequireModerator(t *testing.T) {
+	server := transport.NewFuelGRPCServer(nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.SetFuelIndex(ctx, &calculatorpb.FuelIndexList{})
+		return err
+	})
+}
+
+func TestHubWrites_RequireModerator(t *testing.T) {
+	server := transport.NewHubGRPCServer(nil, nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.CreateHub(ctx, &calculatorpb.Hub{})
+		return err
+	})
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.CreateHubLink(ctx, &calculatorpb.HubLink{})
+		return err
+	})
+}
+
+func TestPromotionWrites_RequireModerator(t *testing.T) {
+	server := transport.NewPromotionGRPCServer(nil, nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.CreatePromotion(ctx, &calculatorpb.Promotion{})
+		return err
+	})
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.SetPromotionActive(ctx, &calculatorpb.SetPromotionActiveRequest{})
+		return err
+	})
+}
+
+func TestCityAliasWrites_RequireModerator(t *testing.T) {
+	server := transport.NewCityGRPCServer(nil, logrus.New())
+
+	assertModeratorOnly(t, func(ctx context.Context) error {
+		_, err := server.SetCityAliases(ctx, &calculatorpb.CityAliasesRequest{})
+		return err
+	})
+}
+
+func TestPromoRedemptions_RequireService(t *testing.T) {
+	server := transport.NewPromotionGRPCServer(nil, nil, logrus.New())
+	moderator := context.WithValue(context.Background(), middleware.GRPCUserIDKey(), "mod-1")
+	moderator = context.WithValue(moderator, middleware.GRPCRoleKey(), "moderator")
+
+	redeem := func(ctx context.Context) error {
+		_, err := server.RedeemPromo(ctx, &calculatorpb.RedeemPromoRequest{Code: "WELCOME10", UserId: "user-2", PackageId: "pkg-1", FirstOrder: true})
+		return err
+	}
+	release := func(ctx context.Context) error {
+		_, err := server.ReleasePromo(ctx, &calculatorpb.ReleasePromoRequest{Code: "WELCOME10", PackageId: "pkg-1"})
+		return err
+	}
+	for _, call := range []func(ctx context.Context) error{redeem, release} {
+		assertModeratorOnly(t, call)
+		assert.Equal(t, codes.PermissionDenied, status.Code(call(moderator)))
+	}
+}
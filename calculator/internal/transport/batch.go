@@ -0,0 +1,128 @@
+package transport
+
+import (
+	"context"
+	"io"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const batchWorkers = 8
+
+// CalculateBatch prices parcels as they stream in and streams results back
+// as they are ready, tagged with the caller's correlation ID. A parcel that
+// can't be priced gets an error result; the stream itself keeps going.
+func (s *GRPCServer) CalculateBatch(stream calculatorpb.CalculatorService_CalculateBatchServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	items := make(chan service.BatchItem)
+	rejected := make(chan *calculatorpb.BatchCalculateResponse)
+	recvErr := make(chan error, 1)
+	go func() {
+		defer close(items)
+		for {
+			req, err := stream.Recv()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			item := batchItem(req)
+			if err := validateBatchItem(item.Package); err != nil {
+				select {
+				case rejected <- batchError(req.GetCorrelationId(), err):
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			select {
+			case items <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	results := s.service.CalculateBatch(ctx, items, batchWorkers)
+	for results != nil {
+		var resp *calculatorpb.BatchCalculateResponse
+		select {
+		case resp = <-rejected:
+		case res, ok := <-results:
+			if !ok {
+				results = nil
+				continue
+			}
+			if res.Err != nil {
+				resp = batchError(res.ID, calculationError(res.Err))
+			} else {
+				resp = &calculatorpb.BatchCalculateResponse{CorrelationId: res.ID, Result: resultToProto(res.Result)}
+			}
+		}
+		if err := stream.Send(resp); err != nil {
+			s.logger.Errorf("CalculateBatch send failed: %v", err)
+			return err
+		}
+	}
+
+	select {
+	case err := <-recvErr:
+		s.logger.Errorf("CalculateBatch receive failed: %v", err)
+		return err
+	default:
+		return nil
+	}
+}
+
+func batchItem(req *calculatorpb.BatchCalculateRequest) service.BatchItem {
+	p := req.GetParcel()
+	return service.BatchItem{
+		ID:         req.GetCorrelationId(),
+		TariffCode: p.GetTariffCode(),
+		Package: models.Package{
+			Weight:         p.GetWeight(),
+			From:           p.GetFrom(),
+			To:             p.GetTo(),
+			Address:        p.GetAddress(),
+			Length:         int(p.GetLength()),
+			Width:          int(p.GetWidth()),
+			Height:         int(p.GetHeight()),
+			Pickup:         p.GetPickup(),
+			TargetCurrency: p.GetTargetCurrency(),
+			PromoCode:      p.GetPromoCode(),
+			Category:       p.GetCategory(),
+		},
+	}
+}
+
+// validateBatchItem checks what pricing needs; unlike a checkout quote a
+// catalogue item has no delivery address.
+func validateBatchItem(pkg models.Package) error {
+	if pkg.Weight <= 0 {
+		return status.Error(codes.InvalidArgument, "Invalid weight")
+	}
+	if pkg.From == "" || pkg.To == "" {
+		return status.Error(codes.InvalidArgument, "Missing required address fields")
+	}
+	if pkg.Length <= 0 || pkg.Width <= 0 || pkg.Height <= 0 {
+		return status.Error(codes.InvalidArgument, "Invalid parameters")
+	}
+	return nil
+}
+
+func batchError(id string, err error) *calculatorpb.BatchCalculateResponse {
+	st := status.Convert(err)
+	return &calculatorpb.BatchCalculateResponse{
+		CorrelationId: id,
+		ErrorCode:     st.Code().String(),
+		Error:         st.Message(),
+	}
+}
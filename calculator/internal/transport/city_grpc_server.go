@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultCityLimit = 10
+	maxCityLimit     = 50
+)
+
+type CityGRPCServer struct {
+	calculatorpb.UnimplementedCityServiceServer
+	repo   repository.CountryRepository
+	logger *logrus.Logger
+}
+
+func NewCityGRPCServer(repo repository.CountryRepository, logger *logrus.Logger) *CityGRPCServer {
+	return &CityGRPCServer{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *CityGRPCServer) SearchCities(ctx context.Context, req *calculatorpb.CitySearchRequest) (*calculatorpb.CityList, error) {
+	if models.SearchKey(req.GetQuery()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	cities, err := s.repo.Search(ctx, req.GetQuery(), cityLimit(req.GetLimit()))
+	if err != nil {
+		s.logger.Errorf("City search for %q failed: %v", req.GetQuery(), err)
+		return nil, status.Errorf(codes.Internal, "city search failed: %v", err)
+	}
+	out := &calculatorpb.CityList{}
+	for _, city := range cities {
+		out.Cities = append(out.Cities, cityToProto(city))
+	}
+	return out, nil
+}
+
+func (s *CityGRPCServer) NearestCities(ctx context.Context, req *calculatorpb.NearestCitiesRequest) (*calculatorpb.CityList, error) {
+	lat, lon := req.GetLatitude(), req.GetLongitude()
+	if lat < -90 || lat > 90 || lon < -180 || lon > 180 {
+		return nil, status.Error(codes.InvalidArgument, "coordinates are out of range")
+	}
+	cities, err := s.repo.Nearest(ctx, lat, lon, cityLimit(req.GetLimit()))
+	if err != nil {
+		s.logger.Errorf("Nearest city search failed: %v", err)
+		return nil, status.Errorf(codes.Internal, "nearest city search failed: %v", err)
+	}
+	out := &calculatorpb.CityList{}
+	for _, city := range cities {
+		pb := cityToProto(city.CountryCoordinates)
+		pb.DistanceKm = city.DistanceKm
+		out.Cities = append(out.Cities, pb)
+	}
+	return out, nil
+}
+
+func (s *CityGRPCServer) SetCityAliases(ctx context.Context, req *calculatorpb.CityAliasesRequest) (*calculatorpb.City, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "city name is required")
+	}
+	city, err := s.repo.SetAliases(ctx, req.GetName(), req.GetAliases())
+	if err != nil {
+		if errors.Is(err, models.ErrCityNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "set city aliases failed: %v", err)
+	}
+	return cityToProto(*city), nil
+}
+
+func cityLimit(limit int32) int {
+	switch {
+	case limit <= 0:
+		return defaultCityLimit
+	case limit > maxCityLimit:
+		return maxCityLimit
+	}
+	return int(limit)
+}
+
+func cityToProto(city models.CountryCoordinates) *calculatorpb.City {
+	return &calculatorpb.City{
+		Name:      city.Name,
+		Code:      city.Code,
+		Latitude:  city.Latitude,
+		Longitude: city.Longitude,
+		Zone:      city.Zone,
+		Aliases:   city.Aliases,
+	}
+}
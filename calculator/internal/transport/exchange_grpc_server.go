@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"context"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ExchangeGRPCServer struct {
+	calculatorpb.UnimplementedExchangeRateServiceServer
+	repo   repository.ExchangeRateRepository
+	logger *logrus.Logger
+}
+
+func NewExchangeGRPCServer(repo repository.ExchangeRateRepository, logger *logrus.Logger) *ExchangeGRPCServer {
+	return &ExchangeGRPCServer{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *ExchangeGRPCServer) ListExchangeRates(ctx context.Context, _ *calculatorpb.Empty) (*calculatorpb.ExchangeRateList, error) {
+	rates, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get exchange rates: %v", err)
+	}
+	return exchangeRatesToProto(rates), nil
+}
+
+func (s *ExchangeGRPCServer) SetExchangeRates(ctx context.Context, req *calculatorpb.ExchangeRateList) (*calculatorpb.ExchangeRateList, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	rates := make([]models.ExchangeRate, 0, len(req.GetRates()))
+	for _, r := range req.GetRates() {
+		rate := models.ExchangeRate{
+			Base:  r.GetBase(),
+			Quote: r.GetQuote(),
+			Rate:  r.GetRate(),
+		}
+		if r.GetDate() != nil {
+			rate.Date = r.GetDate().AsTime()
+		}
+		rate.Normalize()
+		if err := rate.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid exchange rate %s/%s: %v", rate.Base, rate.Quote, err)
+		}
+		rates = append(rates, rate)
+	}
+	if err := s.repo.SetRates(ctx, rates); err != nil {
+		return nil, status.Errorf(codes.Internal, "set exchange rates failed: %v", err)
+	}
+	return exchangeRatesToProto(rates), nil
+}
+
+func exchangeRatesToProto(rates []models.ExchangeRate) *calculatorpb.ExchangeRateList {
+	out := &calculatorpb.ExchangeRateList{}
+	for _, rate := range rates {
+		out.Rates = append(out.Rates, &calculatorpb.ExchangeRate{
+			Base:  rate.Base,
+			Quote: rate.Quote,
+			Rate:  rate.Rate,
+			Date:  timestamppb.New(rate.Date),
+		})
+	}
+	return out
+}
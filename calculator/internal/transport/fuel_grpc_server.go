@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"context"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type FuelGRPCServer struct {
+	calculatorpb.UnimplementedFuelSurchargeServiceServer
+	repo   repository.FuelIndexRepository
+	logger *logrus.Logger
+}
+
+func NewFuelGRPCServer(repo repository.FuelIndexRepository, logger *logrus.Logger) *FuelGRPCServer {
+	return &FuelGRPCServer{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *FuelGRPCServer) ListFuelIndex(ctx context.Context, _ *calculatorpb.Empty) (*calculatorpb.FuelIndexList, error) {
+	entries, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get fuel index: %v", err)
+	}
+	return fuelIndexToProto(entries), nil
+}
+
+func (s *FuelGRPCServer) SetFuelIndex(ctx context.Context, req *calculatorpb.FuelIndexList) (*calculatorpb.FuelIndexList, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	entries := make([]models.FuelIndex, 0, len(req.GetEntries()))
+	for _, e := range req.GetEntries() {
+		entry := models.FuelIndex{Percent: e.GetPercent()}
+		if e.GetEffectiveFrom() != nil {
+			entry.EffectiveFrom = e.GetEffectiveFrom().AsTime()
+		}
+		entry.Normalize()
+		if err := entry.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid fuel index entry: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := s.repo.Set(ctx, entries); err != nil {
+		return nil, status.Errorf(codes.Internal, "set fuel index failed: %v", err)
+	}
+	s.logger.Infof("Fuel surcharge index updated with %d entries", len(entries))
+	return fuelIndexToProto(entries), nil
+}
+
+func fuelIndexToProto(entries []models.FuelIndex) *calculatorpb.FuelIndexList {
+	out := &calculatorpb.FuelIndexList{}
+	for _, entry := range entries {
+		out.Entries = append(out.Entries, &calculatorpb.FuelIndexEntry{
+			Percent:       entry.Percent,
+			EffectiveFrom: timestamppb.New(entry.EffectiveFrom),
+		})
+	}
+	return out
+}
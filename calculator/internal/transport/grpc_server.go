@@ -2,9 +2,11 @@ package transport
 
 import (
 	"context"
+	"errors"
 	"net"
 
 	"github.com/maksroxx/DeliveryService/calculator/internal/middleware"
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
 	"github.com/maksroxx/DeliveryService/calculator/internal/service"
 	"github.com/maksroxx/DeliveryService/calculator/models"
 	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
@@ -12,30 +14,37 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type GRPCServer struct {
 	calculatorpb.UnimplementedCalculatorServiceServer
 	service service.Calculator
+	quotes  *service.QuoteSigner
 	logger  *logrus.Logger
 }
 
-func NewGRPCServer(calc service.Calculator, logger *logrus.Logger) *GRPCServer {
+func NewGRPCServer(calc service.Calculator, quotes *service.QuoteSigner, logger *logrus.Logger) *GRPCServer {
 	return &GRPCServer{
 		service: calc,
+		quotes:  quotes,
 		logger:  logger,
 	}
 }
 
 func (s *GRPCServer) CalculateDeliveryCost(ctx context.Context, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
 	pkg := models.Package{
-		Weight:  req.GetWeight(),
-		From:    req.GetFrom(),
-		To:      req.GetTo(),
-		Address: req.GetAddress(),
-		Length:  int(req.GetLength()),
-		Height:  int(req.GetHeight()),
-		Width:   int(req.GetWidth()),
+		Weight:         req.GetWeight(),
+		From:           req.GetFrom(),
+		To:             req.GetTo(),
+		Address:        req.GetAddress(),
+		Length:         int(req.GetLength()),
+		Height:         int(req.GetHeight()),
+		Width:          int(req.GetWidth()),
+		Pickup:         req.GetPickup(),
+		TargetCurrency: req.GetTargetCurrency(),
+		PromoCode:      req.GetPromoCode(),
+		Category:       req.GetCategory(),
 	}
 
 	if pkg.Weight <= 0 {
@@ -51,58 +60,276 @@ func (s *GRPCServer) CalculateDeliveryCost(ctx context.Context, req *calculatorp
 	result, err := s.service.Calculate(context.Background(), pkg)
 	if err != nil {
 		s.logger.Errorf("gRPC CalculateDeliveryCost error: %v", err)
+		if errors.Is(err, models.ErrCityNotFound) {
+			return nil, cityNotFound(err)
+		}
+		if errors.Is(err, models.ErrExchangeRateNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "Calculation failed: "+err.Error())
 	}
 
-	return &calculatorpb.CalculateDeliveryCostResponse{
-		Cost:           result.Cost,
-		EstimatedHours: int32(result.EstimatedHours),
-		Currency:       result.Currency,
-	}, nil
+	return s.quoteResponse(pkg, "DEFAULT", result)
 }
 
 func (s *GRPCServer) CalculateByTariffCode(ctx context.Context, req *calculatorpb.CalculateByTariffRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
 	pkg := models.Package{
-		Weight: req.Weight,
-		From:   req.From,
-		To:     req.To,
-		Length: int(req.Length),
-		Width:  int(req.Width),
-		Height: int(req.Height),
+		Weight:         req.Weight,
+		From:           req.From,
+		To:             req.To,
+		Length:         int(req.Length),
+		Width:          int(req.Width),
+		Height:         int(req.Height),
+		Pickup:         req.Pickup,
+		TargetCurrency: req.GetTargetCurrency(),
+		PromoCode:      req.GetPromoCode(),
+		Category:       req.GetCategory(),
 	}
 	res, err := s.service.CalculateByTariffCode(ctx, pkg, req.TariffCode)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "calculation error: %v", err)
+		return nil, calculationError(err)
 	}
-	return &calculatorpb.CalculateDeliveryCostResponse{
-		Cost:           res.Cost,
-		EstimatedHours: int32(res.EstimatedHours),
-		Currency:       res.Currency,
-	}, nil
+	return s.quoteResponse(pkg, req.TariffCode, res)
 }
 
-func (s *GRPCServer) GetTariffList(ctx context.Context, _ *calculatorpb.TariffListRequest) (*calculatorpb.TariffListResponse, error) {
-	tariffs, err := s.service.GetTariffs(ctx)
+// calculationError maps a failure to price a parcel onto the status the
+// client sees.
+func calculationError(err error) error {
+	switch {
+	case errors.Is(err, models.ErrCityNotFound):
+		return cityNotFound(err)
+	case errors.Is(err, models.ErrTariffNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrNotEligible):
+		return notEligible(err)
+	case errors.Is(err, models.ErrZoneRateNotFound) || errors.Is(err, models.ErrExchangeRateNotFound) || errors.Is(err, models.ErrNoRoadRoute):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return status.Errorf(codes.Internal, "calculation error: %v", err)
+}
+
+// quoteResponse locks the calculated price in a signed quote the client can
+// hand back when creating the package.
+func (s *GRPCServer) quoteResponse(pkg models.Package, tariffCode string, result models.CalculationResult) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+	resp := resultToProto(result)
+	if s.quotes == nil {
+		return resp, nil
+	}
+	quoteID, expiresAt, err := s.quotes.Issue(pkg, tariffCode, result)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get tariffs: %v", err)
+		s.logger.Errorf("Failed to issue quote: %v", err)
+		return nil, status.Error(codes.Internal, "failed to issue quote")
 	}
-	var result []*calculatorpb.Tariff
-	for _, t := range tariffs {
-		result = append(result, &calculatorpb.Tariff{
-			Code:              t.Code,
-			Name:              t.Name,
-			BaseRate:          t.BaseRate,
-			PricePerKm:        t.PricePerKm,
-			PricePerKg:        t.PricePerKg,
-			Currency:          t.Currency,
-			VolumetricDivider: t.VolumetricDivider,
-			SpeedKmph:         int32(t.SpeedKmph),
+	resp.QuoteId = quoteID
+	resp.QuoteExpiresAt = timestamppb.New(expiresAt)
+	return resp, nil
+}
+
+func (s *GRPCServer) VerifyQuote(ctx context.Context, req *calculatorpb.VerifyQuoteRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+	if s.quotes == nil {
+		return nil, status.Error(codes.Unimplemented, "quotes are disabled")
+	}
+	pkg := models.Package{
+		Weight:         req.GetWeight(),
+		From:           req.GetFrom(),
+		To:             req.GetTo(),
+		Length:         int(req.GetLength()),
+		Width:          int(req.GetWidth()),
+		Height:         int(req.GetHeight()),
+		Pickup:         req.GetPickup(),
+		TargetCurrency: req.GetTargetCurrency(),
+		Category:       req.GetCategory(),
+	}
+	quote, err := s.quotes.Verify(req.GetQuoteId(), pkg, req.GetTariffCode())
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrQuoteExpired):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	resp := resultToProto(quote.Result())
+	resp.QuoteId = req.GetQuoteId()
+	resp.QuoteExpiresAt = timestamppb.New(quote.ExpiresAt)
+	return resp, nil
+}
+
+// cityNotFound reports an unknown city as NotFound with the "did you mean"
+// names attached as an UnknownCity detail.
+func cityNotFound(err error) error {
+	st := status.New(codes.NotFound, err.Error())
+	var unknown *models.UnknownCityError
+	if !errors.As(err, &unknown) {
+		return st.Err()
+	}
+	detailed, detailErr := st.WithDetails(&calculatorpb.UnknownCity{
+		Field:       unknown.Field,
+		Name:        unknown.Name,
+		Suggestions: unknown.Suggestions,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// notEligible reports a parcel the tariff won't take as FailedPrecondition
+// with the broken limits attached as an Ineligible detail.
+func notEligible(err error) error {
+	st := status.New(codes.FailedPrecondition, err.Error())
+	var ineligible *models.IneligibleError
+	if !errors.As(err, &ineligible) {
+		return st.Err()
+	}
+	detailed, detailErr := st.WithDetails(&calculatorpb.Ineligible{
+		TariffCode: ineligible.Tariff,
+		Reasons:    ineligible.Reasons,
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func resultToProto(result models.CalculationResult) *calculatorpb.CalculateDeliveryCostResponse {
+	resp := &calculatorpb.CalculateDeliveryCostResponse{
+		Cost:              result.Cost,
+		EstimatedHours:    int32(result.EstimatedHours),
+		Currency:          result.Currency,
+		Legs:              legsToProto(result.Legs),
+		TariffVersion:     int32(result.TariffVersion),
+		DistanceKm:        result.DistanceKm,
+		ChargeableWeight:  result.ChargeableWeight,
+		VolumetricWeight:  result.VolumetricWeight,
+		OriginalCost:      result.OriginalCost,
+		OriginalCurrency:  result.OriginalCurrency,
+		ExchangeRate:      result.ExchangeRate,
+		PromoCode:         result.PromoCode,
+		PromoRejected:     result.PromoRejected,
+		FuelSurchargeRate: result.FuelSurchargeRate,
+	}
+	if !result.PricedAt.IsZero() {
+		resp.PricedAt = timestamppb.New(result.PricedAt)
+	}
+	if w := result.DeliveryWindow; w != nil {
+		resp.DeliveryFrom = timestamppb.New(w.From)
+		resp.DeliveryTo = timestamppb.New(w.To)
+	}
+	for _, item := range result.LineItems {
+		resp.LineItems = append(resp.LineItems, &calculatorpb.LineItem{
+			Code:        item.Code,
+			Description: item.Description,
+			Amount:      item.Amount,
 		})
 	}
-	return &calculatorpb.TariffListResponse{Tariffs: result}, nil
+	return resp
+}
+
+func (s *GRPCServer) QuoteAllTariffs(ctx context.Context, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.QuoteAllTariffsResponse, error) {
+	pkg := models.Package{
+		Weight:         req.GetWeight(),
+		From:           req.GetFrom(),
+		To:             req.GetTo(),
+		Address:        req.GetAddress(),
+		Length:         int(req.GetLength()),
+		Height:         int(req.GetHeight()),
+		Width:          int(req.GetWidth()),
+		Pickup:         req.GetPickup(),
+		TargetCurrency: req.GetTargetCurrency(),
+		PromoCode:      req.GetPromoCode(),
+		Category:       req.GetCategory(),
+	}
+	if pkg.Weight <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid weight")
+	}
+	if err := Validate(pkg); err != nil {
+		return nil, err
+	}
+
+	quotes, err := s.service.QuoteAllTariffs(ctx, pkg)
+	if err != nil {
+		s.logger.Errorf("gRPC QuoteAllTariffs error: %v", err)
+		if errors.Is(err, models.ErrCityNotFound) {
+			return nil, cityNotFound(err)
+		}
+		return nil, status.Errorf(codes.Internal, "quote failed: %v", err)
+	}
+
+	resp := &calculatorpb.QuoteAllTariffsResponse{}
+	for _, q := range quotes {
+		quote := &calculatorpb.TariffQuote{
+			TariffCode:     q.TariffCode,
+			TariffName:     q.TariffName,
+			Cost:           q.Cost,
+			EstimatedHours: int32(q.EstimatedHours),
+			Currency:       q.Currency,
+			TariffVersion:  int32(q.TariffVersion),
+			Eligible:       q.Eligible,
+			Reason:         q.Reason,
+			Cheapest:       q.Cheapest,
+			Fastest:        q.Fastest,
+			PromoCode:      q.PromoCode,
+			PromoRejected:  q.PromoRejected,
+		}
+		if w := q.DeliveryWindow; w != nil {
+			quote.DeliveryFrom = timestamppb.New(w.From)
+			quote.DeliveryTo = timestamppb.New(w.To)
+		}
+		resp.Quotes = append(resp.Quotes, quote)
+	}
+	return resp, nil
+}
+
+// GetTariffList returns the tariffs in force. When the request describes a
+// parcel, only the tariffs that accept it are listed.
+func (s *GRPCServer) GetTariffList(ctx context.Context, req *calculatorpb.TariffListRequest) (*calculatorpb.TariffListResponse, error) {
+	pkg := models.Package{
+		Weight:   req.GetWeight(),
+		From:     req.GetFrom(),
+		To:       req.GetTo(),
+		Length:   int(req.GetLength()),
+		Width:    int(req.GetWidth()),
+		Height:   int(req.GetHeight()),
+		Category: req.GetCategory(),
+	}
+	if pkg == (models.Package{}) {
+		tariffs, err := s.service.GetTariffs(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get tariffs: %v", err)
+		}
+		return tariffsToProto(tariffs), nil
+	}
+
+	if pkg.Weight < 0 || pkg.Length < 0 || pkg.Width < 0 || pkg.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "parcel size must not be negative")
+	}
+	tariffs, err := s.service.GetEligibleTariffs(ctx, pkg)
+	if err != nil {
+		if errors.Is(err, models.ErrCityNotFound) {
+			return nil, cityNotFound(err)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get tariffs: %v", err)
+	}
+	return tariffsToProto(tariffs), nil
+}
+
+func (s *GRPCServer) GetTariffVersions(ctx context.Context, req *calculatorpb.TariffCodeRequest) (*calculatorpb.TariffListResponse, error) {
+	versions, err := s.service.GetTariffVersions(ctx, req.GetCode())
+	if err != nil {
+		if errors.Is(err, models.ErrTariffNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get tariff versions: %v", err)
+	}
+	return tariffsToProto(versions), nil
 }
 
 func (s *GRPCServer) CreateTariff(ctx context.Context, req *calculatorpb.Tariff) (*calculatorpb.Tariff, error) {
+	ctx, err := moderator(ctx)
+	if err != nil {
+		return nil, err
+	}
 	tariff := models.Tariff{
 		Code:              req.GetCode(),
 		Name:              req.GetName(),
@@ -112,39 +339,253 @@ func (s *GRPCServer) CreateTariff(ctx context.Context, req *calculatorpb.Tariff)
 		Currency:          req.GetCurrency(),
 		VolumetricDivider: req.GetVolumetricDivider(),
 		SpeedKmph:         float64(req.GetSpeedKmph()),
+		PickupSurcharge:   req.GetPickupSurcharge(),
+		PricingMode:       req.GetPricingMode(),
+		DistanceProvider:  req.GetDistanceProvider(),
+		FuelSurcharge:     req.GetFuelSurcharge(),
+		Limits:            limitsFromProto(req.GetLimits()),
+	}
+	if req.GetValidFrom() != nil {
+		tariff.ValidFrom = req.GetValidFrom().AsTime()
 	}
 
 	if err := tariff.Validate(); err != nil {
-		return nil, status.Error(codes.InvalidArgument, "Invalid tariff data")
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid tariff data: %v", err)
 	}
 
-	if _, err := s.service.CreateTariff(ctx, &tariff); err != nil {
+	created, err := s.service.CreateTariff(ctx, &tariff)
+	if err != nil {
+		if errors.Is(err, models.ErrTariffVersionConflict) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "create tariff failed: %v", err)
 	}
 
-	return req, nil
+	return tariffToProto(*created), nil
+}
+
+func (s *GRPCServer) UpdateTariff(ctx context.Context, req *calculatorpb.UpdateTariffRequest) (*calculatorpb.Tariff, error) {
+	ctx, err := moderator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+	if req.GetExpectedVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "expected_version is required")
+	}
+	patch := models.TariffPatch{
+		Name:              req.Name,
+		BaseRate:          req.BaseRate,
+		PricePerKm:        req.PricePerKm,
+		PricePerKg:        req.PricePerKg,
+		Currency:          req.Currency,
+		VolumetricDivider: req.VolumetricDivider,
+		PickupSurcharge:   req.PickupSurcharge,
+		PricingMode:       req.PricingMode,
+		DistanceProvider:  req.DistanceProvider,
+		FuelSurcharge:     req.FuelSurcharge,
+	}
+	if req.SpeedKmph != nil {
+		speed := float64(req.GetSpeedKmph())
+		patch.SpeedKmph = &speed
+	}
+	if req.GetLimits() != nil {
+		limits := limitsFromProto(req.GetLimits())
+		patch.Limits = &limits
+	}
+	if req.GetValidFrom() != nil {
+		validFrom := req.GetValidFrom().AsTime()
+		patch.ValidFrom = &validFrom
+	}
+
+	updated, err := s.service.UpdateTariff(ctx, req.GetCode(), int(req.GetExpectedVersion()), patch)
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrInvalidTariff):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, models.ErrTariffNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrTariffVersionConflict):
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "update tariff failed: %v", err)
+	}
+	return tariffToProto(*updated), nil
 }
 
 func (s *GRPCServer) DeleteTariff(ctx context.Context, req *calculatorpb.TariffCodeRequest) (*calculatorpb.Empty, error) {
+	ctx, err := moderator(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.service.DeleteTariff(ctx, req.GetCode()); err != nil {
 		return nil, status.Errorf(codes.Internal, "delete failed: %v", err)
 	}
 	return &calculatorpb.Empty{}, nil
 }
 
-func StartGRPCServer(port string, calc service.Calculator, logger *logrus.Logger) error {
+func (s *GRPCServer) GetTariffAudit(ctx context.Context, req *calculatorpb.TariffCodeRequest) (*calculatorpb.TariffAuditList, error) {
+	ctx, err := moderator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.service.GetTariffAudit(ctx, req.GetCode())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tariff audit: %v", err)
+	}
+	out := &calculatorpb.TariffAuditList{}
+	for _, e := range entries {
+		entry := &calculatorpb.TariffAuditEntry{
+			TariffCode: e.TariffCode,
+			Action:     e.Action,
+			Actor:      e.Actor,
+			At:         timestamppb.New(e.At),
+		}
+		if e.Before != nil {
+			entry.Before = tariffToProto(*e.Before)
+		}
+		if e.After != nil {
+			entry.After = tariffToProto(*e.After)
+		}
+		out.Entries = append(out.Entries, entry)
+	}
+	return out, nil
+}
+
+// userWithRole returns the caller's user ID when the gateway has vouched
+// for the given role.
+func userWithRole(ctx context.Context, role string) (string, error) {
+	userID, _ := ctx.Value(middleware.GRPCUserIDKey()).(string)
+	if userID == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization required")
+	}
+	if r, _ := ctx.Value(middleware.GRPCRoleKey()).(string); r != role {
+		return "", status.Error(codes.PermissionDenied, "forbidden: insufficient role")
+	}
+	return userID, nil
+}
+
+// moderator admits moderators only and records the caller as the actor of
+// the tariff audit trail.
+func moderator(ctx context.Context) (context.Context, error) {
+	userID, err := userWithRole(ctx, "moderator")
+	if err != nil {
+		return nil, err
+	}
+	return service.WithActor(ctx, userID), nil
+}
+
+// requireModerator admits moderators only, for admin writes that keep no
+// audit trail.
+func requireModerator(ctx context.Context) error {
+	_, err := userWithRole(ctx, "moderator")
+	return err
+}
+
+// requireService admits the database service only; the gateway never
+// forwards its role, so customers can't reach these calls.
+func requireService(ctx context.Context) error {
+	_, err := userWithRole(ctx, "service")
+	return err
+}
+
+func tariffToProto(t models.Tariff) *calculatorpb.Tariff {
+	out := &calculatorpb.Tariff{
+		Code:              t.Code,
+		Name:              t.Name,
+		BaseRate:          t.BaseRate,
+		PricePerKm:        t.PricePerKm,
+		PricePerKg:        t.PricePerKg,
+		Currency:          t.Currency,
+		VolumetricDivider: t.VolumetricDivider,
+		SpeedKmph:         int32(t.SpeedKmph),
+		PickupSurcharge:   t.PickupSurcharge,
+		Version:           int32(t.Version),
+		ValidFrom:         timestamppb.New(t.ValidFrom),
+		PricingMode:       t.PricingMode,
+		DistanceProvider:  t.DistanceProvider,
+		FuelSurcharge:     t.FuelSurcharge,
+		Limits: &calculatorpb.TariffLimits{
+			MaxWeight:            t.Limits.MaxWeight,
+			MaxVolumetricWeight:  t.Limits.MaxVolumetricWeight,
+			MaxDimension:         int32(t.Limits.MaxDimension),
+			MaxGirth:             int32(t.Limits.MaxGirth),
+			Origins:              t.Limits.Origins,
+			Destinations:         t.Limits.Destinations,
+			ProhibitedCategories: t.Limits.ProhibitedCategories,
+		},
+	}
+	if t.ValidTo != nil {
+		out.ValidTo = timestamppb.New(*t.ValidTo)
+	}
+	return out
+}
+
+func limitsFromProto(l *calculatorpb.TariffLimits) models.TariffLimits {
+	return models.TariffLimits{
+		MaxWeight:            l.GetMaxWeight(),
+		MaxVolumetricWeight:  l.GetMaxVolumetricWeight(),
+		MaxDimension:         int(l.GetMaxDimension()),
+		MaxGirth:             int(l.GetMaxGirth()),
+		Origins:              l.GetOrigins(),
+		Destinations:         l.GetDestinations(),
+		ProhibitedCategories: l.GetProhibitedCategories(),
+	}
+}
+
+func tariffsToProto(tariffs []models.Tariff) *calculatorpb.TariffListResponse {
+	var result []*calculatorpb.Tariff
+	for _, t := range tariffs {
+		result = append(result, tariffToProto(t))
+	}
+	return &calculatorpb.TariffListResponse{Tariffs: result}
+}
+
+// ServerDeps is everything the gRPC services of the calculator are built on.
+type ServerDeps struct {
+	Calculator service.Calculator
+	Quotes     *service.QuoteSigner
+	Optimizer  service.RouteOptimizer
+	Hubs       repository.HubRepository
+	Router     service.HubRouter
+	Surcharges repository.SurchargeRuleRepository
+	Zones      repository.ZoneMatrixRepository
+	Rates      repository.ExchangeRateRepository
+	Fuel       repository.FuelIndexRepository
+	Promotions *service.Promotions
+	Promos     repository.PromotionRepository
+	Cities     repository.CountryRepository
+	Logger     *logrus.Logger
+}
+
+func StartGRPCServer(port string, deps ServerDeps) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
 		return err
 	}
 
+	logger := deps.Logger
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			middleware.AuthInterceptor(),
 			middleware.NewLoggingInterceptor(logger),
 		),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamAuthInterceptor(),
+			middleware.NewStreamLoggingInterceptor(logger),
+		),
 	)
-	calculatorpb.RegisterCalculatorServiceServer(grpcServer, NewGRPCServer(calc, logger))
+	calculatorpb.RegisterCalculatorServiceServer(grpcServer, NewGRPCServer(deps.Calculator, deps.Quotes, logger))
+	calculatorpb.RegisterRouteOptimizerServiceServer(grpcServer, NewRouteGRPCServer(deps.Optimizer, logger))
+	calculatorpb.RegisterHubNetworkServiceServer(grpcServer, NewHubGRPCServer(deps.Hubs, deps.Router, logger))
+	calculatorpb.RegisterSurchargeRuleServiceServer(grpcServer, NewSurchargeGRPCServer(deps.Surcharges, logger))
+	calculatorpb.RegisterZoneMatrixServiceServer(grpcServer, NewZoneGRPCServer(deps.Zones, logger))
+	calculatorpb.RegisterExchangeRateServiceServer(grpcServer, NewExchangeGRPCServer(deps.Rates, logger))
+	calculatorpb.RegisterFuelSurchargeServiceServer(grpcServer, NewFuelGRPCServer(deps.Fuel, logger))
+	calculatorpb.RegisterPromotionServiceServer(grpcServer, NewPromotionGRPCServer(deps.Promotions, deps.Promos, logger))
+	calculatorpb.RegisterCityServiceServer(grpcServer, NewCityGRPCServer(deps.Cities, logger))
 
 	logger.Infof("gRPC server listening on :%s", port)
 	return grpcServer.Serve(lis)
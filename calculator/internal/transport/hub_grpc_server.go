@@ -0,0 +1,103 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type HubGRPCServer struct {
+	calculatorpb.UnimplementedHubNetworkServiceServer
+	repo   repository.HubRepository
+	router service.HubRouter
+	logger *logrus.Logger
+}
+
+func NewHubGRPCServer(repo repository.HubRepository, router service.HubRouter, logger *logrus.Logger) *HubGRPCServer {
+	return &HubGRPCServer{
+		repo:   repo,
+		router: router,
+		logger: logger,
+	}
+}
+
+func (s *HubGRPCServer) CreateHub(ctx context.Context, req *calculatorpb.Hub) (*calculatorpb.Hub, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	hub := models.Hub{
+		Code:      req.GetCode(),
+		Name:      req.GetName(),
+		Country:   req.GetCountry(),
+		Latitude:  req.GetLatitude(),
+		Longitude: req.GetLongitude(),
+	}
+	if err := hub.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid hub: %v", err)
+	}
+	if _, err := s.repo.CreateHub(ctx, &hub); err != nil {
+		return nil, status.Errorf(codes.Internal, "create hub failed: %v", err)
+	}
+	s.router.Invalidate()
+	return req, nil
+}
+
+func (s *HubGRPCServer) CreateHubLink(ctx context.Context, req *calculatorpb.HubLink) (*calculatorpb.HubLink, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	link := models.HubLink{
+		From:         req.GetFrom(),
+		To:           req.GetTo(),
+		TransitHours: req.GetTransitHours(),
+		Cost:         req.GetCost(),
+	}
+	if err := link.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid link: %v", err)
+	}
+	if _, err := s.repo.CreateLink(ctx, &link); err != nil {
+		return nil, status.Errorf(codes.Internal, "create link failed: %v", err)
+	}
+	s.router.Invalidate()
+	return req, nil
+}
+
+func (s *HubGRPCServer) GetHubRoute(ctx context.Context, req *calculatorpb.HubRouteRequest) (*calculatorpb.HubRouteResponse, error) {
+	if req.GetFrom() == "" || req.GetTo() == "" {
+		return nil, status.Error(codes.InvalidArgument, "from and to are required")
+	}
+	route, err := s.router.Route(ctx, req.GetFrom(), req.GetTo())
+	if err != nil {
+		if errors.Is(err, service.ErrNoHubRoute) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "routing failed: %v", err)
+	}
+	return &calculatorpb.HubRouteResponse{
+		Legs:            legsToProto(route.Legs),
+		TotalDistanceKm: route.TotalDistance,
+		TotalHours:      route.TotalHours,
+		TotalCost:       route.TotalCost,
+	}, nil
+}
+
+func legsToProto(legs []models.Leg) []*calculatorpb.RouteLeg {
+	var out []*calculatorpb.RouteLeg
+	for _, l := range legs {
+		out = append(out, &calculatorpb.RouteLeg{
+			FromHub:      l.FromHub,
+			ToHub:        l.ToHub,
+			DistanceKm:   l.DistanceKm,
+			TransitHours: l.TransitHours,
+			Cost:         l.Cost,
+		})
+	}
+	return out
+}
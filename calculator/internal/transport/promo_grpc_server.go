@@ -0,0 +1,156 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type PromotionGRPCServer struct {
+	calculatorpb.UnimplementedPromotionServiceServer
+	promotions *service.Promotions
+	repo       repository.PromotionRepository
+	logger     *logrus.Logger
+}
+
+func NewPromotionGRPCServer(promotions *service.Promotions, repo repository.PromotionRepository, logger *logrus.Logger) *PromotionGRPCServer {
+	return &PromotionGRPCServer{
+		promotions: promotions,
+		repo:       repo,
+		logger:     logger,
+	}
+}
+
+func (s *PromotionGRPCServer) ListPromotions(ctx context.Context, _ *calculatorpb.Empty) (*calculatorpb.PromotionList, error) {
+	promos, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get promotions: %v", err)
+	}
+	out := &calculatorpb.PromotionList{}
+	for _, promo := range promos {
+		out.Promotions = append(out.Promotions, promotionToProto(promo))
+	}
+	return out, nil
+}
+
+func (s *PromotionGRPCServer) CreatePromotion(ctx context.Context, req *calculatorpb.Promotion) (*calculatorpb.Promotion, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	promo := models.Promotion{
+		Code:           models.NormalizePromoCode(req.GetCode()),
+		Description:    req.GetDescription(),
+		Type:           req.GetType(),
+		Value:          req.GetValue(),
+		Currency:       req.GetCurrency(),
+		Tariffs:        req.GetTariffs(),
+		MaxUses:        int(req.GetMaxUses()),
+		MaxUsesPerUser: int(req.GetMaxUsesPerUser()),
+		FirstOrderOnly: req.GetFirstOrderOnly(),
+		Active:         req.GetActive(),
+	}
+	for _, r := range req.GetRoutes() {
+		promo.Routes = append(promo.Routes, models.PromoRoute{From: r.GetFrom(), To: r.GetTo()})
+	}
+	if req.GetValidFrom() != nil {
+		promo.ValidFrom = req.GetValidFrom().AsTime()
+	}
+	if req.GetValidTo() != nil {
+		validTo := req.GetValidTo().AsTime()
+		promo.ValidTo = &validTo
+	}
+	if err := promo.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid promotion: %v", err)
+	}
+	if _, err := s.repo.Create(ctx, &promo); err != nil {
+		return nil, status.Errorf(codes.Internal, "create promotion failed: %v", err)
+	}
+	return promotionToProto(promo), nil
+}
+
+func (s *PromotionGRPCServer) SetPromotionActive(ctx context.Context, req *calculatorpb.SetPromotionActiveRequest) (*calculatorpb.Empty, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetActive(ctx, models.NormalizePromoCode(req.GetCode()), req.GetActive()); err != nil {
+		if errors.Is(err, models.ErrPromoNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "update promotion failed: %v", err)
+	}
+	return &calculatorpb.Empty{}, nil
+}
+
+// RedeemPromo is called by the database service for the orders it creates;
+// it alone knows whether the order is the user's first.
+func (s *PromotionGRPCServer) RedeemPromo(ctx context.Context, req *calculatorpb.RedeemPromoRequest) (*calculatorpb.Empty, error) {
+	if err := requireService(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetCode() == "" || req.GetUserId() == "" || req.GetPackageId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code, user_id and package_id are required")
+	}
+	err := s.promotions.Redeem(ctx, service.Redemption{
+		Code:       req.GetCode(),
+		UserID:     req.GetUserId(),
+		PackageID:  req.GetPackageId(),
+		TariffCode: req.GetTariffCode(),
+		From:       req.GetFrom(),
+		To:         req.GetTo(),
+		FirstOrder: req.GetFirstOrder(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, models.ErrPromoNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, models.ErrPromoNotApplicable),
+			errors.Is(err, models.ErrPromoExhausted),
+			errors.Is(err, models.ErrPromoUserLimit):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "redeem promo failed: %v", err)
+	}
+	return &calculatorpb.Empty{}, nil
+}
+
+func (s *PromotionGRPCServer) ReleasePromo(ctx context.Context, req *calculatorpb.ReleasePromoRequest) (*calculatorpb.Empty, error) {
+	if err := requireService(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.promotions.Release(ctx, req.GetCode(), req.GetPackageId()); err != nil {
+		return nil, status.Errorf(codes.Internal, "release promo failed: %v", err)
+	}
+	return &calculatorpb.Empty{}, nil
+}
+
+func promotionToProto(p models.Promotion) *calculatorpb.Promotion {
+	out := &calculatorpb.Promotion{
+		Code:           p.Code,
+		Description:    p.Description,
+		Type:           p.Type,
+		Value:          p.Value,
+		Currency:       p.Currency,
+		Tariffs:        p.Tariffs,
+		ValidFrom:      timestamppb.New(p.ValidFrom),
+		MaxUses:        int32(p.MaxUses),
+		MaxUsesPerUser: int32(p.MaxUsesPerUser),
+		FirstOrderOnly: p.FirstOrderOnly,
+		Used:           int32(p.Used),
+		Active:         p.Active,
+	}
+	for _, r := range p.Routes {
+		out.Routes = append(out.Routes, &calculatorpb.PromoRoute{From: r.From, To: r.To})
+	}
+	if p.ValidTo != nil {
+		out.ValidTo = timestamppb.New(*p.ValidTo)
+	}
+	return out
+}
@@ -0,0 +1,115 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RouteGRPCServer struct {
+	calculatorpb.UnimplementedRouteOptimizerServiceServer
+	optimizer service.RouteOptimizer
+	logger    *logrus.Logger
+}
+
+func NewRouteGRPCServer(optimizer service.RouteOptimizer, logger *logrus.Logger) *RouteGRPCServer {
+	return &RouteGRPCServer{
+		optimizer: optimizer,
+		logger:    logger,
+	}
+}
+
+func (s *RouteGRPCServer) OptimizeRoute(ctx context.Context, req *calculatorpb.OptimizeRouteRequest) (*calculatorpb.OptimizeRouteResponse, error) {
+	if req.GetDepot() == nil {
+		return nil, status.Error(codes.InvalidArgument, "depot is required")
+	}
+	if len(req.GetStops()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one stop is required")
+	}
+	if req.GetCapacity() < 0 || req.GetSpeedKmph() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "capacity and speed must not be negative")
+	}
+
+	routeReq := models.RouteRequest{
+		Depot:         stopFromProto(req.GetDepot()),
+		SpeedKmph:     req.GetSpeedKmph(),
+		Capacity:      req.GetCapacity(),
+		ReturnToDepot: req.GetReturnToDepot(),
+	}
+	if req.GetStartTime() != nil {
+		routeReq.StartTime = req.GetStartTime().AsTime()
+	}
+	for _, st := range req.GetStops() {
+		routeReq.Stops = append(routeReq.Stops, stopFromProto(st))
+	}
+
+	plan, err := s.optimizer.OptimizeRoute(ctx, routeReq)
+	if err != nil {
+		s.logger.Errorf("gRPC OptimizeRoute error: %v", err)
+		if errors.Is(err, models.ErrStopNotLocated) || errors.Is(err, models.ErrCityNotFound) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "route optimization failed: "+err.Error())
+	}
+
+	resp := &calculatorpb.OptimizeRouteResponse{
+		TotalDistanceKm: plan.TotalDistance,
+		FinishTime:      timestamppb.New(plan.FinishTime),
+	}
+	for _, ps := range plan.Stops {
+		resp.Stops = append(resp.Stops, &calculatorpb.PlannedStop{
+			Stop:                   stopToProto(ps.Stop),
+			Sequence:               int32(ps.Sequence),
+			DistanceFromPreviousKm: ps.DistanceFromPrev,
+			Eta:                    timestamppb.New(ps.ETA),
+			Departure:              timestamppb.New(ps.Departure),
+		})
+	}
+	for _, st := range plan.Unassigned {
+		resp.Unassigned = append(resp.Unassigned, stopToProto(st))
+	}
+	return resp, nil
+}
+
+func stopFromProto(st *calculatorpb.RouteStop) models.Stop {
+	stop := models.Stop{
+		ID:             st.GetId(),
+		Location:       st.GetLocation(),
+		Latitude:       st.GetLatitude(),
+		Longitude:      st.GetLongitude(),
+		Weight:         st.GetWeight(),
+		ServiceMinutes: int(st.GetServiceMinutes()),
+	}
+	if st.GetWindowStart() != nil {
+		stop.WindowStart = st.GetWindowStart().AsTime()
+	}
+	if st.GetWindowEnd() != nil {
+		stop.WindowEnd = st.GetWindowEnd().AsTime()
+	}
+	return stop
+}
+
+func stopToProto(st models.Stop) *calculatorpb.RouteStop {
+	out := &calculatorpb.RouteStop{
+		Id:             st.ID,
+		Location:       st.Location,
+		Latitude:       st.Latitude,
+		Longitude:      st.Longitude,
+		Weight:         st.Weight,
+		ServiceMinutes: int32(st.ServiceMinutes),
+	}
+	if !st.WindowStart.IsZero() {
+		out.WindowStart = timestamppb.New(st.WindowStart)
+	}
+	if !st.WindowEnd.IsZero() {
+		out.WindowEnd = timestamppb.New(st.WindowEnd)
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/transport"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type failingOptimizer struct {
+	err error
+}
+
+func (f failingOptimizer) OptimizeRoute(ctx context.Context, req models.RouteRequest) (models.RoutePlan, error) {
+	return models.RoutePlan{}, f.err
+}
+
+func TestOptimizeRoute_ErrorCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{name: "unknown city", err: fmt.Errorf("stop %q: %w", "a", models.ErrCityNotFound), want: codes.InvalidArgument},
+		{name: "stop without location", err: fmt.Errorf("stop %q: %w", "a", models.ErrStopNotLocated), want: codes.InvalidArgument},
+		{name: "lookup failure", err: fmt.Errorf("stop %q: %w", "a", errors.New("server selection timeout")), want: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := transport.NewRouteGRPCServer(failingOptimizer{err: tt.err}, logrus.New())
+			_, err := server.OptimizeRoute(context.Background(), &calculatorpb.OptimizeRouteRequest{
+				Depot: &calculatorpb.RouteStop{Id: "depot"},
+				Stops: []*calculatorpb.RouteStop{{Id: "a", Location: "Atlantis"}},
+			})
+			assert.Equal(t, tt.want, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SurchargeGRPCServer struct {
+	calculatorpb.UnimplementedSurchargeRuleServiceServer
+	repo   repository.SurchargeRuleRepository
+	logger *logrus.Logger
+}
+
+func NewSurchargeGRPCServer(repo repository.SurchargeRuleRepository, logger *logrus.Logger) *SurchargeGRPCServer {
+	return &SurchargeGRPCServer{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *SurchargeGRPCServer) ListSurchargeRules(ctx context.Context, _ *calculatorpb.Empty) (*calculatorpb.SurchargeRuleList, error) {
+	rules, err := s.repo.GetAll(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get surcharge rules: %v", err)
+	}
+	out := &calculatorpb.SurchargeRuleList{}
+	for _, rule := range rules {
+		out.Rules = append(out.Rules, surchargeRuleToProto(rule))
+	}
+	return out, nil
+}
+
+func (s *SurchargeGRPCServer) CreateSurchargeRule(ctx context.Context, req *calculatorpb.SurchargeRule) (*calculatorpb.SurchargeRule, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	rule := surchargeRuleFromProto(req)
+	if rule.ID == "" {
+		rule.ID = uuid.NewString()
+	}
+	if err := rule.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid surcharge rule: %v", err)
+	}
+	if _, err := s.repo.Create(ctx, &rule); err != nil {
+		return nil, status.Errorf(codes.Internal, "create surcharge rule failed: %v", err)
+	}
+	return surchargeRuleToProto(rule), nil
+}
+
+func (s *SurchargeGRPCServer) UpdateSurchargeRule(ctx context.Context, req *calculatorpb.SurchargeRule) (*calculatorpb.SurchargeRule, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	rule := surchargeRuleFromProto(req)
+	if err := rule.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid surcharge rule: %v", err)
+	}
+	if _, err := s.repo.Update(ctx, &rule); err != nil {
+		if errors.Is(err, models.ErrSurchargeRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "update surcharge rule failed: %v", err)
+	}
+	return surchargeRuleToProto(rule), nil
+}
+
+func (s *SurchargeGRPCServer) DeleteSurchargeRule(ctx context.Context, req *calculatorpb.SurchargeRuleID) (*calculatorpb.Empty, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Delete(ctx, req.GetId()); err != nil {
+		if errors.Is(err, models.ErrSurchargeRuleNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "delete surcharge rule failed: %v", err)
+	}
+	return &calculatorpb.Empty{}, nil
+}
+
+func surchargeRuleFromProto(req *calculatorpb.SurchargeRule) models.SurchargeRule {
+	c := req.GetCondition()
+	rule := models.SurchargeRule{
+		ID:       req.GetId(),
+		Name:     req.GetName(),
+		Priority: int(req.GetPriority()),
+		Enabled:  req.GetEnabled(),
+		Condition: models.SurchargeCondition{
+			FromZones: c.GetFromZones(),
+			ToZones:   c.GetToZones(),
+			From:      c.GetFrom(),
+			To:        c.GetTo(),
+			Tariffs:   c.GetTariffs(),
+		},
+		Action: models.SurchargeAction{
+			Type:   req.GetAction().GetType(),
+			Target: req.GetAction().GetTarget(),
+			Value:  req.GetAction().GetValue(),
+		},
+	}
+	if rule.Action.Target == "" {
+		rule.Action.Target = models.SurchargeTargetPrice
+	}
+	if h := c.GetHours(); h != nil {
+		rule.Condition.Hours = &models.HourRange{From: int(h.GetFrom()), To: int(h.GetTo())}
+	}
+	for _, d := range c.GetWeekdays() {
+		rule.Condition.Weekdays = append(rule.Condition.Weekdays, int(d))
+	}
+	if b := c.GetDistance(); b != nil {
+		rule.Condition.Distance = &models.Band{Min: b.GetMin(), Max: b.GetMax()}
+	}
+	if b := c.GetWeight(); b != nil {
+		rule.Condition.Weight = &models.Band{Min: b.GetMin(), Max: b.GetMax()}
+	}
+	return rule
+}
+
+func surchargeRuleToProto(rule models.SurchargeRule) *calculatorpb.SurchargeRule {
+	c := rule.Condition
+	cond := &calculatorpb.SurchargeCondition{
+		FromZones: c.FromZones,
+		ToZones:   c.ToZones,
+		From:      c.From,
+		To:        c.To,
+		Tariffs:   c.Tariffs,
+	}
+	if c.Hours != nil {
+		cond.Hours = &calculatorpb.HourRange{From: int32(c.Hours.From), To: int32(c.Hours.To)}
+	}
+	for _, d := range c.Weekdays {
+		cond.Weekdays = append(cond.Weekdays, int32(d))
+	}
+	if c.Distance != nil {
+		cond.Distance = &calculatorpb.Band{Min: c.Distance.Min, Max: c.Distance.Max}
+	}
+	if c.Weight != nil {
+		cond.Weight = &calculatorpb.Band{Min: c.Weight.Min, Max: c.Weight.Max}
+	}
+	return &calculatorpb.SurchargeRule{
+		Id:        rule.ID,
+		Name:      rule.Name,
+		Priority:  int32(rule.Priority),
+		Enabled:   rule.Enabled,
+		Condition: cond,
+		Action: &calculatorpb.SurchargeAction{
+			Type:   rule.Action.Type,
+			Target: rule.Action.Target,
+			Value:  rule.Action.Value,
+		},
+	}
+}
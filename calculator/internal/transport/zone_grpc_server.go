@@ -0,0 +1,86 @@
+package transport
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ZoneGRPCServer struct {
+	calculatorpb.UnimplementedZoneMatrixServiceServer
+	repo   repository.ZoneMatrixRepository
+	logger *logrus.Logger
+}
+
+func NewZoneGRPCServer(repo repository.ZoneMatrixRepository, logger *logrus.Logger) *ZoneGRPCServer {
+	return &ZoneGRPCServer{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+func (s *ZoneGRPCServer) GetZoneMatrix(ctx context.Context, req *calculatorpb.TariffCodeRequest) (*calculatorpb.ZoneMatrix, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tariff code is required")
+	}
+	rates, err := s.repo.GetMatrix(ctx, req.GetCode())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get zone matrix: %v", err)
+	}
+	out := &calculatorpb.ZoneMatrix{}
+	for _, rate := range rates {
+		out.Rates = append(out.Rates, zoneRateToProto(rate))
+	}
+	return out, nil
+}
+
+func (s *ZoneGRPCServer) SetZoneRate(ctx context.Context, req *calculatorpb.ZoneRate) (*calculatorpb.ZoneRate, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	rate := models.ZoneRate{
+		TariffCode:  req.GetTariffCode(),
+		FromZone:    req.GetFromZone(),
+		ToZone:      req.GetToZone(),
+		BaseRate:    req.GetBaseRate(),
+		PricePerKg:  req.GetPricePerKg(),
+		TransitDays: int(req.GetTransitDays()),
+	}
+	if err := rate.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid zone rate: %v", err)
+	}
+	if _, err := s.repo.SetRate(ctx, &rate); err != nil {
+		return nil, status.Errorf(codes.Internal, "set zone rate failed: %v", err)
+	}
+	return zoneRateToProto(rate), nil
+}
+
+func (s *ZoneGRPCServer) DeleteZoneRate(ctx context.Context, req *calculatorpb.ZoneRateKey) (*calculatorpb.Empty, error) {
+	if err := requireModerator(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteRate(ctx, req.GetTariffCode(), req.GetFromZone(), req.GetToZone()); err != nil {
+		if errors.Is(err, models.ErrZoneRateNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "delete zone rate failed: %v", err)
+	}
+	return &calculatorpb.Empty{}, nil
+}
+
+func zoneRateToProto(rate models.ZoneRate) *calculatorpb.ZoneRate {
+	return &calculatorpb.ZoneRate{
+		TariffCode:  rate.TariffCode,
+		FromZone:    rate.FromZone,
+		ToZone:      rate.ToZone,
+		BaseRate:    rate.BaseRate,
+		PricePerKg:  rate.PricePerKg,
+		TransitDays: int32(rate.TransitDays),
+	}
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// WorkingHours are the hours a hub, city, country or zone hands parcels
+// over, in its own time zone. Scope "*" applies wherever nothing more
+// specific is set.
+type WorkingHours struct {
+	Scope    string
+	Days     [7]bool // indexed by time.Weekday
+	Open     time.Duration
+	Close    time.Duration
+	Location *time.Location
+}
+
+// Holiday is a day off for a scope, or everywhere when the scope is "*".
+type Holiday struct {
+	Scope string
+	Date  time.Time
+	Name  string
+}
+
+// DeliveryWindow is the working-day slot a parcel is expected to be
+// delivered in.
+type DeliveryWindow struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
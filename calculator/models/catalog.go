@@ -0,0 +1,40 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidCatalog = errors.New("invalid catalog")
+
+const (
+	CatalogCreate = "create"
+	CatalogUpdate = "update"
+	CatalogDelete = "delete"
+)
+
+// Catalog is the reference data prices are worked out from: the tariffs in
+// force and the city directory. Tariff versions aren't part of it; applying
+// a catalog publishes new versions that take effect straight away. A nil
+// section isn't managed by the catalog; an empty one means "none".
+type Catalog struct {
+	Tariffs []Tariff             `yaml:"tariffs"`
+	Cities  []CountryCoordinates `yaml:"cities"`
+}
+
+// CatalogChange is one difference between the live catalog and a file.
+type CatalogChange struct {
+	Kind   string   // "tariff" or "city"
+	Key    string   // tariff code or city name
+	Action string   // CatalogCreate, CatalogUpdate or CatalogDelete
+	Fields []string // what an update changes
+}
+
+func (c CatalogChange) String() string {
+	sign := map[string]string{CatalogCreate: "+", CatalogUpdate: "~", CatalogDelete: "-"}[c.Action]
+	s := fmt.Sprintf("%s %s %s", sign, c.Kind, c.Key)
+	if len(c.Fields) > 0 {
+		s += fmt.Sprintf(" %v", c.Fields)
+	}
+	return s
+}
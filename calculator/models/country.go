@@ -1,18 +1,68 @@
 package models
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrCityNotFound = errors.New("city not found")
+
 type CountryCoordinates struct {
-	Name      string
-	Country   string
-	Latitude  float64
-	Longitude float64
-	Zone      string
+	Name      string   `yaml:"name"`
+	Code      string   `yaml:"code,omitempty"`
+	Country   string   `yaml:"-"`
+	Latitude  float64  `yaml:"latitude"`
+	Longitude float64  `yaml:"longitude"`
+	Zone      string   `yaml:"zone,omitempty"`
+	Aliases   []string `yaml:"aliases,omitempty"`
 }
 
 type CountryDoc struct {
 	Name     string `bson:"name"`
+	Code     string `bson:"code"`
 	Location struct {
 		Type        string    `bson:"type"`
 		Coordinates []float64 `bson:"coordinates"`
 	} `bson:"location"`
-	Zone string `bson:"zone"`
+	Zone    string   `bson:"zone"`
+	Aliases []string `bson:"aliases,omitempty"`
+	Search  []string `bson:"search,omitempty"`
+}
+
+func (d CountryDoc) Coordinates() *CountryCoordinates {
+	return &CountryCoordinates{
+		Name:      d.Name,
+		Code:      d.Code,
+		Latitude:  d.Location.Coordinates[1],
+		Longitude: d.Location.Coordinates[0],
+		Zone:      d.Zone,
+		Aliases:   d.Aliases,
+	}
+}
+
+// NearbyCity is a directory entry found around a point.
+type NearbyCity struct {
+	CountryCoordinates
+	DistanceKm float64
+}
+
+// UnknownCityError names the package field that points at a city missing
+// from the directory, along with the closest known names.
+type UnknownCityError struct {
+	Field       string
+	Name        string
+	Suggestions []string
+}
+
+func (e *UnknownCityError) Error() string {
+	msg := fmt.Sprintf("unknown city %q in %s", e.Name, e.Field)
+	if len(e.Suggestions) > 0 {
+		msg += ", did you mean " + strings.Join(e.Suggestions, ", ") + "?"
+	}
+	return msg
+}
+
+func (e *UnknownCityError) Unwrap() error {
+	return ErrCityNotFound
 }
@@ -0,0 +1,14 @@
+package models
+
+import "errors"
+
+// Distance providers a tariff can measure the lane with.
+const (
+	DistanceHaversine = "haversine"
+	DistanceRoad      = "road"
+)
+
+var (
+	ErrNoRoadRoute             = errors.New("no road route between cities")
+	ErrUnknownDistanceProvider = errors.New("unknown distance provider")
+)
@@ -0,0 +1,105 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrNotEligible = errors.New("parcel is not eligible for the tariff")
+
+// TariffLimits restricts which parcels a tariff accepts. Zero values and
+// empty lists mean no limit. Dimensions are in centimetres, weights in kg.
+// Origins and Destinations hold city names, codes or zones.
+type TariffLimits struct {
+	MaxWeight            float64  `bson:"max_weight,omitempty" json:"max_weight,omitempty" yaml:"max_weight,omitempty"`
+	MaxVolumetricWeight  float64  `bson:"max_volumetric_weight,omitempty" json:"max_volumetric_weight,omitempty" yaml:"max_volumetric_weight,omitempty"`
+	MaxDimension         int      `bson:"max_dimension,omitempty" json:"max_dimension,omitempty" yaml:"max_dimension,omitempty"`
+	MaxGirth             int      `bson:"max_girth,omitempty" json:"max_girth,omitempty" yaml:"max_girth,omitempty"`
+	Origins              []string `bson:"origins,omitempty" json:"origins,omitempty" yaml:"origins,omitempty"`
+	Destinations         []string `bson:"destinations,omitempty" json:"destinations,omitempty" yaml:"destinations,omitempty"`
+	ProhibitedCategories []string `bson:"prohibited_categories,omitempty" json:"prohibited_categories,omitempty" yaml:"prohibited_categories,omitempty"`
+}
+
+func (l TariffLimits) Validate() error {
+	if l.MaxWeight < 0 || l.MaxVolumetricWeight < 0 || l.MaxDimension < 0 || l.MaxGirth < 0 {
+		return fmt.Errorf("limits must not be negative")
+	}
+	return nil
+}
+
+// IneligibleError lists every limit of the tariff the parcel breaks.
+type IneligibleError struct {
+	Tariff  string
+	Reasons []string
+}
+
+func (e *IneligibleError) Error() string {
+	return fmt.Sprintf("tariff %s does not accept the parcel: %s", e.Tariff, strings.Join(e.Reasons, "; "))
+}
+
+func (e *IneligibleError) Unwrap() error {
+	return ErrNotEligible
+}
+
+// Girth is the longest side plus twice the sum of the other two, the
+// measure carriers cap alongside the longest side itself.
+func Girth(pkg Package) (longest, girth int) {
+	sides := []int{pkg.Length, pkg.Width, pkg.Height}
+	sort.Sort(sort.Reverse(sort.IntSlice(sides)))
+	return sides[0], sides[0] + 2*(sides[1]+sides[2])
+}
+
+// Check returns why the parcel can't go with the tariff, nil when it can.
+// Route limits are skipped for an unresolved end so a tariff list can be
+// narrowed by size alone.
+func (l TariffLimits) Check(pkg Package, volumetric float64, from, to *CountryCoordinates) []string {
+	var reasons []string
+	if l.MaxWeight > 0 && pkg.Weight > l.MaxWeight {
+		reasons = append(reasons, fmt.Sprintf("weight %.2f kg exceeds %.2f kg", pkg.Weight, l.MaxWeight))
+	}
+	if l.MaxVolumetricWeight > 0 && volumetric > l.MaxVolumetricWeight {
+		reasons = append(reasons, fmt.Sprintf("volumetric weight %.2f kg exceeds %.2f kg", volumetric, l.MaxVolumetricWeight))
+	}
+	longest, girth := Girth(pkg)
+	if l.MaxDimension > 0 && longest > l.MaxDimension {
+		reasons = append(reasons, fmt.Sprintf("longest side %d cm exceeds %d cm", longest, l.MaxDimension))
+	}
+	if l.MaxGirth > 0 && girth > l.MaxGirth {
+		reasons = append(reasons, fmt.Sprintf("girth %d cm exceeds %d cm", girth, l.MaxGirth))
+	}
+	if from != nil && len(l.Origins) > 0 && !servesCity(l.Origins, from) {
+		reasons = append(reasons, fmt.Sprintf("origin %s is not served", from.Name))
+	}
+	if to != nil && len(l.Destinations) > 0 && !servesCity(l.Destinations, to) {
+		reasons = append(reasons, fmt.Sprintf("destination %s is not served", to.Name))
+	}
+	if pkg.Category != "" {
+		for _, category := range l.ProhibitedCategories {
+			if strings.EqualFold(strings.TrimSpace(category), strings.TrimSpace(pkg.Category)) {
+				reasons = append(reasons, fmt.Sprintf("category %q is prohibited", pkg.Category))
+				break
+			}
+		}
+	}
+	return reasons
+}
+
+// servesCity matches the city by any of its names, its code or its zone.
+func servesCity(allowed []string, city *CountryCoordinates) bool {
+	keys := SearchKeys(city.Name, city.Aliases)
+	for _, a := range allowed {
+		if (city.Code != "" && strings.EqualFold(a, city.Code)) ||
+			(city.Zone != "" && strings.EqualFold(a, city.Zone)) {
+			return true
+		}
+		key := SearchKey(a)
+		for _, k := range keys {
+			if k == key {
+				return true
+			}
+		}
+	}
+	return false
+}
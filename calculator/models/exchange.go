@@ -0,0 +1,60 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+var ErrExchangeRateNotFound = errors.New("exchange rate not found")
+
+// ExchangeRate is the price of one unit of Base in Quote, in force from Date
+// until a later rate for the same pair replaces it.
+type ExchangeRate struct {
+	Base  string    `bson:"base" json:"base"`
+	Quote string    `bson:"quote" json:"quote"`
+	Rate  float64   `bson:"rate" json:"rate"`
+	Date  time.Time `bson:"date" json:"date"`
+}
+
+func (r *ExchangeRate) Normalize() {
+	r.Base = strings.ToUpper(strings.TrimSpace(r.Base))
+	r.Quote = strings.ToUpper(strings.TrimSpace(r.Quote))
+	r.Date = r.Date.UTC().Truncate(24 * time.Hour)
+}
+
+func (r *ExchangeRate) Validate() error {
+	if len(r.Base) != 3 || len(r.Quote) != 3 {
+		return fmt.Errorf("currencies must be three-letter codes")
+	}
+	if r.Base == r.Quote {
+		return fmt.Errorf("base and quote currencies must differ")
+	}
+	if r.Rate <= 0 {
+		return fmt.Errorf("rate must be positive")
+	}
+	if r.Date.IsZero() {
+		return fmt.Errorf("date is required")
+	}
+	return nil
+}
+
+// zeroDecimalCurrencies have no minor unit in ISO 4217.
+var zeroDecimalCurrencies = map[string]bool{
+	"JPY": true, "KRW": true, "VND": true, "CLP": true, "ISK": true, "UGX": true,
+}
+
+// RoundAmount rounds half away from zero to the currency's minor unit, so
+// the same amount always converts to the same price. The amount is first
+// snapped to 1e-6 of a minor unit so that 1.005 rounds up like it reads
+// instead of down like its binary form.
+func RoundAmount(amount float64, currency string) float64 {
+	scale := 100.0
+	if zeroDecimalCurrencies[strings.ToUpper(currency)] {
+		scale = 1
+	}
+	minor := math.Round(amount*scale*1e6) / 1e6
+	return math.Round(minor) / scale
+}
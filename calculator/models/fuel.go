@@ -0,0 +1,33 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrFuelIndexNotFound = errors.New("fuel surcharge index not found")
+
+// FuelIndex is the fuel surcharge, a percentage of the freight charge, in
+// force from EffectiveFrom until a later entry replaces it. Tariffs opt in
+// with Tariff.FuelSurcharge.
+type FuelIndex struct {
+	Percent       float64   `bson:"percent" json:"percent"`
+	EffectiveFrom time.Time `bson:"effective_from" json:"effective_from"`
+}
+
+func (f *FuelIndex) Normalize() {
+	f.EffectiveFrom = f.EffectiveFrom.UTC().Truncate(time.Millisecond)
+}
+
+// Validate allows a zero percent, which suspends the surcharge from that
+// date on.
+func (f *FuelIndex) Validate() error {
+	if f.Percent < 0 || f.Percent > 100 {
+		return fmt.Errorf("percent must be within 0-100")
+	}
+	if f.EffectiveFrom.IsZero() {
+		return fmt.Errorf("effective_from is required")
+	}
+	return nil
+}
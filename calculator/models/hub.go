@@ -0,0 +1,73 @@
+package models
+
+import "fmt"
+
+type Hub struct {
+	Code      string  `bson:"code" json:"code"`
+	Name      string  `bson:"name" json:"name"`
+	Country   string  `bson:"country" json:"country"`
+	Latitude  float64 `bson:"latitude" json:"latitude"`
+	Longitude float64 `bson:"longitude" json:"longitude"`
+}
+
+func (h *Hub) Validate() error {
+	if h.Code == "" {
+		return fmt.Errorf("code is required")
+	}
+	if h.Country == "" {
+		return fmt.Errorf("country is required")
+	}
+	if h.Latitude < -90 || h.Latitude > 90 || h.Longitude < -180 || h.Longitude > 180 {
+		return fmt.Errorf("coordinates are out of range")
+	}
+	return nil
+}
+
+type HubLink struct {
+	From         string  `bson:"from" json:"from"`
+	To           string  `bson:"to" json:"to"`
+	TransitHours float64 `bson:"transit_hours" json:"transit_hours"`
+	Cost         float64 `bson:"cost" json:"cost"`
+}
+
+func (l *HubLink) Validate() error {
+	if l.From == "" || l.To == "" {
+		return fmt.Errorf("from and to hubs are required")
+	}
+	if l.From == l.To {
+		return fmt.Errorf("link must connect two different hubs")
+	}
+	if l.TransitHours <= 0 {
+		return fmt.Errorf("transit_hours must be positive")
+	}
+	if l.Cost < 0 {
+		return fmt.Errorf("cost must not be negative")
+	}
+	return nil
+}
+
+type Leg struct {
+	FromHub      string  `json:"from_hub"`
+	ToHub        string  `json:"to_hub"`
+	DistanceKm   float64 `json:"distance_km"`
+	TransitHours float64 `json:"transit_hours"`
+	Cost         float64 `json:"cost"`
+}
+
+type HubRoute struct {
+	Legs          []Leg   `json:"legs"`
+	TotalDistance float64 `json:"total_distance"`
+	TotalHours    float64 `json:"total_hours"`
+	TotalCost     float64 `json:"total_cost"`
+}
+
+func (r *HubRoute) Hubs() []string {
+	if len(r.Legs) == 0 {
+		return nil
+	}
+	hubs := []string{r.Legs[0].FromHub}
+	for _, l := range r.Legs {
+		hubs = append(hubs, l.ToHub)
+	}
+	return hubs
+}
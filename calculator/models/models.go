@@ -1,17 +1,70 @@
 package models
 
+import (
+	"math"
+	"time"
+)
+
 type Package struct {
-	Weight  float64 `json:"weight"`
-	From    string  `json:"from"`
-	To      string  `json:"to"`
-	Address string  `json:"address"`
-	Length  int     `json:"length"`
-	Width   int     `json:"width"`
-	Height  int     `json:"height"`
+	Weight         float64 `json:"weight"`
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	Address        string  `json:"address"`
+	Length         int     `json:"length"`
+	Width          int     `json:"width"`
+	Height         int     `json:"height"`
+	Pickup         bool    `json:"pickup"`
+	TargetCurrency string  `json:"target_currency,omitempty"`
+	PromoCode      string  `json:"promo_code,omitempty"`
+	Category       string  `json:"category,omitempty"`
 }
 
 type CalculationResult struct {
-	Cost           float64 `json:"cost"`
-	EstimatedHours int     `json:"estimated_hours"`
-	Currency       string  `json:"currency"`
+	Cost              float64         `json:"cost"`
+	EstimatedHours    int             `json:"estimated_hours"`
+	Currency          string          `json:"currency"`
+	Legs              []Leg           `json:"legs,omitempty"`
+	TariffVersion     int             `json:"tariff_version"`
+	LineItems         []LineItem      `json:"line_items"`
+	DistanceKm        float64         `json:"distance_km"`
+	ChargeableWeight  float64         `json:"chargeable_weight"`
+	VolumetricWeight  float64         `json:"volumetric_weight"`
+	PricedAt          time.Time       `json:"priced_at"`
+	OriginalCost      float64         `json:"original_cost,omitempty"`
+	OriginalCurrency  string          `json:"original_currency,omitempty"`
+	ExchangeRate      float64         `json:"exchange_rate,omitempty"`
+	PromoCode         string          `json:"promo_code,omitempty"`
+	PromoRejected     string          `json:"promo_rejected,omitempty"`
+	DeliveryWindow    *DeliveryWindow `json:"delivery_window,omitempty"`
+	FuelSurchargeRate float64         `json:"fuel_surcharge_rate,omitempty"`
+}
+
+// LineItem is one component of the price; the items of a result add up to its cost.
+type LineItem struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description"`
+	Amount      float64 `json:"amount"`
+}
+
+type TariffQuote struct {
+	TariffCode string `json:"tariff_code"`
+	TariffName string `json:"tariff_name"`
+	CalculationResult
+	Eligible bool   `json:"eligible"`
+	Reason   string `json:"reason,omitempty"`
+	Cheapest bool   `json:"cheapest"`
+	Fastest  bool   `json:"fastest"`
+}
+
+// AddLineItems appends the items rounded to cents and keeps Cost equal to their sum.
+func (r *CalculationResult) AddLineItems(items ...LineItem) {
+	for _, item := range items {
+		item.Amount = math.Round(item.Amount*100) / 100
+		r.LineItems = append(r.LineItems, item)
+	}
+	var total float64
+	for _, item := range r.LineItems {
+		total += item.Amount
+	}
+	r.Cost = math.Round(total*100) / 100
 }
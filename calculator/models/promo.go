@@ -0,0 +1,133 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+const (
+	PromoPercent = "percent"
+	PromoFixed   = "fixed"
+)
+
+var (
+	ErrPromoNotFound      = errors.New("promo code not found")
+	ErrPromoNotApplicable = errors.New("promo code is not applicable")
+	ErrPromoExhausted     = errors.New("promo code has been fully redeemed")
+	ErrPromoUserLimit     = errors.New("promo code usage limit reached for this user")
+)
+
+// PromoRoute restricts a promotion to a lane; an empty side matches any city.
+type PromoRoute struct {
+	From string `bson:"from,omitempty" json:"from,omitempty"`
+	To   string `bson:"to,omitempty" json:"to,omitempty"`
+}
+
+func (r PromoRoute) Matches(from, to string) bool {
+	return (r.From == "" || strings.EqualFold(r.From, from)) &&
+		(r.To == "" || strings.EqualFold(r.To, to))
+}
+
+type Promotion struct {
+	Code           string       `bson:"code" json:"code"`
+	Description    string       `bson:"description" json:"description"`
+	Type           string       `bson:"type" json:"type"`
+	Value          float64      `bson:"value" json:"value"`
+	Currency       string       `bson:"currency,omitempty" json:"currency,omitempty"`
+	Tariffs        []string     `bson:"tariffs,omitempty" json:"tariffs,omitempty"`
+	Routes         []PromoRoute `bson:"routes,omitempty" json:"routes,omitempty"`
+	ValidFrom      time.Time    `bson:"valid_from" json:"valid_from"`
+	ValidTo        *time.Time   `bson:"valid_to,omitempty" json:"valid_to,omitempty"`
+	MaxUses        int          `bson:"max_uses" json:"max_uses"`
+	MaxUsesPerUser int          `bson:"max_uses_per_user" json:"max_uses_per_user"`
+	FirstOrderOnly bool         `bson:"first_order_only" json:"first_order_only"`
+	Used           int          `bson:"used" json:"used"`
+	Active         bool         `bson:"active" json:"active"`
+}
+
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p *Promotion) Validate() error {
+	if p.Code == "" {
+		return fmt.Errorf("code is required")
+	}
+	switch p.Type {
+	case PromoPercent:
+		if p.Value <= 0 || p.Value > 100 {
+			return fmt.Errorf("percent value must be in (0, 100]")
+		}
+	case PromoFixed:
+		if p.Value <= 0 {
+			return fmt.Errorf("fixed value must be positive")
+		}
+		if p.Currency == "" {
+			return fmt.Errorf("currency is required for a fixed discount")
+		}
+	default:
+		return fmt.Errorf("type must be %q or %q", PromoPercent, PromoFixed)
+	}
+	if p.ValidTo != nil && !p.ValidTo.After(p.ValidFrom) {
+		return fmt.Errorf("valid_to must be after valid_from")
+	}
+	if p.MaxUses < 0 || p.MaxUsesPerUser < 0 {
+		return fmt.Errorf("usage limits must not be negative")
+	}
+	return nil
+}
+
+// UserLimit is how many times one user may redeem the code; zero means no
+// limit. A first-order code can only ever be used once per user.
+func (p *Promotion) UserLimit() int {
+	if p.FirstOrderOnly {
+		return 1
+	}
+	return p.MaxUsesPerUser
+}
+
+// Applies checks everything that can be known before the order is placed.
+// Per-user limits and the first-order rule are enforced on redemption.
+func (p *Promotion) Applies(at time.Time, tariffCode, from, to string) error {
+	if !p.Active {
+		return fmt.Errorf("%w: promotion is disabled", ErrPromoNotApplicable)
+	}
+	if at.Before(p.ValidFrom) {
+		return fmt.Errorf("%w: promotion starts %s", ErrPromoNotApplicable, p.ValidFrom.Format(time.RFC3339))
+	}
+	if p.ValidTo != nil && !at.Before(*p.ValidTo) {
+		return fmt.Errorf("%w: promotion has ended", ErrPromoNotApplicable)
+	}
+	if p.MaxUses > 0 && p.Used >= p.MaxUses {
+		return ErrPromoExhausted
+	}
+	if len(p.Tariffs) > 0 && !containsFold(p.Tariffs, tariffCode) {
+		return fmt.Errorf("%w: not valid for tariff %s", ErrPromoNotApplicable, tariffCode)
+	}
+	if len(p.Routes) > 0 {
+		matched := false
+		for _, route := range p.Routes {
+			if route.Matches(from, to) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%w: not valid for %s -> %s", ErrPromoNotApplicable, from, to)
+		}
+	}
+	return nil
+}
+
+// Discount is the amount taken off subtotal, never more than the subtotal.
+// A fixed discount must already be in the subtotal's currency.
+func (p *Promotion) Discount(subtotal, fixed float64) float64 {
+	discount := fixed
+	if p.Type == PromoPercent {
+		discount = subtotal * p.Value / 100
+	}
+	return math.Min(discount, subtotal)
+}
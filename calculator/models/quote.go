@@ -0,0 +1,79 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrQuoteInvalid  = errors.New("quote is invalid")
+	ErrQuoteExpired  = errors.New("quote has expired")
+	ErrQuoteMismatch = errors.New("quote does not match the parcel")
+)
+
+// Quote is a price locked in for a specific parcel until ExpiresAt.
+type Quote struct {
+	ID                string          `json:"id"`
+	TariffCode        string          `json:"tariff_code"`
+	TariffVersion     int             `json:"tariff_version"`
+	Cost              float64         `json:"cost"`
+	Currency          string          `json:"currency"`
+	EstimatedHours    int             `json:"estimated_hours"`
+	From              string          `json:"from"`
+	To                string          `json:"to"`
+	Weight            float64         `json:"weight"`
+	Length            int             `json:"length"`
+	Width             int             `json:"width"`
+	Height            int             `json:"height"`
+	Pickup            bool            `json:"pickup"`
+	Category          string          `json:"category,omitempty"`
+	Legs              []Leg           `json:"legs,omitempty"`
+	LineItems         []LineItem      `json:"line_items,omitempty"`
+	DistanceKm        float64         `json:"distance_km"`
+	ChargeableWeight  float64         `json:"chargeable_weight"`
+	VolumetricWeight  float64         `json:"volumetric_weight"`
+	PricedAt          time.Time       `json:"priced_at"`
+	OriginalCost      float64         `json:"original_cost,omitempty"`
+	OriginalCurrency  string          `json:"original_currency,omitempty"`
+	ExchangeRate      float64         `json:"exchange_rate,omitempty"`
+	PromoCode         string          `json:"promo_code,omitempty"`
+	DeliveryWindow    *DeliveryWindow `json:"delivery_window,omitempty"`
+	FuelSurchargeRate float64         `json:"fuel_surcharge_rate,omitempty"`
+	ExpiresAt         time.Time       `json:"expires_at"`
+}
+
+// Result is the calculation the quote was issued for.
+func (q *Quote) Result() CalculationResult {
+	return CalculationResult{
+		Cost:              q.Cost,
+		EstimatedHours:    q.EstimatedHours,
+		Currency:          q.Currency,
+		Legs:              q.Legs,
+		TariffVersion:     q.TariffVersion,
+		LineItems:         q.LineItems,
+		DistanceKm:        q.DistanceKm,
+		ChargeableWeight:  q.ChargeableWeight,
+		VolumetricWeight:  q.VolumetricWeight,
+		PricedAt:          q.PricedAt,
+		OriginalCost:      q.OriginalCost,
+		OriginalCurrency:  q.OriginalCurrency,
+		ExchangeRate:      q.ExchangeRate,
+		PromoCode:         q.PromoCode,
+		DeliveryWindow:    q.DeliveryWindow,
+		FuelSurchargeRate: q.FuelSurchargeRate,
+	}
+}
+
+func (q *Quote) Matches(pkg Package, tariffCode string) bool {
+	return q.TariffCode == tariffCode &&
+		q.From == pkg.From &&
+		q.To == pkg.To &&
+		q.Weight == pkg.Weight &&
+		q.Length == pkg.Length &&
+		q.Width == pkg.Width &&
+		q.Height == pkg.Height &&
+		q.Pickup == pkg.Pickup &&
+		strings.EqualFold(strings.TrimSpace(q.Category), strings.TrimSpace(pkg.Category)) &&
+		(pkg.TargetCurrency == "" || strings.EqualFold(pkg.TargetCurrency, q.Currency))
+}
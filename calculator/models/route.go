@@ -0,0 +1,47 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrStopNotLocated = errors.New("stop has neither coordinates nor location")
+
+type Stop struct {
+	ID             string    `json:"id"`
+	Location       string    `json:"location"`
+	Latitude       float64   `json:"latitude"`
+	Longitude      float64   `json:"longitude"`
+	Weight         float64   `json:"weight"`
+	ServiceMinutes int       `json:"service_minutes"`
+	WindowStart    time.Time `json:"window_start"`
+	WindowEnd      time.Time `json:"window_end"`
+}
+
+func (s Stop) HasCoordinates() bool {
+	return s.Latitude != 0 || s.Longitude != 0
+}
+
+type RouteRequest struct {
+	Depot         Stop      `json:"depot"`
+	Stops         []Stop    `json:"stops"`
+	StartTime     time.Time `json:"start_time"`
+	SpeedKmph     float64   `json:"speed_kmph"`
+	Capacity      float64   `json:"capacity"`
+	ReturnToDepot bool      `json:"return_to_depot"`
+}
+
+type PlannedStop struct {
+	Stop             Stop      `json:"stop"`
+	Sequence         int       `json:"sequence"`
+	DistanceFromPrev float64   `json:"distance_from_prev"`
+	ETA              time.Time `json:"eta"`
+	Departure        time.Time `json:"departure"`
+}
+
+type RoutePlan struct {
+	Stops         []PlannedStop `json:"stops"`
+	TotalDistance float64       `json:"total_distance"`
+	FinishTime    time.Time     `json:"finish_time"`
+	Unassigned    []Stop        `json:"unassigned"`
+}
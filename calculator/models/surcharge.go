@@ -0,0 +1,161 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	SurchargeMultiplier = "multiplier"
+	SurchargeFixed      = "fixed"
+
+	SurchargeTargetPrice = "price"
+	SurchargeTargetETA   = "eta"
+)
+
+var ErrSurchargeRuleNotFound = errors.New("surcharge rule not found")
+
+// HourRange is [From, To) in hours of the day; From > To wraps past midnight.
+type HourRange struct {
+	From int `bson:"from" json:"from"`
+	To   int `bson:"to" json:"to"`
+}
+
+func (r HourRange) Contains(hour int) bool {
+	if r.From <= r.To {
+		return hour >= r.From && hour < r.To
+	}
+	return hour >= r.From || hour < r.To
+}
+
+// Band is [Min, Max); a zero Max leaves the band open.
+type Band struct {
+	Min float64 `bson:"min" json:"min"`
+	Max float64 `bson:"max" json:"max"`
+}
+
+func (b Band) Contains(v float64) bool {
+	return v >= b.Min && (b.Max == 0 || v < b.Max)
+}
+
+// SurchargeCondition matches when every condition that is set holds.
+type SurchargeCondition struct {
+	Hours     *HourRange `bson:"hours,omitempty" json:"hours,omitempty"`
+	Weekdays  []int      `bson:"weekdays,omitempty" json:"weekdays,omitempty"`
+	Distance  *Band      `bson:"distance,omitempty" json:"distance,omitempty"`
+	Weight    *Band      `bson:"weight,omitempty" json:"weight,omitempty"`
+	FromZones []string   `bson:"from_zones,omitempty" json:"from_zones,omitempty"`
+	ToZones   []string   `bson:"to_zones,omitempty" json:"to_zones,omitempty"`
+	From      string     `bson:"from,omitempty" json:"from,omitempty"`
+	To        string     `bson:"to,omitempty" json:"to,omitempty"`
+	Tariffs   []string   `bson:"tariffs,omitempty" json:"tariffs,omitempty"`
+}
+
+type SurchargeAction struct {
+	Type   string  `bson:"type" json:"type"`
+	Target string  `bson:"target" json:"target"`
+	Value  float64 `bson:"value" json:"value"`
+}
+
+type SurchargeRule struct {
+	ID        string             `bson:"rule_id" json:"id"`
+	Name      string             `bson:"name" json:"name"`
+	Priority  int                `bson:"priority" json:"priority"`
+	Enabled   bool               `bson:"enabled" json:"enabled"`
+	Condition SurchargeCondition `bson:"condition" json:"condition"`
+	Action    SurchargeAction    `bson:"action" json:"action"`
+}
+
+// SurchargeInput is what a rule condition is evaluated against.
+type SurchargeInput struct {
+	At         time.Time
+	DistanceKm float64
+	WeightKg   float64
+	From       string
+	To         string
+	FromZone   string
+	ToZone     string
+	Tariff     string
+}
+
+func (r *SurchargeRule) Validate() error {
+	if r.ID == "" {
+		return fmt.Errorf("id is required")
+	}
+	if r.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch r.Action.Type {
+	case SurchargeMultiplier:
+		if r.Action.Value <= 0 {
+			return fmt.Errorf("multiplier must be positive")
+		}
+	case SurchargeFixed:
+	default:
+		return fmt.Errorf("action type must be %q or %q", SurchargeMultiplier, SurchargeFixed)
+	}
+	if r.Action.Target != SurchargeTargetPrice && r.Action.Target != SurchargeTargetETA {
+		return fmt.Errorf("action target must be %q or %q", SurchargeTargetPrice, SurchargeTargetETA)
+	}
+	if h := r.Condition.Hours; h != nil && (h.From < 0 || h.From > 23 || h.To < 0 || h.To > 24) {
+		return fmt.Errorf("hours must be within 0-24")
+	}
+	for _, d := range r.Condition.Weekdays {
+		if d < 0 || d > 6 {
+			return fmt.Errorf("weekdays must be within 0 (Sunday) - 6 (Saturday)")
+		}
+	}
+	return nil
+}
+
+func (r *SurchargeRule) Matches(in SurchargeInput) bool {
+	c := r.Condition
+	if c.Hours != nil && !c.Hours.Contains(in.At.Hour()) {
+		return false
+	}
+	if len(c.Weekdays) > 0 && !containsInt(c.Weekdays, int(in.At.Weekday())) {
+		return false
+	}
+	if c.Distance != nil && !c.Distance.Contains(in.DistanceKm) {
+		return false
+	}
+	if c.Weight != nil && !c.Weight.Contains(in.WeightKg) {
+		return false
+	}
+	if len(c.FromZones) > 0 && !containsFold(c.FromZones, in.FromZone) {
+		return false
+	}
+	if len(c.ToZones) > 0 && !containsFold(c.ToZones, in.ToZone) {
+		return false
+	}
+	if c.From != "" && !strings.EqualFold(c.From, in.From) {
+		return false
+	}
+	if c.To != "" && !strings.EqualFold(c.To, in.To) {
+		return false
+	}
+	if len(c.Tariffs) > 0 && !containsFold(c.Tariffs, in.Tariff) {
+		return false
+	}
+	return true
+}
+
+func containsInt(list []int, v int) bool {
+	for _, item := range list {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, v string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, v) {
+			return true
+		}
+	}
+	return false
+}
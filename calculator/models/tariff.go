@@ -1,16 +1,34 @@
 package models
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrTariffNotFound        = errors.New("tariff not found")
+	ErrTariffVersionConflict = errors.New("tariff version conflict")
+	ErrInvalidTariff         = errors.New("invalid tariff")
+)
 
 type Tariff struct {
-	Code              string  `bson:"code" json:"code"`
-	Name              string  `bson:"name" json:"name"`
-	BaseRate          float64 `bson:"base_rate" json:"base_rate"`
-	PricePerKm        float64 `bson:"price_per_km" json:"price_per_km"`
-	PricePerKg        float64 `bson:"price_per_kg" json:"price_per_kg"`
-	Currency          string  `bson:"currency" json:"currency"`
-	VolumetricDivider float64 `bson:"volumetric_divider" json:"volumetric_divider"`
-	SpeedKmph         float64 `bson:"speed_kmph" json:"speed_kmph"`
+	Code              string       `bson:"code" json:"code" yaml:"code"`
+	Name              string       `bson:"name" json:"name" yaml:"name"`
+	BaseRate          float64      `bson:"base_rate" json:"base_rate" yaml:"base_rate"`
+	PricePerKm        float64      `bson:"price_per_km" json:"price_per_km" yaml:"price_per_km"`
+	PricePerKg        float64      `bson:"price_per_kg" json:"price_per_kg" yaml:"price_per_kg"`
+	Currency          string       `bson:"currency" json:"currency" yaml:"currency"`
+	VolumetricDivider float64      `bson:"volumetric_divider" json:"volumetric_divider" yaml:"volumetric_divider"`
+	SpeedKmph         float64      `bson:"speed_kmph" json:"speed_kmph" yaml:"speed_kmph"`
+	PickupSurcharge   float64      `bson:"pickup_surcharge" json:"pickup_surcharge" yaml:"pickup_surcharge"`
+	PricingMode       string       `bson:"pricing_mode,omitempty" json:"pricing_mode,omitempty" yaml:"pricing_mode,omitempty"`
+	DistanceProvider  string       `bson:"distance_provider,omitempty" json:"distance_provider,omitempty" yaml:"distance_provider,omitempty"`
+	FuelSurcharge     bool         `bson:"fuel_surcharge,omitempty" json:"fuel_surcharge,omitempty" yaml:"fuel_surcharge,omitempty"`
+	Limits            TariffLimits `bson:"limits" json:"limits" yaml:"limits,omitempty"`
+	Version           int          `bson:"version" json:"version" yaml:"-"`
+	ValidFrom         time.Time    `bson:"valid_from" json:"valid_from" yaml:"-"`
+	ValidTo           *time.Time   `bson:"valid_to,omitempty" json:"valid_to,omitempty" yaml:"-"`
 }
 
 func (t *Tariff) Validate() error {
@@ -20,14 +38,26 @@ func (t *Tariff) Validate() error {
 	if t.Name == "" {
 		return fmt.Errorf("name is required")
 	}
-	if t.BaseRate <= 0 {
-		return fmt.Errorf("base_rate must be positive")
-	}
-	if t.PricePerKm <= 0 {
-		return fmt.Errorf("price_per_km must be positive")
+	switch t.PricingMode {
+	case "", PricingDistance:
+		if t.BaseRate <= 0 {
+			return fmt.Errorf("base_rate must be positive")
+		}
+		if t.PricePerKm <= 0 {
+			return fmt.Errorf("price_per_km must be positive")
+		}
+		if t.PricePerKg <= 0 {
+			return fmt.Errorf("price_per_kg must be positive")
+		}
+	case PricingZone:
+		// Rates come from the zone matrix.
+	default:
+		return fmt.Errorf("unknown pricing_mode %q", t.PricingMode)
 	}
-	if t.PricePerKg <= 0 {
-		return fmt.Errorf("price_per_kg must be positive")
+	switch t.DistanceProvider {
+	case "", DistanceHaversine, DistanceRoad:
+	default:
+		return fmt.Errorf("unknown distance_provider %q", t.DistanceProvider)
 	}
 	if t.Currency == "" {
 		return fmt.Errorf("currency is required")
@@ -38,5 +68,20 @@ func (t *Tariff) Validate() error {
 	if t.SpeedKmph <= 0 {
 		return fmt.Errorf("speed_kmph must be positive")
 	}
-	return nil
+	if t.PickupSurcharge < 0 {
+		return fmt.Errorf("pickup_surcharge must not be negative")
+	}
+	return t.Limits.Validate()
+}
+
+// ZonePriced reports whether the tariff takes its rates from the zone matrix
+// instead of the distance.
+func (t *Tariff) ZonePriced() bool {
+	return t.PricingMode == PricingZone
+}
+
+// HubRouted reports whether the tariff travels the hub network; a tariff that
+// names a distance provider is priced by that provider instead.
+func (t *Tariff) HubRouted() bool {
+	return t.DistanceProvider == ""
 }
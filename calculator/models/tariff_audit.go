@@ -0,0 +1,88 @@
+package models
+
+import "time"
+
+const (
+	TariffCreated = "create"
+	TariffUpdated = "update"
+	TariffDeleted = "delete"
+)
+
+// TariffAudit records one change to a tariff: who made it, when, and the
+// tariff version before and after. Before is empty for a new tariff and
+// After is empty once it is deleted.
+type TariffAudit struct {
+	TariffCode string    `bson:"tariff_code" json:"tariff_code"`
+	Action     string    `bson:"action" json:"action"`
+	Actor      string    `bson:"actor" json:"actor"`
+	At         time.Time `bson:"at" json:"at"`
+	Before     *Tariff   `bson:"before,omitempty" json:"before,omitempty"`
+	After      *Tariff   `bson:"after,omitempty" json:"after,omitempty"`
+}
+
+// TariffPatch lists the fields an update changes; nil fields keep the value
+// of the version being updated.
+type TariffPatch struct {
+	Name              *string
+	BaseRate          *float64
+	PricePerKm        *float64
+	PricePerKg        *float64
+	Currency          *string
+	VolumetricDivider *float64
+	SpeedKmph         *float64
+	PickupSurcharge   *float64
+	PricingMode       *string
+	DistanceProvider  *string
+	FuelSurcharge     *bool
+	Limits            *TariffLimits // replaces the whole set
+	ValidFrom         *time.Time
+}
+
+// Apply returns the next version of the tariff with the patch applied. It
+// takes effect at the patch's ValidFrom, immediately when unset.
+func (p TariffPatch) Apply(t Tariff) Tariff {
+	next := t
+	next.Version = 0
+	next.ValidFrom = time.Time{}
+	next.ValidTo = nil
+	setString(&next.Name, p.Name)
+	setFloat(&next.BaseRate, p.BaseRate)
+	setFloat(&next.PricePerKm, p.PricePerKm)
+	setFloat(&next.PricePerKg, p.PricePerKg)
+	setString(&next.Currency, p.Currency)
+	setFloat(&next.VolumetricDivider, p.VolumetricDivider)
+	setFloat(&next.SpeedKmph, p.SpeedKmph)
+	setFloat(&next.PickupSurcharge, p.PickupSurcharge)
+	setString(&next.PricingMode, p.PricingMode)
+	setString(&next.DistanceProvider, p.DistanceProvider)
+	if p.FuelSurcharge != nil {
+		next.FuelSurcharge = *p.FuelSurcharge
+	}
+	if p.Limits != nil {
+		next.Limits = *p.Limits
+	}
+	if p.ValidFrom != nil {
+		next.ValidFrom = *p.ValidFrom
+	}
+	return next
+}
+
+// Empty reports whether the patch leaves every tariff field as it is.
+func (p TariffPatch) Empty() bool {
+	return p.Name == nil && p.BaseRate == nil && p.PricePerKm == nil && p.PricePerKg == nil &&
+		p.Currency == nil && p.VolumetricDivider == nil && p.SpeedKmph == nil &&
+		p.PickupSurcharge == nil && p.PricingMode == nil && p.DistanceProvider == nil &&
+		p.FuelSurcharge == nil && p.Limits == nil
+}
+
+func setString(dst *string, v *string) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+func setFloat(dst *float64, v *float64) {
+	if v != nil {
+		*dst = *v
+	}
+}
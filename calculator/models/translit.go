@@ -0,0 +1,57 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+)
+
+var cyrillicToLatin = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "yi", 'є': "ye", 'ґ': "g", 'ў': "u",
+}
+
+// SearchKey folds a city name into the form lookups are matched on: lower
+// case, Cyrillic transliterated to Latin, and hyphens, dots and repeated
+// spaces collapsed to a single space. "Санкт-Петербург" and "sankt peterburg"
+// share a key.
+func SearchKey(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(strings.TrimSpace(name)) {
+		if latin, ok := cyrillicToLatin[r]; ok {
+			b.WriteString(latin)
+			space = false
+			continue
+		}
+		if unicode.IsSpace(r) || r == '-' || r == '.' || r == '_' {
+			if !space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		b.WriteRune(r)
+		space = false
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// SearchKeys returns the distinct keys a city is found by: its name and
+// every alias.
+func SearchKeys(name string, aliases []string) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, n := range append([]string{name}, aliases...) {
+		key := SearchKey(n)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,45 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	PricingDistance = "distance"
+	PricingZone     = "zone"
+)
+
+var ErrZoneRateNotFound = errors.New("zone rate not found")
+
+// ZoneRate is one cell of a tariff's origin zone x destination zone matrix.
+type ZoneRate struct {
+	TariffCode  string  `bson:"tariff_code" json:"tariff_code"`
+	FromZone    string  `bson:"from_zone" json:"from_zone"`
+	ToZone      string  `bson:"to_zone" json:"to_zone"`
+	BaseRate    float64 `bson:"base_rate" json:"base_rate"`
+	PricePerKg  float64 `bson:"price_per_kg" json:"price_per_kg"`
+	TransitDays int     `bson:"transit_days" json:"transit_days"`
+}
+
+func (z *ZoneRate) Validate() error {
+	if z.TariffCode == "" {
+		return fmt.Errorf("tariff_code is required")
+	}
+	if z.FromZone == "" || z.ToZone == "" {
+		return fmt.Errorf("from_zone and to_zone are required")
+	}
+	if z.BaseRate < 0 {
+		return fmt.Errorf("base_rate must not be negative")
+	}
+	if z.PricePerKg < 0 {
+		return fmt.Errorf("price_per_kg must not be negative")
+	}
+	if z.BaseRate == 0 && z.PricePerKg == 0 {
+		return fmt.Errorf("base_rate or price_per_kg must be positive")
+	}
+	if z.TransitDays <= 0 {
+		return fmt.Errorf("transit_days must be positive")
+	}
+	return nil
+}
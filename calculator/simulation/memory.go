@@ -0,0 +1,118 @@
+package simulation
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// The repositories below serve a scenario from memory. They embed the
+// repository interfaces and implement only the reads pricing needs; a write
+// would panic, which is fine since a simulation never writes.
+
+type cityDirectory struct {
+	repository.CountryRepository
+	byKey map[string]models.CountryCoordinates
+	names []string
+}
+
+func newCityDirectory(cities []models.CountryCoordinates) *cityDirectory {
+	d := &cityDirectory{byKey: make(map[string]models.CountryCoordinates)}
+	for _, city := range cities {
+		d.names = append(d.names, city.Name)
+		for _, name := range append([]string{city.Name}, city.Aliases...) {
+			if key := models.SearchKey(name); key != "" {
+				d.byKey[key] = city
+			}
+		}
+	}
+	sort.Strings(d.names)
+	return d
+}
+
+func (d *cityDirectory) GetCoordinates(_ context.Context, name string) (*models.CountryCoordinates, error) {
+	city, ok := d.byKey[models.SearchKey(name)]
+	if !ok {
+		return nil, models.ErrCityNotFound
+	}
+	return &city, nil
+}
+
+func (d *cityDirectory) GetNames(context.Context) ([]string, error) {
+	return d.names, nil
+}
+
+// tariffSet holds the candidate tariffs, in force whatever the instant.
+type tariffSet struct {
+	repository.TariffRepository
+	tariffs map[string]models.Tariff
+}
+
+func newTariffSet(tariffs []models.Tariff) *tariffSet {
+	s := &tariffSet{tariffs: make(map[string]models.Tariff, len(tariffs))}
+	for _, t := range tariffs {
+		s.tariffs[strings.ToUpper(t.Code)] = t
+	}
+	return s
+}
+
+func (s *tariffSet) GetByCode(_ context.Context, code string, _ time.Time) (*models.Tariff, error) {
+	t, ok := s.tariffs[strings.ToUpper(code)]
+	if !ok {
+		return nil, models.ErrTariffNotFound
+	}
+	return &t, nil
+}
+
+func (s *tariffSet) GetAll(context.Context) ([]models.Tariff, error) {
+	all := make([]models.Tariff, 0, len(s.tariffs))
+	for _, t := range s.tariffs {
+		all = append(all, t)
+	}
+	return all, nil
+}
+
+type surchargeRules struct {
+	repository.SurchargeRuleRepository
+	rules []models.SurchargeRule
+}
+
+func (r *surchargeRules) GetAll(context.Context) ([]models.SurchargeRule, error) {
+	return r.rules, nil
+}
+
+type promotionSet struct {
+	repository.PromotionRepository
+	promos map[string]models.Promotion
+}
+
+func newPromotionSet(promos []models.Promotion) *promotionSet {
+	s := &promotionSet{promos: make(map[string]models.Promotion, len(promos))}
+	for _, p := range promos {
+		s.promos[models.NormalizePromoCode(p.Code)] = p
+	}
+	return s
+}
+
+func (s *promotionSet) GetByCode(_ context.Context, code string) (*models.Promotion, error) {
+	p, ok := s.promos[models.NormalizePromoCode(code)]
+	if !ok {
+		return nil, models.ErrPromoNotFound
+	}
+	return &p, nil
+}
+
+// fuelRate is the index as one number, set for each package before it is
+// priced.
+type fuelRate struct {
+	repository.FuelIndexRepository
+	percent float64
+}
+
+func (f *fuelRate) GetAt(_ context.Context, at time.Time) (*models.FuelIndex, error) {
+	return &models.FuelIndex{Percent: f.percent, EffectiveFrom: at}, nil
+}
@@ -0,0 +1,209 @@
+package simulation
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Delta totals the repriced packages sharing a key and a currency.
+type Delta struct {
+	Key       string
+	Currency  string
+	Packages  int
+	Baseline  float64
+	Simulated float64
+}
+
+func (d Delta) Change() float64 {
+	return d.Simulated - d.Baseline
+}
+
+// Percent is the change relative to the baseline; zero when there was no
+// baseline revenue.
+func (d Delta) Percent() float64 {
+	if d.Baseline == 0 {
+		return 0
+	}
+	return d.Change() / d.Baseline * 100
+}
+
+func ByRoute(results []Result) []Delta {
+	return aggregate(results, func(r Result) string { return r.Route })
+}
+
+func ByTariff(results []Result) []Delta {
+	return aggregate(results, func(r Result) string { return r.Tariff })
+}
+
+// aggregate leaves out packages that failed or changed currency, since
+// their deltas don't add up. The biggest changes come first.
+func aggregate(results []Result, key func(Result) string) []Delta {
+	index := make(map[[2]string]int)
+	var deltas []Delta
+	for _, r := range results {
+		if r.Status != StatusRepriced {
+			continue
+		}
+		k := [2]string{key(r), r.Currency}
+		i, ok := index[k]
+		if !ok {
+			i = len(deltas)
+			index[k] = i
+			deltas = append(deltas, Delta{Key: k[0], Currency: k[1]})
+		}
+		deltas[i].Packages++
+		deltas[i].Baseline += r.Baseline
+		deltas[i].Simulated += r.Simulated
+	}
+	sort.SliceStable(deltas, func(i, j int) bool {
+		ci, cj := math.Abs(deltas[i].Change()), math.Abs(deltas[j].Change())
+		if ci != cj {
+			return ci > cj
+		}
+		if deltas[i].Key != deltas[j].Key {
+			return deltas[i].Key < deltas[j].Key
+		}
+		return deltas[i].Currency < deltas[j].Currency
+	})
+	return deltas
+}
+
+func WriteResultsCSV(w io.Writer, results []Result) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"package_id", "created_at", "route", "tariff", "currency", "baseline", "simulated", "delta", "status", "error"}); err != nil {
+		return err
+	}
+	for _, r := range results {
+		created := ""
+		if !r.CreatedAt.IsZero() {
+			created = r.CreatedAt.UTC().Format(time.RFC3339)
+		}
+		simulated, delta := "", ""
+		if r.Status != StatusFailed {
+			simulated, delta = formatAmount(r.Simulated), formatAmount(r.Delta())
+		}
+		err := writer.Write([]string{
+			r.PackageID, created, r.Route, r.Tariff, r.Currency,
+			formatAmount(r.Baseline), simulated, delta, r.Status, r.Error,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteDeltasCSV writes one row per key and currency; keyColumn names the
+// first column.
+func WriteDeltasCSV(w io.Writer, keyColumn string, deltas []Delta) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{keyColumn, "currency", "packages", "baseline", "simulated", "delta", "delta_percent"}); err != nil {
+		return err
+	}
+	for _, d := range deltas {
+		err := writer.Write([]string{
+			d.Key, d.Currency, strconv.Itoa(d.Packages),
+			formatAmount(d.Baseline), formatAmount(d.Simulated), formatAmount(d.Change()), formatAmount(d.Percent()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// Summary is the headline of a run: revenue per currency and how the
+// per-package change in percent is spread.
+type Summary struct {
+	Packages        int
+	Repriced        int
+	Failed          int
+	CurrencyChanged int
+	Revenue         []Delta // keyed by currency
+	Increased       int
+	Decreased       int
+	Unchanged       int
+	MeanPercent     float64
+	MedianPercent   float64
+	MinPercent      float64
+	MaxPercent      float64
+}
+
+func Summarize(results []Result) Summary {
+	s := Summary{Packages: len(results)}
+	var percents []float64
+	for _, r := range results {
+		switch r.Status {
+		case StatusFailed:
+			s.Failed++
+			continue
+		case StatusCurrencyChanged:
+			s.CurrencyChanged++
+			continue
+		}
+		s.Repriced++
+		switch delta := math.Round(r.Delta()*100) / 100; {
+		case delta > 0:
+			s.Increased++
+		case delta < 0:
+			s.Decreased++
+		default:
+			s.Unchanged++
+		}
+		if r.Baseline > 0 {
+			percents = append(percents, r.Delta()/r.Baseline*100)
+		}
+	}
+	s.Revenue = aggregate(results, func(r Result) string { return r.Currency })
+
+	if len(percents) == 0 {
+		return s
+	}
+	sort.Float64s(percents)
+	var sum float64
+	for _, p := range percents {
+		sum += p
+	}
+	s.MeanPercent = sum / float64(len(percents))
+	s.MinPercent, s.MaxPercent = percents[0], percents[len(percents)-1]
+	if mid := len(percents) / 2; len(percents)%2 == 1 {
+		s.MedianPercent = percents[mid]
+	} else {
+		s.MedianPercent = (percents[mid-1] + percents[mid]) / 2
+	}
+	return s
+}
+
+func (s Summary) Write(w io.Writer) error {
+	_, err := fmt.Fprintf(w, `packages:          %d
+repriced:          %d
+failed:            %d
+currency changed:  %d
+increased:         %d
+decreased:         %d
+unchanged:         %d
+change per package: mean %+.2f%%, median %+.2f%%, min %+.2f%%, max %+.2f%%
+`, s.Packages, s.Repriced, s.Failed, s.CurrencyChanged, s.Increased, s.Decreased, s.Unchanged,
+		s.MeanPercent, s.MedianPercent, s.MinPercent, s.MaxPercent)
+	if err != nil {
+		return err
+	}
+	for _, r := range s.Revenue {
+		if _, err := fmt.Fprintf(w, "revenue %s: %s -> %s (%+.2f, %+.2f%%)\n",
+			r.Currency, formatAmount(r.Baseline), formatAmount(r.Simulated), r.Change(), r.Percent()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatAmount(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', 2, 64)
+}
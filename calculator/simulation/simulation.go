@@ -0,0 +1,276 @@
+// Package simulation re-prices historical packages with a candidate set of
+// tariffs, surcharge rules and promotions, so the revenue impact of a
+// change is known before it is applied. It runs the calculator's own
+// pricing against the scenario held in memory and never touches a
+// database.
+//
+// Hub routes, zone matrices, road distances and exchange rates aren't part
+// of a scenario: packages are priced by great-circle distance in the
+// tariff's currency, and zone-priced tariffs fail to price.
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/internal/repository"
+	"github.com/maksroxx/DeliveryService/calculator/internal/service"
+	"github.com/maksroxx/DeliveryService/calculator/models"
+)
+
+// DefaultTariff is what packages priced without a tariff code are grouped
+// under.
+const DefaultTariff = "DEFAULT"
+
+const (
+	StatusRepriced        = "repriced"
+	StatusFailed          = "failed"
+	StatusCurrencyChanged = "currency_changed"
+)
+
+// Package is a stored package as the database service exports it.
+type Package struct {
+	PackageID         string    `json:"package_id"`
+	Weight            float64   `json:"weight"`
+	Length            int       `json:"length"`
+	Width             int       `json:"width"`
+	Height            int       `json:"height"`
+	From              string    `json:"from"`
+	To                string    `json:"to"`
+	Pickup            bool      `json:"pickup"`
+	Cost              float64   `json:"cost"`
+	Currency          string    `json:"currency"`
+	OriginalCost      float64   `json:"original_cost,omitempty"`
+	OriginalCurrency  string    `json:"original_currency,omitempty"`
+	ExchangeRate      float64   `json:"exchange_rate,omitempty"`
+	TariffCode        string    `json:"tariff_code"`
+	PromoCode         string    `json:"promo_code,omitempty"`
+	Discount          float64   `json:"discount,omitempty"`
+	FuelSurchargeRate float64   `json:"fuel_surcharge_rate,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// Charged is what the package was priced at in the tariff's currency,
+// before any conversion into the currency the customer paid in.
+func (p Package) Charged() (float64, string) {
+	if p.OriginalCurrency != "" {
+		return p.OriginalCost, p.OriginalCurrency
+	}
+	return p.Cost, p.Currency
+}
+
+// historicalDiscount is the promo discount in the tariff's currency.
+func (p Package) historicalDiscount() float64 {
+	if p.OriginalCurrency != "" && p.ExchangeRate > 0 {
+		return p.Discount / p.ExchangeRate
+	}
+	return p.Discount
+}
+
+// Scenario is the pricing set up to simulate. Nil Surcharges falls back to
+// the calculator's defaults, nil Promotions keeps each package's historical
+// discount and a nil FuelPercent charges the rate recorded on each package.
+type Scenario struct {
+	Catalog     models.Catalog
+	Surcharges  []models.SurchargeRule
+	Promotions  []models.Promotion
+	FuelPercent *float64
+}
+
+// Result is one package priced both ways, in the tariff's currency.
+type Result struct {
+	PackageID string
+	CreatedAt time.Time
+	Route     string
+	Tariff    string
+	Currency  string
+	Baseline  float64
+	Simulated float64
+	Status    string
+	Error     string
+}
+
+func (r Result) Delta() float64 {
+	return r.Simulated - r.Baseline
+}
+
+// Simulator prices packages one at a time; it isn't safe for concurrent
+// use since the clock and fuel rate are set per package.
+type Simulator struct {
+	calc     *service.ExtendedCalculator
+	fuel     *fuelRate
+	at       time.Time
+	scenario Scenario
+}
+
+func New(scenario Scenario) (*Simulator, error) {
+	if scenario.Catalog.Tariffs == nil || scenario.Catalog.Cities == nil {
+		return nil, fmt.Errorf("%w: a scenario needs both tariffs and cities", models.ErrInvalidCatalog)
+	}
+	if err := service.ValidateCatalog(scenario.Catalog); err != nil {
+		return nil, err
+	}
+	for i := range scenario.Surcharges {
+		if err := scenario.Surcharges[i].Validate(); err != nil {
+			return nil, fmt.Errorf("surcharge rule %s: %w", scenario.Surcharges[i].ID, err)
+		}
+	}
+	for i := range scenario.Promotions {
+		if err := scenario.Promotions[i].Validate(); err != nil {
+			return nil, fmt.Errorf("promotion %s: %w", scenario.Promotions[i].Code, err)
+		}
+	}
+
+	var surcharges repository.SurchargeRuleRepository
+	if scenario.Surcharges != nil {
+		surcharges = &surchargeRules{rules: scenario.Surcharges}
+	}
+	var promos repository.PromotionRepository
+	if scenario.Promotions != nil {
+		promos = newPromotionSet(scenario.Promotions)
+	}
+	s := &Simulator{fuel: &fuelRate{}, scenario: scenario}
+	s.calc = service.NewExtendedCalculator(service.CalculatorDeps{
+		Countries:  newCityDirectory(scenario.Catalog.Cities),
+		Tariffs:    newTariffSet(scenario.Catalog.Tariffs),
+		Surcharges: surcharges,
+		Promos:     promos,
+		Fuel:       s.fuel,
+		Clock:      func() time.Time { return s.at },
+	})
+	return s, nil
+}
+
+func (s *Simulator) Run(ctx context.Context, packages []Package) []Result {
+	results := make([]Result, 0, len(packages))
+	for _, pkg := range packages {
+		results = append(results, s.Reprice(ctx, pkg))
+	}
+	return results
+}
+
+// Reprice prices the package as if the scenario had been live when it was
+// created.
+func (s *Simulator) Reprice(ctx context.Context, pkg Package) Result {
+	baseline, currency := pkg.Charged()
+	res := Result{
+		PackageID: pkg.PackageID,
+		CreatedAt: pkg.CreatedAt,
+		Route:     pkg.From + " -> " + pkg.To,
+		Tariff:    pkg.TariffCode,
+		Currency:  currency,
+		Baseline:  baseline,
+	}
+	if res.Tariff == "" {
+		res.Tariff = DefaultTariff
+	}
+
+	s.at = pkg.CreatedAt
+	if s.at.IsZero() {
+		s.at = time.Now()
+	}
+	s.fuel.percent = pkg.FuelSurchargeRate
+	if s.scenario.FuelPercent != nil {
+		s.fuel.percent = *s.scenario.FuelPercent
+	}
+
+	in := models.Package{
+		Weight: pkg.Weight,
+		From:   pkg.From,
+		To:     pkg.To,
+		Length: pkg.Length,
+		Width:  pkg.Width,
+		Height: pkg.Height,
+		Pickup: pkg.Pickup,
+	}
+	if s.scenario.Promotions != nil {
+		in.PromoCode = pkg.PromoCode
+	}
+
+	var (
+		result models.CalculationResult
+		err    error
+	)
+	if pkg.TariffCode == "" {
+		result, err = s.calc.Calculate(ctx, in)
+	} else {
+		result, err = s.calc.CalculateByTariffCode(ctx, in, pkg.TariffCode)
+	}
+	if err != nil {
+		res.Status, res.Error = StatusFailed, err.Error()
+		return res
+	}
+	if s.scenario.Promotions == nil {
+		if discount := pkg.historicalDiscount(); discount > 0 {
+			result.AddLineItems(models.LineItem{
+				Code:        "promo",
+				Description: "Historical discount",
+				Amount:      -models.RoundAmount(min(discount, result.Cost), result.Currency),
+			})
+		}
+	}
+
+	res.Simulated = result.Cost
+	res.Status = StatusRepriced
+	if result.Currency != currency {
+		res.Status = StatusCurrencyChanged
+		res.Error = fmt.Sprintf("priced in %s, charged in %s", result.Currency, currency)
+	}
+	return res
+}
+
+// LoadPackages reads a JSON array of packages exported from the database
+// service.
+func LoadPackages(path string) ([]Package, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var packages []Package
+	if err := json.Unmarshal(data, &packages); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return packages, nil
+}
+
+// LoadScenario reads the catalog the way the catalog command does, and the
+// surcharge rules and promotions from JSON arrays. An empty path leaves
+// that part of the scenario unset.
+func LoadScenario(catalogPath, surchargesPath, promotionsPath string) (Scenario, error) {
+	var scenario Scenario
+	if catalogPath == "" {
+		return scenario, errors.New("a catalog is required")
+	}
+	catalog, err := service.LoadCatalog(catalogPath)
+	if err != nil {
+		return scenario, err
+	}
+	scenario.Catalog = catalog
+	if err := readJSON(surchargesPath, &scenario.Surcharges); err != nil {
+		return scenario, err
+	}
+	if err := readJSON(promotionsPath, &scenario.Promotions); err != nil {
+		return scenario, err
+	}
+	return scenario, nil
+}
+
+func readJSON[T any](path string, out *[]T) error {
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	list := []T{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	*out = list
+	return nil
+}
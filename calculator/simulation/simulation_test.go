@@ -0,0 +1,127 @@
+package simulation_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/calculator/models"
+	"github.com/maksroxx/DeliveryService/calculator/simulation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scenario(baseRate float64) simulation.Scenario {
+	return simulation.Scenario{
+		Catalog: models.Catalog{
+			Tariffs: []models.Tariff{{
+				Code:              "STANDARD",
+				Name:              "Standard",
+				BaseRate:          baseRate,
+				PricePerKm:        1,
+				PricePerKg:        10,
+				Currency:          "RUB",
+				VolumetricDivider: 5000,
+				SpeedKmph:         60,
+				FuelSurcharge:     true,
+			}},
+			Cities: []models.CountryCoordinates{
+				{Name: "Moscow", Latitude: 55.75, Longitude: 37.62, Aliases: []string{"Москва"}},
+				{Name: "Kazan", Latitude: 55.79, Longitude: 49.12},
+			},
+		},
+		Surcharges: []models.SurchargeRule{},
+	}
+}
+
+func TestSimulator_Reprice(t *testing.T) {
+	sim, err := simulation.New(scenario(300))
+	require.NoError(t, err)
+	at := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+	pkg := simulation.Package{PackageID: "p1", Weight: 1, From: "Москва", To: "Kazan", Cost: 1000, Currency: "RUB", TariffCode: "STANDARD", CreatedAt: at}
+
+	base := sim.Reprice(context.Background(), pkg)
+	require.Equal(t, simulation.StatusRepriced, base.Status, base.Error)
+	assert.Equal(t, "Москва -> Kazan", base.Route)
+	assert.Equal(t, 1000.0, base.Baseline)
+
+	raised, err := simulation.New(scenario(400))
+	require.NoError(t, err)
+	res := raised.Reprice(context.Background(), pkg)
+	assert.InDelta(t, base.Simulated+100, res.Simulated, 0.01)
+
+	// the fuel rate recorded on the package is charged again
+	pkg.FuelSurchargeRate = 10
+	res = sim.Reprice(context.Background(), pkg)
+	assert.InDelta(t, base.Simulated*1.1, res.Simulated, 0.01)
+
+	// without candidate promotions the historical discount is kept
+	pkg.FuelSurchargeRate = 0
+	pkg.PromoCode, pkg.Discount = "WELCOME", 50
+	res = sim.Reprice(context.Background(), pkg)
+	assert.InDelta(t, base.Simulated-50, res.Simulated, 0.01)
+
+	// paid in euros, priced in roubles: the baseline is the rouble price
+	pkg = simulation.Package{PackageID: "p2", Weight: 1, From: "Moscow", To: "Kazan", Cost: 10, Currency: "EUR", OriginalCost: 1000, OriginalCurrency: "RUB", TariffCode: "STANDARD"}
+	res = sim.Reprice(context.Background(), pkg)
+	assert.Equal(t, simulation.StatusRepriced, res.Status)
+	assert.Equal(t, "RUB", res.Currency)
+	assert.Equal(t, 1000.0, res.Baseline)
+
+	res = sim.Reprice(context.Background(), simulation.Package{PackageID: "p3", Weight: 1, From: "Moscow", To: "Kazan", Cost: 900, Currency: "RUB", TariffCode: "RETIRED"})
+	assert.Equal(t, simulation.StatusFailed, res.Status)
+	assert.Contains(t, res.Error, "RETIRED")
+}
+
+func TestNew_RejectsIncompleteScenario(t *testing.T) {
+	s := scenario(300)
+	s.Catalog.Cities = nil
+	_, err := simulation.New(s)
+	assert.ErrorIs(t, err, models.ErrInvalidCatalog)
+
+	s = scenario(0)
+	_, err = simulation.New(s)
+	assert.ErrorIs(t, err, models.ErrInvalidCatalog)
+}
+
+func TestReports(t *testing.T) {
+	results := []simulation.Result{
+		{PackageID: "a", Route: "Moscow -> Kazan", Tariff: "STANDARD", Currency: "RUB", Baseline: 100, Simulated: 110, Status: simulation.StatusRepriced},
+		{PackageID: "b", Route: "Moscow -> Kazan", Tariff: "EXPRESS", Currency: "RUB", Baseline: 200, Simulated: 180, Status: simulation.StatusRepriced},
+		{PackageID: "c", Route: "Kazan -> Moscow", Tariff: "STANDARD", Currency: "RUB", Baseline: 100, Simulated: 100, Status: simulation.StatusRepriced},
+		{PackageID: "d", Route: "Kazan -> Moscow", Tariff: "STANDARD", Currency: "RUB", Baseline: 100, Status: simulation.StatusFailed, Error: "unknown city"},
+	}
+
+	routes := simulation.ByRoute(results)
+	require.Len(t, routes, 2)
+	assert.Equal(t, simulation.Delta{Key: "Moscow -> Kazan", Currency: "RUB", Packages: 2, Baseline: 300, Simulated: 290}, routes[0])
+	assert.Equal(t, 1, routes[1].Packages, "failed packages aren't totalled")
+
+	tariffs := simulation.ByTariff(results)
+	require.Len(t, tariffs, 2)
+	assert.Equal(t, "EXPRESS", tariffs[0].Key, "the biggest change comes first")
+	assert.InDelta(t, -10, tariffs[0].Percent(), 1e-9)
+
+	summary := simulation.Summarize(results)
+	assert.Equal(t, 4, summary.Packages)
+	assert.Equal(t, 3, summary.Repriced)
+	assert.Equal(t, 1, summary.Failed)
+	assert.Equal(t, [3]int{1, 1, 1}, [3]int{summary.Increased, summary.Decreased, summary.Unchanged})
+	assert.InDelta(t, 0, summary.MedianPercent, 1e-9)
+	assert.InDelta(t, -10, summary.MinPercent, 1e-9)
+	assert.InDelta(t, 10, summary.MaxPercent, 1e-9)
+	require.Len(t, summary.Revenue, 1)
+	assert.Equal(t, 400.0, summary.Revenue[0].Baseline)
+
+	var buf bytes.Buffer
+	require.NoError(t, simulation.WriteDeltasCSV(&buf, "route", routes))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, "route,currency,packages,baseline,simulated,delta,delta_percent", lines[0])
+	assert.Equal(t, "Moscow -> Kazan,RUB,2,300.00,290.00,-10.00,-3.33", lines[1])
+
+	buf.Reset()
+	require.NoError(t, simulation.WriteResultsCSV(&buf, results))
+	assert.Contains(t, buf.String(), "d,,Kazan -> Moscow,STANDARD,RUB,100.00,,,failed,unknown city")
+}
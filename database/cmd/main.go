@@ -55,11 +55,18 @@ func main() {
 	}
 	defer producer.Close()
 	repo := repository.NewMongoRepository(db, "packages")
-	service := service.NewPackageService(repo, calcClient, producer, logger)
+	pickupRepo := repository.NewMongoPickupRepository(db, "pickup_slots", "pickup_bookings")
+	pickupService := service.NewPickupService(pickupRepo, repo, cfg.Pickup, logger)
+	courierRepo := repository.NewMongoCourierRepository(db, "couriers")
+	courierService := service.NewCourierService(courierRepo, repo, logger)
+	redemptionRepo := repository.NewMongoRedemptionRepository(db, "redemptions")
+	service := service.NewPackageService(repo, redemptionRepo, courierRepo, pickupRepo, calcClient, producer, logger)
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(middleware.GRPCAuthInterceptor()),
 	)
 	pb.RegisterPackageServiceServer(grpcServer, handlers.NewGrpcPackageHandler(service, logger))
+	pb.RegisterPickupServiceServer(grpcServer, handlers.NewGrpcPickupHandler(pickupService, logger))
+	pb.RegisterCourierServiceServer(grpcServer, handlers.NewGrpcCourierHandler(courierService, logger))
 
 	go func() {
 		listener, err := net.Listen("tcp", ":50054")
@@ -71,7 +78,7 @@ func main() {
 			logger.Fatalf("gRPC server failed: %v", err)
 		}
 	}()
-	packageHandler := handlers.NewPackageHandler(repo, calcClient, producer, logger)
+	packageHandler := handlers.NewPackageHandler(repo, service, logger)
 
 	mux := http.NewServeMux()
 	protected := http.NewServeMux()
@@ -13,6 +13,7 @@ type Config struct {
 	Database   DatabaseConfig   `yaml:"database"`
 	Kafka      KafkaConfig      `yaml:"kafka"`
 	Calculator CalculatorConfig `yaml:"calculator"`
+	Pickup     PickupConfig     `yaml:"pickup"`
 }
 
 type ServerConfig struct {
@@ -40,6 +41,18 @@ type CalculatorConfig struct {
 	GRPCAddress string `yaml:"grpc_address"`
 }
 
+type PickupConfig struct {
+	HorizonDays  int                           `yaml:"horizon_days"`
+	DefaultSlots []PickupSlotConfig            `yaml:"default_slots"`
+	Cities       map[string][]PickupSlotConfig `yaml:"cities"`
+}
+
+type PickupSlotConfig struct {
+	Start    string `yaml:"start"`
+	End      string `yaml:"end"`
+	Capacity int    `yaml:"capacity"`
+}
+
 func Load() *Config {
 	configPath := os.Getenv("PACKAGE_CONFIG")
 	if configPath == "" {
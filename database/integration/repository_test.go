@@ -2,7 +2,9 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -483,6 +485,70 @@ func TestMongoRepository_CalculateRemainingHours(t *testing.T) {
 	assert.Equal(t, 24, result.RemainingHours)
 }
 
+func TestMongoRedemptionRepository_RedeemOnce(t *testing.T) {
+	ctx, db, cleanup := setupDatabaseTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewMongoRedemptionRepository(db, "redemptions")
+
+	assert.NoError(t, repo.Redeem(ctx, "quote:abc", "PKG-1", time.Now().Add(time.Hour)))
+	assert.ErrorIs(t, repo.Redeem(ctx, "quote:abc", "PKG-2", time.Time{}), models.ErrAlreadyRedeemed)
+
+	// only the package holding the redemption can release it
+	assert.NoError(t, repo.Release(ctx, "quote:abc", "PKG-2"))
+	assert.ErrorIs(t, repo.Redeem(ctx, "quote:abc", "PKG-2", time.Time{}), models.ErrAlreadyRedeemed)
+
+	assert.NoError(t, repo.Release(ctx, "quote:abc", "PKG-1"))
+	assert.NoError(t, repo.Redeem(ctx, "quote:abc", "PKG-2", time.Time{}))
+}
+
+func TestMongoPickupRepository_ReserveSlot_ConcurrentFirstBookings(t *testing.T) {
+	ctx, db, cleanup := setupDatabaseTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewMongoPickupRepository(db, "pickup_slots", "pickup_bookings")
+	slot := models.PickupSlot{SlotID: "Berlin|2026-05-01|09:00", City: "Berlin", Date: "2026-05-01", Start: "09:00", End: "11:00", Capacity: 5}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- repo.ReserveSlot(ctx, slot)
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var reserved, full int
+	for err := range errs {
+		switch {
+		case err == nil:
+			reserved++
+		case errors.Is(err, models.ErrPickupSlotFull):
+			full++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	assert.Equal(t, 5, reserved)
+	assert.Equal(t, 3, full)
+}
+
+func TestMongoPickupRepository_ReserveSlot_ZeroCapacity(t *testing.T) {
+	ctx, db, cleanup := setupDatabaseTestEnvironment(t)
+	defer cleanup()
+
+	repo := repository.NewMongoPickupRepository(db, "pickup_slots", "pickup_bookings")
+	slot := models.PickupSlot{SlotID: "Berlin|2026-05-01|18:00", City: "Berlin", Date: "2026-05-01", Start: "18:00", End: "20:00"}
+
+	assert.ErrorIs(t, repo.ReserveSlot(ctx, slot), models.ErrPickupSlotFull)
+	counts, err := repo.GetBookedCounts(ctx, "Berlin", "2026-05-01")
+	assert.NoError(t, err)
+	assert.Empty(t, counts)
+}
+
 func setupDatabaseTestEnvironment(t *testing.T) (context.Context, *mongo.Database, func()) {
 	ctx := context.Background()
 
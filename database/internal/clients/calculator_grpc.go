@@ -10,14 +10,22 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// serviceRole marks the database service's own calls; the calculator takes
+// promo redemptions from it only.
+const serviceRole = "service"
+
 type Calculator interface {
-	Calculate(weight float64, userID, from, to, address string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error)
-	CalculateByTariff(weight float64, userID, from, to, address, tariffCode string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error)
+	Calculate(userID string, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.CalculateDeliveryCostResponse, error)
+	CalculateByTariff(userID string, req *calculatorpb.CalculateByTariffRequest) (*calculatorpb.CalculateDeliveryCostResponse, error)
+	VerifyQuote(userID string, req *calculatorpb.VerifyQuoteRequest) (*calculatorpb.CalculateDeliveryCostResponse, error)
+	RedeemPromo(userID, code, packageID, tariffCode, from, to string, firstOrder bool) error
+	ReleasePromo(userID, code, packageID string) error
 }
 
 type CalculatorGRPCClient struct {
 	conn   *grpc.ClientConn
 	client calculatorpb.CalculatorServiceClient
+	promos calculatorpb.PromotionServiceClient
 }
 
 func NewCalculatorClient(address string) (*CalculatorGRPCClient, error) {
@@ -35,6 +43,7 @@ func NewCalculatorClient(address string) (*CalculatorGRPCClient, error) {
 	return &CalculatorGRPCClient{
 		conn:   conn,
 		client: client,
+		promos: calculatorpb.NewPromotionServiceClient(conn),
 	}, nil
 }
 
@@ -42,7 +51,7 @@ func (c *CalculatorGRPCClient) Close() error {
 	return c.conn.Close()
 }
 
-func (c *CalculatorGRPCClient) Calculate(weight float64, userID, from, to, address string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+func (c *CalculatorGRPCClient) Calculate(userID string, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
 	md := metadata.New(map[string]string{
 		"authorization": userID,
 	})
@@ -50,20 +59,21 @@ func (c *CalculatorGRPCClient) Calculate(weight float64, userID, from, to, addre
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	req := &calculatorpb.CalculateDeliveryCostRequest{
-		Weight:  weight,
-		From:    from,
-		To:      to,
-		Address: address,
-		Width:   int32(width),
-		Length:  int32(length),
-		Height:  int32(height),
-	}
-
 	return c.client.CalculateDeliveryCost(ctx, req)
 }
 
-func (c *CalculatorGRPCClient) CalculateByTariff(weight float64, userID, from, to, address, tariff_code string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+func (c *CalculatorGRPCClient) CalculateByTariff(userID string, req *calculatorpb.CalculateByTariffRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+	md := metadata.New(map[string]string{
+		"authorization": userID,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.client.CalculateByTariffCode(ctx, req)
+}
+
+func (c *CalculatorGRPCClient) VerifyQuote(userID string, req *calculatorpb.VerifyQuoteRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
 	md := metadata.New(map[string]string{
 		"authorization": userID,
 	})
@@ -71,15 +81,62 @@ func (c *CalculatorGRPCClient) CalculateByTariff(weight float64, userID, from, t
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	req := &calculatorpb.CalculateByTariffRequest{
-		Weight:     weight,
+	return c.client.VerifyQuote(ctx, req)
+}
+
+func (c *CalculatorGRPCClient) RedeemPromo(userID, code, packageID, tariffCode, from, to string, firstOrder bool) error {
+	md := metadata.New(map[string]string{
+		"authorization": userID,
+		"role":          serviceRole,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := c.promos.RedeemPromo(ctx, &calculatorpb.RedeemPromoRequest{
+		Code:       code,
+		UserId:     userID,
+		PackageId:  packageID,
+		TariffCode: tariffCode,
 		From:       from,
 		To:         to,
-		Address:    address,
-		Width:      int32(width),
-		Length:     int32(length),
-		Height:     int32(height),
-		TariffCode: tariff_code,
+		FirstOrder: firstOrder,
+	})
+	return err
+}
+
+func (c *CalculatorGRPCClient) ReleasePromo(userID, code, packageID string) error {
+	md := metadata.New(map[string]string{
+		"authorization": userID,
+		"role":          serviceRole,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	_, err := c.promos.ReleasePromo(ctx, &calculatorpb.ReleasePromoRequest{
+		Code:      code,
+		PackageId: packageID,
+	})
+	return err
+}
+
+// PromoDiscount returns how much the applied promo code took off the price.
+func PromoDiscount(result *calculatorpb.CalculateDeliveryCostResponse) float64 {
+	for _, item := range result.LineItems {
+		if item.Code == "promo" {
+			return -item.Amount
+		}
 	}
-	return c.client.CalculateByTariffCode(ctx, req)
+	return 0
+}
+
+// DeliveryWindow returns when the calculator expects the parcel to be
+// handed over, nil bounds when it planned no window.
+func DeliveryWindow(result *calculatorpb.CalculateDeliveryCostResponse) (from, to *time.Time) {
+	if result.DeliveryFrom == nil || result.DeliveryTo == nil {
+		return nil, nil
+	}
+	f, t := result.DeliveryFrom.AsTime(), result.DeliveryTo.AsTime()
+	return &f, &t
 }
@@ -0,0 +1,158 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/internal/middleware"
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"github.com/maksroxx/DeliveryService/database/internal/service"
+	pb "github.com/maksroxx/DeliveryService/proto/database"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ErrForbidden = errors.New("forbidden: insufficient role")
+
+type GrpcCourierHandler struct {
+	pb.UnimplementedCourierServiceServer
+	service service.CourierService
+	logger  *logrus.Logger
+}
+
+func NewGrpcCourierHandler(service service.CourierService, log *logrus.Logger) *GrpcCourierHandler {
+	return &GrpcCourierHandler{
+		service: service,
+		logger:  log,
+	}
+}
+
+func (h *GrpcCourierHandler) SaveCourierProfile(ctx context.Context, req *pb.CourierProfile) (*pb.Courier, error) {
+	courierID, err := userWithRole(ctx, "courier")
+	if err != nil {
+		return nil, err
+	}
+	courier, err := h.service.SaveProfile(ctx, &models.Courier{
+		CourierID: courierID,
+		Name:      req.Name,
+		Phone:     req.Phone,
+		City:      req.City,
+		Vehicle: models.Vehicle{
+			Type:        req.VehicleType,
+			MaxWeight:   req.MaxWeight,
+			MaxPackages: int(req.MaxPackages),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toProtoCourier(courier), nil
+}
+
+func (h *GrpcCourierHandler) GetCourierProfile(ctx context.Context, _ *pb.Empty) (*pb.Courier, error) {
+	courierID, err := userWithRole(ctx, "courier")
+	if err != nil {
+		return nil, err
+	}
+	courier, err := h.service.GetProfile(ctx, courierID)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoCourier(courier), nil
+}
+
+func (h *GrpcCourierHandler) StartShift(ctx context.Context, req *pb.StartShiftRequest) (*pb.Courier, error) {
+	courierID, err := userWithRole(ctx, "courier")
+	if err != nil {
+		return nil, err
+	}
+	courier, err := h.service.StartShift(ctx, courierID, int(req.Hours))
+	if err != nil {
+		return nil, err
+	}
+	return toProtoCourier(courier), nil
+}
+
+func (h *GrpcCourierHandler) EndShift(ctx context.Context, _ *pb.Empty) (*pb.Courier, error) {
+	courierID, err := userWithRole(ctx, "courier")
+	if err != nil {
+		return nil, err
+	}
+	courier, err := h.service.EndShift(ctx, courierID)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoCourier(courier), nil
+}
+
+func (h *GrpcCourierHandler) AssignPackage(ctx context.Context, req *pb.AssignPackageRequest) (*pb.Package, error) {
+	if _, err := userWithRole(ctx, "moderator"); err != nil {
+		return nil, err
+	}
+	if req.PackageId == "" {
+		return nil, ErrInvalidInput
+	}
+	pkg, err := h.service.AssignPackage(ctx, req.PackageId, req.CourierId)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(pkg), nil
+}
+
+func (h *GrpcCourierHandler) GetAssignedPackages(ctx context.Context, req *pb.PackageFilter) (*pb.PackageList, error) {
+	courierID, err := userWithRole(ctx, "courier")
+	if err != nil {
+		return nil, err
+	}
+	pkgs, err := h.service.GetAssignedPackages(ctx, courierID, req.Status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoList(pkgs), nil
+}
+
+func (h *GrpcCourierHandler) UpdateDeliveryStatus(ctx context.Context, req *pb.DeliveryStatusUpdate) (*pb.Package, error) {
+	courierID, err := userWithRole(ctx, "courier")
+	if err != nil {
+		return nil, err
+	}
+	if req.PackageId == "" || req.Status == "" {
+		return nil, ErrInvalidInput
+	}
+	pkg, err := h.service.UpdateDeliveryStatus(ctx, courierID, req.PackageId, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return toProto(pkg), nil
+}
+
+func userWithRole(ctx context.Context, role string) (string, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if r, _ := ctx.Value(middleware.GRPCRoleKey()).(string); r != role {
+		return "", ErrForbidden
+	}
+	return userID, nil
+}
+
+func toProtoCourier(c *models.Courier) *pb.Courier {
+	out := &pb.Courier{
+		CourierId:    c.CourierID,
+		Name:         c.Name,
+		Phone:        c.Phone,
+		City:         c.City,
+		VehicleType:  c.Vehicle.Type,
+		MaxWeight:    c.Vehicle.MaxWeight,
+		MaxPackages:  int32(c.Vehicle.MaxPackages),
+		LoadWeight:   c.LoadWeight,
+		LoadPackages: int32(c.LoadPackages),
+	}
+	if c.Shift.Active(time.Now()) {
+		out.OnShift = true
+		out.ShiftEndsAt = timestamppb.New(c.Shift.EndsAt)
+	}
+	return out
+}
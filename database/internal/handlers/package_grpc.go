@@ -2,12 +2,15 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/maksroxx/DeliveryService/database/internal/models"
 	"github.com/maksroxx/DeliveryService/database/internal/service"
 	pb "github.com/maksroxx/DeliveryService/proto/database"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -24,7 +27,8 @@ type GrpcPackageHandler struct {
 
 func NewGrpcPackageHandler(service service.PackageService, log *logrus.Logger) *GrpcPackageHandler {
 	return &GrpcPackageHandler{
-		logger: log,
+		service: service,
+		logger:  log,
 	}
 }
 
@@ -161,9 +165,20 @@ func (h *GrpcPackageHandler) CreatePackageWithCalc(ctx context.Context, req *pb.
 		To:         req.To,
 		Address:    req.Address,
 		TariffCode: req.TariffCode,
+		Pickup:     req.Pickup,
+		QuoteID:    req.QuoteId,
+		Currency:   req.Currency,
+		PromoCode:  req.PromoCode,
+		Category:   req.Category,
 	}
 	created, err := h.service.CreatePackageWithCalculation(ctx, model)
 	if err != nil {
+		if errors.Is(err, models.ErrQuoteAlreadyUsed) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, models.ErrPromoRejected) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
 	return toProto(created), nil
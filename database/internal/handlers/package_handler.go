@@ -2,32 +2,30 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 	"time"
 
-	"github.com/google/uuid"
-	"github.com/maksroxx/DeliveryService/database/internal/clients"
-	"github.com/maksroxx/DeliveryService/database/internal/kafka"
 	"github.com/maksroxx/DeliveryService/database/internal/models"
 	"github.com/maksroxx/DeliveryService/database/internal/repository"
-	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
+	"github.com/maksroxx/DeliveryService/database/internal/service"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PackageHandler struct {
-	rep      repository.RouteRepository
-	calc     clients.Calculator
-	producer *kafka.Producer
-	log      *logrus.Logger
+	rep repository.RouteRepository
+	svc service.PackageService
+	log *logrus.Logger
 }
 
-func NewPackageHandler(rep repository.RouteRepository, calculator clients.Calculator, producer *kafka.Producer, logger *logrus.Logger) *PackageHandler {
+func NewPackageHandler(rep repository.RouteRepository, svc service.PackageService, logger *logrus.Logger) *PackageHandler {
 	return &PackageHandler{
-		rep:      rep,
-		calc:     calculator,
-		producer: producer,
-		log:      logger,
+		rep: rep,
+		svc: svc,
+		log: logger,
 	}
 }
 
@@ -207,7 +205,7 @@ func (h *PackageHandler) DeletePackage(w http.ResponseWriter, r *http.Request) {
 		respondWithError(w, http.StatusBadRequest, "Package id not found")
 	}
 
-	if err := h.rep.DeletePackage(r.Context(), packageID); err != nil {
+	if err := h.svc.DeletePackage(r.Context(), packageID); err != nil {
 		respondWithError(w, http.StatusInternalServerError, "Failed to delete package")
 		return
 	}
@@ -227,51 +225,26 @@ func (h *PackageHandler) Create(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "invalid request: "+err.Error(), http.StatusBadRequest)
 		return
 	}
-	var (
-		result *calculatorpb.CalculateDeliveryCostResponse
-		err    error
-	)
-	if pack.TariffCode == "" {
-		result, err = h.calc.Calculate(pack.Weight, userID, pack.From, pack.To, pack.Address, pack.Length, pack.Width, pack.Height)
-		pack.TariffCode = "DEFAULT"
-	} else {
-		result, err = h.calc.CalculateByTariff(pack.Weight, userID, pack.From, pack.To, pack.Address, pack.TariffCode, pack.Length, pack.Width, pack.Height)
-	}
-	if err != nil {
-		h.log.WithError(err).Error("Failed to call calculator")
-		http.Error(w, "calculation failed", http.StatusInternalServerError)
-		return
-	}
-
-	pack.PackageID = "PKG-" + uuid.New().String()
 	pack.UserID = userID
-	pack.Cost = result.Cost
-	pack.Status = "Created"
-	pack.PaymentStatus = "PENDING"
-	pack.EstimatedHours = int(result.EstimatedHours)
-	pack.Currency = result.Currency
-	pack.CreatedAt = time.Now()
-
-	if _, err := h.rep.Create(r.Context(), &pack); err != nil {
-		h.log.WithError(err).Error("DB insert failed")
-		http.Error(w, "failed to store package", http.StatusInternalServerError)
-		return
-	}
 
-	payment := models.Payment{
-		UserID:    userID,
-		PackageID: pack.PackageID,
-		Cost:      pack.Cost,
-		Currency:  pack.Currency,
-	}
-
-	if err := h.producer.SendPaymentEvent(payment); err != nil {
-		h.log.WithError(err).Error("Kafka payment event failed")
-		http.Error(w, "failed to send payment event", http.StatusInternalServerError)
+	created, err := h.svc.CreatePackageWithCalculation(r.Context(), &pack)
+	if err != nil {
+		h.log.WithError(err).Error("Failed to create package")
+		var grpcErr interface{ GRPCStatus() *status.Status }
+		switch {
+		case errors.Is(err, models.ErrQuoteAlreadyUsed):
+			http.Error(w, err.Error(), http.StatusConflict)
+		case errors.Is(err, models.ErrPromoRejected):
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		case errors.As(err, &grpcErr) && (grpcErr.GRPCStatus().Code() == codes.NotFound || grpcErr.GRPCStatus().Code() == codes.FailedPrecondition):
+			http.Error(w, grpcErr.GRPCStatus().Message(), http.StatusUnprocessableEntity)
+		default:
+			http.Error(w, "failed to create package", http.StatusInternalServerError)
+		}
 		return
 	}
 
-	respondWithJSON(w, http.StatusCreated, pack)
+	respondWithJSON(w, http.StatusCreated, created)
 }
 
 func (h *PackageHandler) CancelPackage(w http.ResponseWriter, r *http.Request) {
@@ -296,11 +269,7 @@ func (h *PackageHandler) CancelPackage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	update := models.PackageUpdate{
-		Status: "Сanceled",
-	}
-
-	updatedPkg, err := h.rep.UpdatePackage(r.Context(), packageID, update)
+	updatedPkg, err := h.svc.CancelPackage(r.Context(), packageID)
 	if err != nil {
 		respondWithError(w, http.StatusInternalServerError, "Failed to cancel package")
 		return
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/maksroxx/DeliveryService/database/internal/middleware"
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"github.com/maksroxx/DeliveryService/database/internal/service"
+	pb "github.com/maksroxx/DeliveryService/proto/database"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GrpcPickupHandler struct {
+	pb.UnimplementedPickupServiceServer
+	service service.PickupService
+	logger  *logrus.Logger
+}
+
+func NewGrpcPickupHandler(service service.PickupService, log *logrus.Logger) *GrpcPickupHandler {
+	return &GrpcPickupHandler{
+		service: service,
+		logger:  log,
+	}
+}
+
+func (h *GrpcPickupHandler) GetPickupSlots(ctx context.Context, req *pb.PickupSlotsRequest) (*pb.PickupSlotList, error) {
+	if req.City == "" || req.Date == "" {
+		return nil, ErrInvalidInput
+	}
+	slots, err := h.service.GetAvailableSlots(ctx, req.City, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	out := &pb.PickupSlotList{}
+	for _, s := range slots {
+		out.Slots = append(out.Slots, &pb.PickupSlot{
+			SlotId:    s.SlotID,
+			City:      s.City,
+			Date:      s.Date,
+			Start:     s.Start,
+			End:       s.End,
+			Capacity:  int32(s.Capacity),
+			Available: int32(s.Available()),
+		})
+	}
+	return out, nil
+}
+
+func (h *GrpcPickupHandler) BookPickup(ctx context.Context, req *pb.BookPickupRequest) (*pb.PickupBooking, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.PackageId == "" || req.City == "" || req.Date == "" || req.Start == "" {
+		return nil, ErrInvalidInput
+	}
+	booking, err := h.service.BookPickup(ctx, userID, req.PackageId, req.City, req.Date, req.Start)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoBooking(booking), nil
+}
+
+func (h *GrpcPickupHandler) ReschedulePickup(ctx context.Context, req *pb.ReschedulePickupRequest) (*pb.PickupBooking, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.BookingId == "" || req.Date == "" || req.Start == "" {
+		return nil, ErrInvalidInput
+	}
+	booking, err := h.service.ReschedulePickup(ctx, userID, req.BookingId, req.Date, req.Start)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoBooking(booking), nil
+}
+
+func (h *GrpcPickupHandler) CancelPickup(ctx context.Context, req *pb.PickupBookingID) (*pb.PickupBooking, error) {
+	userID, err := userFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	booking, err := h.service.CancelPickup(ctx, userID, req.BookingId)
+	if err != nil {
+		return nil, err
+	}
+	return toProtoBooking(booking), nil
+}
+
+func userFromContext(ctx context.Context) (string, error) {
+	userID, ok := ctx.Value(middleware.GRPCUserIDKey()).(string)
+	if !ok || userID == "" {
+		return "", errors.New("unauthorized: authorization required")
+	}
+	return userID, nil
+}
+
+func toProtoBooking(b *models.PickupBooking) *pb.PickupBooking {
+	return &pb.PickupBooking{
+		BookingId: b.BookingID,
+		PackageId: b.PackageID,
+		SlotId:    b.SlotID,
+		City:      b.City,
+		Date:      b.Date,
+		Start:     b.Start,
+		End:       b.End,
+		Status:    b.Status,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+	}
+}
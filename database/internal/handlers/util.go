@@ -22,25 +22,56 @@ func respondWithError(w http.ResponseWriter, code int, message string) {
 }
 
 func toProto(p *models.Package) *pb.Package {
-	return &pb.Package{
-		PackageId:      p.PackageID,
-		UserId:         p.UserID,
-		Weight:         p.Weight,
-		Length:         int32(p.Length),
-		Width:          int32(p.Width),
-		Height:         int32(p.Height),
-		From:           p.From,
-		To:             p.To,
-		Address:        p.Address,
-		PaymentStatus:  p.PaymentStatus,
-		Status:         p.Status,
-		Cost:           p.Cost,
-		EstimatedHours: int32(p.EstimatedHours),
-		RemainingHours: int32(p.RemainingHours),
-		Currency:       p.Currency,
-		CreatedAt:      timestamppb.New(p.CreatedAt),
-		TariffCode:     p.TariffCode,
+	out := &pb.Package{
+		PackageId:         p.PackageID,
+		UserId:            p.UserID,
+		Weight:            p.Weight,
+		Length:            int32(p.Length),
+		Width:             int32(p.Width),
+		Height:            int32(p.Height),
+		From:              p.From,
+		To:                p.To,
+		Address:           p.Address,
+		PaymentStatus:     p.PaymentStatus,
+		Status:            p.Status,
+		Cost:              p.Cost,
+		EstimatedHours:    int32(p.EstimatedHours),
+		RemainingHours:    int32(p.RemainingHours),
+		Currency:          p.Currency,
+		CreatedAt:         timestamppb.New(p.CreatedAt),
+		TariffCode:        p.TariffCode,
+		TariffVersion:     int32(p.TariffVersion),
+		QuoteId:           p.QuoteID,
+		Pickup:            p.Pickup,
+		Category:          p.Category,
+		CourierId:         p.CourierID,
+		Route:             routeToProto(p.Route),
+		OriginalCost:      p.OriginalCost,
+		OriginalCurrency:  p.OriginalCurrency,
+		ExchangeRate:      p.ExchangeRate,
+		PromoCode:         p.PromoCode,
+		Discount:          p.Discount,
+		FuelSurchargeRate: p.FuelSurchargeRate,
 	}
+	if p.DeliveryFrom != nil && p.DeliveryTo != nil {
+		out.DeliveryFrom = timestamppb.New(*p.DeliveryFrom)
+		out.DeliveryTo = timestamppb.New(*p.DeliveryTo)
+	}
+	return out
+}
+
+func routeToProto(route []models.RouteLeg) []*pb.RouteLeg {
+	var out []*pb.RouteLeg
+	for _, leg := range route {
+		out = append(out, &pb.RouteLeg{
+			FromHub:      leg.FromHub,
+			ToHub:        leg.ToHub,
+			DistanceKm:   leg.DistanceKm,
+			TransitHours: leg.TransitHours,
+			Cost:         leg.Cost,
+		})
+	}
+	return out
 }
 
 func toProtoList(list []*models.Package) *pb.PackageList {
@@ -11,7 +11,10 @@ import (
 
 type contextKey string
 
-const userIDKey contextKey = "user_id"
+const (
+	userIDKey contextKey = "user_id"
+	roleKey   contextKey = "role"
+)
 
 var excludedMethods = map[string]bool{
 	"/delivery.PackageService/TransferExpiredPackages": true,
@@ -21,6 +24,10 @@ func GRPCUserIDKey() contextKey {
 	return userIDKey
 }
 
+func GRPCRoleKey() contextKey {
+	return roleKey
+}
+
 func GRPCAuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -45,6 +52,9 @@ func GRPCAuthInterceptor() grpc.UnaryServerInterceptor {
 
 		userID := ids[0]
 		newCtx := context.WithValue(ctx, userIDKey, userID)
+		if roles := md.Get("role"); len(roles) > 0 {
+			newCtx = context.WithValue(newCtx, roleKey, roles[0])
+		}
 		return handler(newCtx, req)
 	}
 }
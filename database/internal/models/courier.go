@@ -0,0 +1,78 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	StatusCreated   = "Created"
+	StatusPickedUp  = "Picked up"
+	StatusInTransit = "In transit"
+	StatusDelivered = "Delivered"
+	StatusCanceled  = "Сanceled"
+)
+
+var (
+	ErrCourierNotFound        = errors.New("courier not found")
+	ErrCourierNotOnShift      = errors.New("courier is not on shift")
+	ErrCourierOverloaded      = errors.New("courier has no free capacity")
+	ErrNoCourierAvailable     = errors.New("no courier available for this package")
+	ErrPackageNotAssigned     = errors.New("package is not assigned to this courier")
+	ErrPackageAlreadyAssigned = errors.New("package is already assigned to a courier")
+	ErrInvalidTransition      = errors.New("invalid package status transition")
+)
+
+// courierTransitions is the part of the package lifecycle a courier drives.
+var courierTransitions = map[string][]string{
+	StatusCreated:   {StatusPickedUp},
+	StatusPickedUp:  {StatusInTransit},
+	StatusInTransit: {StatusDelivered},
+}
+
+// IsTerminalStatus reports whether the package has left its courier's hands
+// for good, so the load it put on the courier can be released.
+func IsTerminalStatus(status string) bool {
+	return status == StatusDelivered || status == StatusCanceled
+}
+
+func CanCourierTransition(from, to string) bool {
+	for _, next := range courierTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+type Vehicle struct {
+	Type        string  `bson:"type" json:"type"`
+	MaxWeight   float64 `bson:"max_weight" json:"max_weight"`
+	MaxPackages int     `bson:"max_packages" json:"max_packages"`
+}
+
+type Shift struct {
+	StartedAt time.Time `bson:"started_at" json:"started_at"`
+	EndsAt    time.Time `bson:"ends_at" json:"ends_at"`
+}
+
+func (s *Shift) Active(now time.Time) bool {
+	return s != nil && !now.Before(s.StartedAt) && now.Before(s.EndsAt)
+}
+
+type Courier struct {
+	CourierID    string    `bson:"courier_id" json:"courier_id"`
+	Name         string    `bson:"name" json:"name"`
+	Phone        string    `bson:"phone" json:"phone"`
+	City         string    `bson:"city" json:"city"`
+	Vehicle      Vehicle   `bson:"vehicle" json:"vehicle"`
+	Shift        *Shift    `bson:"shift,omitempty" json:"shift,omitempty"`
+	LoadWeight   float64   `bson:"load_weight" json:"load_weight"`
+	LoadPackages int       `bson:"load_packages" json:"load_packages"`
+	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+func (c *Courier) FreeWeight() float64 {
+	return c.Vehicle.MaxWeight - c.LoadWeight
+}
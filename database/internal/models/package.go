@@ -1,41 +1,74 @@
 package models
 
 import (
+	"errors"
 	"time"
 )
 
+var (
+	ErrQuoteAlreadyUsed = errors.New("quote has already been used")
+	ErrPromoRejected    = errors.New("promo code rejected")
+)
+
 type Package struct {
-	ID             string    `bson:"_id,omitempty" json:"-"`
-	PackageID      string    `bson:"package_id" json:"package_id"`
-	UserID         string    `bson:"user_id" json:"-"`
-	Weight         float64   `bson:"weight" json:"weight"`
-	Length         int       `bson:"length" json:"length"`
-	Width          int       `bson:"width" json:"width"`
-	Height         int       `bson:"height" json:"height"`
-	From           string    `bson:"from" json:"from"`
-	To             string    `bson:"to" json:"to"`
-	Address        string    `bson:"address" json:"address"`
-	PaymentStatus  string    `bson:"payment_status" json:"payment_status"`
-	Status         string    `bson:"status" json:"status"`
-	Cost           float64   `bson:"cost" json:"cost"`
-	EstimatedHours int       `bson:"estimated_hours" json:"estimated_hours"`
-	RemainingHours int       `bson:"-" json:"remaining_hours"`
-	Currency       string    `bson:"currency" json:"currency"`
-	CreatedAt      time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt      time.Time `bosn:"updated_at" json:"updated_at"`
-	TariffCode     string    `bson:"tariff_code" json:"tariff_code"`
+	ID                string     `bson:"_id,omitempty" json:"-"`
+	PackageID         string     `bson:"package_id" json:"package_id"`
+	UserID            string     `bson:"user_id" json:"-"`
+	Weight            float64    `bson:"weight" json:"weight"`
+	Length            int        `bson:"length" json:"length"`
+	Width             int        `bson:"width" json:"width"`
+	Height            int        `bson:"height" json:"height"`
+	From              string     `bson:"from" json:"from"`
+	To                string     `bson:"to" json:"to"`
+	Address           string     `bson:"address" json:"address"`
+	PaymentStatus     string     `bson:"payment_status" json:"payment_status"`
+	Status            string     `bson:"status" json:"status"`
+	Cost              float64    `bson:"cost" json:"cost"`
+	EstimatedHours    int        `bson:"estimated_hours" json:"estimated_hours"`
+	RemainingHours    int        `bson:"-" json:"remaining_hours"`
+	Currency          string     `bson:"currency" json:"currency"`
+	OriginalCost      float64    `bson:"original_cost,omitempty" json:"original_cost,omitempty"`
+	OriginalCurrency  string     `bson:"original_currency,omitempty" json:"original_currency,omitempty"`
+	ExchangeRate      float64    `bson:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
+	CreatedAt         time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt         time.Time  `bosn:"updated_at" json:"updated_at"`
+	TariffCode        string     `bson:"tariff_code" json:"tariff_code"`
+	TariffVersion     int        `bson:"tariff_version" json:"tariff_version"`
+	QuoteID           string     `bson:"quote_id,omitempty" json:"quote_id,omitempty"`
+	PromoCode         string     `bson:"promo_code,omitempty" json:"promo_code,omitempty"`
+	Discount          float64    `bson:"discount,omitempty" json:"discount,omitempty"`
+	DeliveryFrom      *time.Time `bson:"delivery_from,omitempty" json:"delivery_from,omitempty"`
+	DeliveryTo        *time.Time `bson:"delivery_to,omitempty" json:"delivery_to,omitempty"`
+	FuelSurchargeRate float64    `bson:"fuel_surcharge_rate,omitempty" json:"fuel_surcharge_rate,omitempty"`
+	Pickup            bool       `bson:"pickup" json:"pickup"`
+	Category          string     `bson:"category,omitempty" json:"category,omitempty"`
+	CourierID         string     `bson:"courier_id,omitempty" json:"courier_id,omitempty"`
+	Route             []RouteLeg `bson:"route,omitempty" json:"route,omitempty"`
+}
+
+// RouteLeg is one hop of the hub route planned by the calculator.
+type RouteLeg struct {
+	FromHub      string  `bson:"from_hub" json:"from_hub"`
+	ToHub        string  `bson:"to_hub" json:"to_hub"`
+	DistanceKm   float64 `bson:"distance_km" json:"distance_km"`
+	TransitHours float64 `bson:"transit_hours" json:"transit_hours"`
+	Cost         float64 `bson:"cost" json:"cost"`
 }
 
 type Payment struct {
-	UserID    string  `bson:"user_id" json:"user_id"`
-	PackageID string  `bson:"package_id" json:"package_id"`
-	Cost      float64 `bson:"cost" json:"cost"`
-	Currency  string  `bson:"currency" json:"currency"`
-	Status    string  `bson:"status" json:"status"`
+	UserID           string  `bson:"user_id" json:"user_id"`
+	PackageID        string  `bson:"package_id" json:"package_id"`
+	Cost             float64 `bson:"cost" json:"cost"`
+	Currency         string  `bson:"currency" json:"currency"`
+	Status           string  `bson:"status" json:"status"`
+	OriginalCost     float64 `bson:"original_cost,omitempty" json:"original_cost,omitempty"`
+	OriginalCurrency string  `bson:"original_currency,omitempty" json:"original_currency,omitempty"`
+	ExchangeRate     float64 `bson:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
 }
 
 type PackageFilter struct {
 	UserID       string    `form:"user_id"`
+	CourierID    string    `form:"courier_id"`
 	Status       string    `form:"status"`
 	CreatedAfter time.Time `form:"created_after"`
 	Limit        int64     `form:"limit,default=20"`
@@ -52,6 +85,8 @@ type PackageUpdate struct {
 // Delivered
 // Сanceled
 // In pick-up point
+// Picked up
+// In transit
 
 type ExpiredPackageEvent struct {
 	PackageID  string    `json:"package_id"`
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	PickupStatusBooked   = "Booked"
+	PickupStatusCanceled = "Canceled"
+)
+
+var (
+	ErrPickupSlotFull       = errors.New("pickup slot is fully booked")
+	ErrPickupSlotNotFound   = errors.New("pickup slot not found")
+	ErrPickupAlreadyBooked  = errors.New("pickup already booked for this package")
+	ErrPickupNotFound       = errors.New("pickup booking not found")
+	ErrPickupDateOutOfRange = errors.New("pickup date is outside the booking horizon")
+	ErrPickupNotRequested   = errors.New("package was created without pickup")
+	ErrPickupCityMismatch   = errors.New("pickup city does not match the package origin")
+)
+
+type PickupSlot struct {
+	SlotID   string `bson:"slot_id" json:"slot_id"`
+	City     string `bson:"city" json:"city"`
+	Date     string `bson:"date" json:"date"`
+	Start    string `bson:"start" json:"start"`
+	End      string `bson:"end" json:"end"`
+	Capacity int    `bson:"-" json:"capacity"`
+	Booked   int    `bson:"booked" json:"booked"`
+}
+
+func (s PickupSlot) Available() int {
+	if s.Booked >= s.Capacity {
+		return 0
+	}
+	return s.Capacity - s.Booked
+}
+
+type PickupBooking struct {
+	BookingID string    `bson:"booking_id" json:"booking_id"`
+	PackageID string    `bson:"package_id" json:"package_id"`
+	UserID    string    `bson:"user_id" json:"-"`
+	SlotID    string    `bson:"slot_id" json:"slot_id"`
+	City      string    `bson:"city" json:"city"`
+	Date      string    `bson:"date" json:"date"`
+	Start     string    `bson:"start" json:"start"`
+	End       string    `bson:"end" json:"end"`
+	Status    string    `bson:"status" json:"status"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrAlreadyRedeemed = errors.New("already redeemed")
+
+// Redemption marks a one-off right, such as a quote, as used by a package.
+// Records with an ExpiresAt are dropped by a TTL index once it passes.
+type Redemption struct {
+	Key        string     `bson:"key"`
+	PackageID  string     `bson:"package_id"`
+	RedeemedAt time.Time  `bson:"redeemed_at"`
+	ExpiresAt  *time.Time `bson:"expires_at,omitempty"`
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/database/internal/models"
 )
@@ -14,5 +15,32 @@ type RouteRepository interface {
 	Create(ctx context.Context, route *models.Package) (*models.Package, error)
 	UpdatePackage(ctx context.Context, id string, update models.PackageUpdate) (*models.Package, error)
 	DeletePackage(ctx context.Context, id string) error
+	AssignCourier(ctx context.Context, packageID, courierID string) (*models.Package, error)
+	TransitionStatus(ctx context.Context, packageID, courierID, from, to string) (*models.Package, error)
 	Ping(ctx context.Context) error
 }
+
+type PickupRepository interface {
+	ReserveSlot(ctx context.Context, slot models.PickupSlot) error
+	ReleaseSlot(ctx context.Context, slotID string) error
+	GetBookedCounts(ctx context.Context, city, date string) (map[string]int, error)
+	CreateBooking(ctx context.Context, booking *models.PickupBooking) error
+	GetBooking(ctx context.Context, bookingID string) (*models.PickupBooking, error)
+	MoveBooking(ctx context.Context, bookingID, fromSlotID string, slot models.PickupSlot) (*models.PickupBooking, error)
+	CancelBooking(ctx context.Context, bookingID string) (*models.PickupBooking, error)
+	CancelPackageBooking(ctx context.Context, packageID string) (*models.PickupBooking, error)
+}
+
+type CourierRepository interface {
+	SaveProfile(ctx context.Context, courier *models.Courier) (*models.Courier, error)
+	GetByID(ctx context.Context, courierID string) (*models.Courier, error)
+	SetShift(ctx context.Context, courierID string, shift *models.Shift) (*models.Courier, error)
+	FindAvailable(ctx context.Context, city string, weight float64, now time.Time) ([]*models.Courier, error)
+	ReserveCapacity(ctx context.Context, courierID string, weight float64) error
+	ReleaseCapacity(ctx context.Context, courierID string, weight float64) error
+}
+
+type RedemptionRepository interface {
+	Redeem(ctx context.Context, key, packageID string, expiresAt time.Time) error
+	Release(ctx context.Context, key, packageID string) error
+}
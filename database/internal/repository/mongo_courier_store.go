@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var caseInsensitive = &options.Collation{Locale: "en", Strength: 2}
+
+type MongoCourierRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoCourierRepository(db *mongo.Database, collectionName string) *MongoCourierRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "courier_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "city", Value: 1}, {Key: "shift.ends_at", Value: 1}},
+			Options: options.Index().SetCollation(caseInsensitive),
+		},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create courier indexes: %v", err))
+	}
+
+	return &MongoCourierRepository{collection: collection}
+}
+
+func (r *MongoCourierRepository) SaveProfile(ctx context.Context, courier *models.Courier) (*models.Courier, error) {
+	now := time.Now()
+	filter := bson.M{"courier_id": courier.CourierID}
+	update := bson.M{
+		"$set": bson.M{
+			"name":       courier.Name,
+			"phone":      courier.Phone,
+			"city":       courier.City,
+			"vehicle":    courier.Vehicle,
+			"updated_at": now,
+		},
+		"$setOnInsert": bson.M{
+			"load_weight":   0.0,
+			"load_packages": 0,
+			"created_at":    now,
+		},
+	}
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var saved models.Courier
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&saved); err != nil {
+		return nil, fmt.Errorf("failed to save courier: %w", err)
+	}
+	return &saved, nil
+}
+
+func (r *MongoCourierRepository) GetByID(ctx context.Context, courierID string) (*models.Courier, error) {
+	var courier models.Courier
+	err := r.collection.FindOne(ctx, bson.M{"courier_id": courierID}).Decode(&courier)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrCourierNotFound
+		}
+		return nil, err
+	}
+	return &courier, nil
+}
+
+func (r *MongoCourierRepository) SetShift(ctx context.Context, courierID string, shift *models.Shift) (*models.Courier, error) {
+	update := bson.M{"$set": bson.M{"shift": shift, "updated_at": time.Now()}}
+	if shift == nil {
+		update = bson.M{"$unset": bson.M{"shift": ""}, "$set": bson.M{"updated_at": time.Now()}}
+	}
+
+	var courier models.Courier
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"courier_id": courierID}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&courier)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrCourierNotFound
+		}
+		return nil, err
+	}
+	return &courier, nil
+}
+
+func (r *MongoCourierRepository) FindAvailable(ctx context.Context, city string, weight float64, now time.Time) ([]*models.Courier, error) {
+	filter := bson.M{
+		"city":             city,
+		"shift.started_at": bson.M{"$lte": now},
+		"shift.ends_at":    bson.M{"$gt": now},
+		"$expr":            hasCapacity(weight),
+	}
+	opts := options.Find().
+		SetCollation(caseInsensitive).
+		SetSort(bson.D{{Key: "load_packages", Value: 1}, {Key: "load_weight", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var couriers []*models.Courier
+	for cursor.Next(ctx) {
+		var courier models.Courier
+		if err := cursor.Decode(&courier); err != nil {
+			return nil, err
+		}
+		couriers = append(couriers, &courier)
+	}
+	return couriers, cursor.Err()
+}
+
+func (r *MongoCourierRepository) ReserveCapacity(ctx context.Context, courierID string, weight float64) error {
+	filter := bson.M{
+		"courier_id": courierID,
+		"$expr":      hasCapacity(weight),
+	}
+	update := bson.M{"$inc": bson.M{"load_weight": weight, "load_packages": 1}}
+
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to reserve courier capacity: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrCourierOverloaded
+	}
+	return nil
+}
+
+func (r *MongoCourierRepository) ReleaseCapacity(ctx context.Context, courierID string, weight float64) error {
+	filter := bson.M{
+		"courier_id":    courierID,
+		"load_packages": bson.M{"$gt": 0},
+	}
+	update := bson.M{"$inc": bson.M{"load_weight": -weight, "load_packages": -1}}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to release courier capacity: %w", err)
+	}
+	return nil
+}
+
+func hasCapacity(weight float64) bson.M {
+	return bson.M{"$and": bson.A{
+		bson.M{"$lte": bson.A{bson.M{"$add": bson.A{"$load_weight", weight}}, "$vehicle.max_weight"}},
+		bson.M{"$lt": bson.A{"$load_packages", "$vehicle.max_packages"}},
+	}}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/maksroxx/DeliveryService/database/internal/metrics"
@@ -21,12 +22,21 @@ type MongoRepository struct {
 func NewMongoRepository(db *mongo.Database, collectionName string) *MongoRepository {
 	collection := db.Collection(collectionName)
 
-	indexModel := mongo.IndexModel{
-		Keys:    bson.D{{Key: "package_id", Value: 1}},
-		Options: options.Index().SetUnique(true),
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "package_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// a quote can pay for one package only
+			Keys: bson.D{{Key: "quote_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"quote_id": bson.M{"$type": "string"}}),
+		},
 	}
 
-	_, err := collection.Indexes().CreateOne(context.Background(), indexModel)
+	_, err := collection.Indexes().CreateMany(context.Background(), indexModels)
 	if err != nil {
 		panic(fmt.Sprintf("Failed to create unique index: %v", err))
 	}
@@ -83,13 +93,42 @@ func (r *MongoRepository) Create(ctx context.Context, route *models.Package) (*m
 		"cost":            route.Cost,
 		"estimated_hours": route.EstimatedHours,
 		"currency":        route.Currency,
+		"pickup":          route.Pickup,
+		"tariff_code":     route.TariffCode,
+		"tariff_version":  route.TariffVersion,
+		"route":           route.Route,
 		"created_at":      route.CreatedAt,
 		"updated_at":      now,
 	}
+	if route.QuoteID != "" {
+		doc["quote_id"] = route.QuoteID
+	}
+	if route.OriginalCurrency != "" {
+		doc["original_cost"] = route.OriginalCost
+		doc["original_currency"] = route.OriginalCurrency
+		doc["exchange_rate"] = route.ExchangeRate
+	}
+	if route.PromoCode != "" {
+		doc["promo_code"] = route.PromoCode
+		doc["discount"] = route.Discount
+	}
+	if route.FuelSurchargeRate > 0 {
+		doc["fuel_surcharge_rate"] = route.FuelSurchargeRate
+	}
+	if route.Category != "" {
+		doc["category"] = route.Category
+	}
+	if route.DeliveryFrom != nil && route.DeliveryTo != nil {
+		doc["delivery_from"] = route.DeliveryFrom
+		doc["delivery_to"] = route.DeliveryTo
+	}
 
 	result, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		metrics.FailedPackageCreations.Inc()
+		if mongo.IsDuplicateKeyError(err) && route.QuoteID != "" && strings.Contains(err.Error(), "quote_id") {
+			return nil, models.ErrQuoteAlreadyUsed
+		}
 		if mongo.IsDuplicateKeyError(err) {
 			return nil, errors.New("package has already exists")
 		}
@@ -114,6 +153,10 @@ func (r *MongoRepository) GetAllPackages(ctx context.Context, filter models.Pack
 		bsonFilter["user_id"] = filter.UserID
 	}
 
+	if filter.CourierID != "" {
+		bsonFilter["courier_id"] = filter.CourierID
+	}
+
 	if filter.Status != "" {
 		bsonFilter["status"] = filter.Status
 	}
@@ -183,6 +226,60 @@ func (r *MongoRepository) UpdatePackage(ctx context.Context, packageID string, u
 	return &updatedRoute, nil
 }
 
+func (r *MongoRepository) AssignCourier(ctx context.Context, packageID, courierID string) (*models.Package, error) {
+	filter := bson.M{
+		"package_id": packageID,
+		"status":     models.StatusCreated,
+		"courier_id": bson.M{"$in": bson.A{nil, ""}},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"courier_id": courierID,
+			"updated_at": time.Now(),
+		},
+	}
+
+	var pkg models.Package
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&pkg)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrPackageAlreadyAssigned
+		}
+		return nil, err
+	}
+
+	metrics.UpdatedPackages.Inc()
+	return &pkg, nil
+}
+
+// TransitionStatus moves the package only if it is still in the expected state,
+// so two concurrent updates from the courier app cannot skip a step.
+func (r *MongoRepository) TransitionStatus(ctx context.Context, packageID, courierID, from, to string) (*models.Package, error) {
+	filter := bson.M{
+		"package_id": packageID,
+		"courier_id": courierID,
+		"status":     from,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"status":     to,
+			"updated_at": time.Now(),
+		},
+	}
+
+	var pkg models.Package
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&pkg)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrInvalidTransition
+		}
+		return nil, err
+	}
+
+	metrics.UpdatedPackages.Inc()
+	return &pkg, nil
+}
+
 func (r *MongoRepository) DeletePackage(ctx context.Context, packageID string) error {
 	filter := bson.M{"package_id": packageID}
 
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoPickupRepository struct {
+	slots    *mongo.Collection
+	bookings *mongo.Collection
+}
+
+func NewMongoPickupRepository(db *mongo.Database, slotsCollection, bookingsCollection string) *MongoPickupRepository {
+	slots := db.Collection(slotsCollection)
+	bookings := db.Collection(bookingsCollection)
+
+	_, err := slots.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "slot_id", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create unique index: %v", err))
+	}
+
+	_, err = bookings.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "booking_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			// only one active booking per package
+			Keys: bson.D{{Key: "package_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": models.PickupStatusBooked}),
+		},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create pickup booking indexes: %v", err))
+	}
+
+	return &MongoPickupRepository{
+		slots:    slots,
+		bookings: bookings,
+	}
+}
+
+// ReserveSlot atomically takes one place in the slot. The counter is only
+// incremented while it is below capacity; when the slot is full the filter
+// no longer matches and the upsert collides with the unique slot_id index.
+// Two first bookings of a slot can also race on that upsert, so a collision
+// is retried once as a plain update before the slot is reported full.
+func (r *MongoPickupRepository) ReserveSlot(ctx context.Context, slot models.PickupSlot) error {
+	if slot.Capacity <= 0 {
+		return models.ErrPickupSlotFull
+	}
+	filter := bson.M{
+		"slot_id": slot.SlotID,
+		"booked":  bson.M{"$lt": slot.Capacity},
+	}
+	update := bson.M{
+		"$inc": bson.M{"booked": 1},
+		"$setOnInsert": bson.M{
+			"city":  slot.City,
+			"date":  slot.Date,
+			"start": slot.Start,
+			"end":   slot.End,
+		},
+	}
+
+	_, err := r.slots.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err == nil {
+		return nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to reserve pickup slot: %w", err)
+	}
+
+	res, err := r.slots.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"booked": 1}})
+	if err != nil {
+		return fmt.Errorf("failed to reserve pickup slot: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return models.ErrPickupSlotFull
+	}
+	return nil
+}
+
+func (r *MongoPickupRepository) ReleaseSlot(ctx context.Context, slotID string) error {
+	filter := bson.M{
+		"slot_id": slotID,
+		"booked":  bson.M{"$gt": 0},
+	}
+	_, err := r.slots.UpdateOne(ctx, filter, bson.M{"$inc": bson.M{"booked": -1}})
+	if err != nil {
+		return fmt.Errorf("failed to release pickup slot: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoPickupRepository) GetBookedCounts(ctx context.Context, city, date string) (map[string]int, error) {
+	cursor, err := r.slots.Find(ctx, bson.M{"city": city, "date": date})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	counts := make(map[string]int)
+	for cursor.Next(ctx) {
+		var slot models.PickupSlot
+		if err := cursor.Decode(&slot); err != nil {
+			return nil, err
+		}
+		counts[slot.SlotID] = slot.Booked
+	}
+	return counts, cursor.Err()
+}
+
+func (r *MongoPickupRepository) CreateBooking(ctx context.Context, booking *models.PickupBooking) error {
+	_, err := r.bookings.InsertOne(ctx, booking)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ErrPickupAlreadyBooked
+		}
+		return fmt.Errorf("failed to create pickup booking: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoPickupRepository) GetBooking(ctx context.Context, bookingID string) (*models.PickupBooking, error) {
+	var booking models.PickupBooking
+	err := r.bookings.FindOne(ctx, bson.M{"booking_id": bookingID}).Decode(&booking)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrPickupNotFound
+		}
+		return nil, err
+	}
+	return &booking, nil
+}
+
+func (r *MongoPickupRepository) MoveBooking(ctx context.Context, bookingID, fromSlotID string, slot models.PickupSlot) (*models.PickupBooking, error) {
+	filter := bson.M{
+		"booking_id": bookingID,
+		"slot_id":    fromSlotID,
+		"status":     models.PickupStatusBooked,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"slot_id":    slot.SlotID,
+			"city":       slot.City,
+			"date":       slot.Date,
+			"start":      slot.Start,
+			"end":        slot.End,
+			"updated_at": time.Now(),
+		},
+	}
+
+	var booking models.PickupBooking
+	err := r.bookings.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&booking)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrPickupNotFound
+		}
+		return nil, err
+	}
+	return &booking, nil
+}
+
+func (r *MongoPickupRepository) CancelBooking(ctx context.Context, bookingID string) (*models.PickupBooking, error) {
+	return r.cancel(ctx, bson.M{"booking_id": bookingID})
+}
+
+// CancelPackageBooking cancels the package's active booking, if it has one.
+func (r *MongoPickupRepository) CancelPackageBooking(ctx context.Context, packageID string) (*models.PickupBooking, error) {
+	return r.cancel(ctx, bson.M{"package_id": packageID})
+}
+
+func (r *MongoPickupRepository) cancel(ctx context.Context, filter bson.M) (*models.PickupBooking, error) {
+	filter["status"] = models.PickupStatusBooked
+	update := bson.M{
+		"$set": bson.M{
+			"status":     models.PickupStatusCanceled,
+			"updated_at": time.Now(),
+		},
+	}
+
+	var booking models.PickupBooking
+	err := r.bookings.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&booking)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, models.ErrPickupNotFound
+		}
+		return nil, err
+	}
+	return &booking, nil
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoRedemptionRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoRedemptionRepository(db *mongo.Database, collectionName string) *MongoRedemptionRepository {
+	collection := db.Collection(collectionName)
+
+	_, err := collection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create redemption indexes: %v", err))
+	}
+
+	return &MongoRedemptionRepository{collection: collection}
+}
+
+// Redeem records key as used by packageID. The unique key index makes the
+// insert the single point where concurrent redemptions are decided.
+func (r *MongoRedemptionRepository) Redeem(ctx context.Context, key, packageID string, expiresAt time.Time) error {
+	redemption := models.Redemption{
+		Key:        key,
+		PackageID:  packageID,
+		RedeemedAt: time.Now(),
+	}
+	if !expiresAt.IsZero() {
+		redemption.ExpiresAt = &expiresAt
+	}
+
+	if _, err := r.collection.InsertOne(ctx, redemption); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.ErrAlreadyRedeemed
+		}
+		return fmt.Errorf("failed to redeem %s: %w", key, err)
+	}
+	return nil
+}
+
+// Release undoes a redemption, but only the one made for packageID.
+func (r *MongoRedemptionRepository) Release(ctx context.Context, key, packageID string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"key": key, "package_id": packageID})
+	if err != nil {
+		return fmt.Errorf("failed to release %s: %w", key, err)
+	}
+	return nil
+}
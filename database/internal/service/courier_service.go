@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"github.com/maksroxx/DeliveryService/database/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultShiftHours = 8
+	maxShiftHours     = 12
+)
+
+type courierService struct {
+	repo     repository.CourierRepository
+	packages repository.RouteRepository
+	logger   *logrus.Logger
+	now      func() time.Time
+}
+
+func NewCourierService(repo repository.CourierRepository, packages repository.RouteRepository, log *logrus.Logger) *courierService {
+	return &courierService{
+		repo:     repo,
+		packages: packages,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+func (s *courierService) SaveProfile(ctx context.Context, courier *models.Courier) (*models.Courier, error) {
+	if courier.CourierID == "" || courier.Name == "" || courier.City == "" {
+		return nil, errors.New("courier id, name and city are required")
+	}
+	if courier.Vehicle.MaxWeight <= 0 || courier.Vehicle.MaxPackages <= 0 {
+		return nil, errors.New("vehicle capacity must be positive")
+	}
+	return s.repo.SaveProfile(ctx, courier)
+}
+
+func (s *courierService) GetProfile(ctx context.Context, courierID string) (*models.Courier, error) {
+	return s.repo.GetByID(ctx, courierID)
+}
+
+func (s *courierService) StartShift(ctx context.Context, courierID string, hours int) (*models.Courier, error) {
+	if hours <= 0 {
+		hours = defaultShiftHours
+	}
+	if hours > maxShiftHours {
+		return nil, fmt.Errorf("shift cannot be longer than %d hours", maxShiftHours)
+	}
+	now := s.now()
+	return s.repo.SetShift(ctx, courierID, &models.Shift{
+		StartedAt: now,
+		EndsAt:    now.Add(time.Duration(hours) * time.Hour),
+	})
+}
+
+func (s *courierService) EndShift(ctx context.Context, courierID string) (*models.Courier, error) {
+	return s.repo.SetShift(ctx, courierID, nil)
+}
+
+// AssignPackage gives the package to the requested courier, or to the least
+// loaded courier on shift in the package's origin city when none is given.
+func (s *courierService) AssignPackage(ctx context.Context, packageID, courierID string) (*models.Package, error) {
+	pkg, err := s.packages.GetByID(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+	if pkg.Status != models.StatusCreated {
+		return nil, fmt.Errorf("cannot assign package in status %s", pkg.Status)
+	}
+	if pkg.CourierID != "" {
+		return nil, models.ErrPackageAlreadyAssigned
+	}
+
+	if courierID != "" {
+		courier, err := s.repo.GetByID(ctx, courierID)
+		if err != nil {
+			return nil, err
+		}
+		if !courier.Shift.Active(s.now()) {
+			return nil, models.ErrCourierNotOnShift
+		}
+		return s.assign(ctx, pkg, courierID)
+	}
+
+	candidates, err := s.repo.FindAvailable(ctx, pkg.From, pkg.Weight, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find couriers: %w", err)
+	}
+	for _, courier := range candidates {
+		assigned, err := s.assign(ctx, pkg, courier.CourierID)
+		if errors.Is(err, models.ErrCourierOverloaded) {
+			// filled up between the lookup and the reservation, try the next one
+			continue
+		}
+		return assigned, err
+	}
+	return nil, models.ErrNoCourierAvailable
+}
+
+func (s *courierService) assign(ctx context.Context, pkg *models.Package, courierID string) (*models.Package, error) {
+	if err := s.repo.ReserveCapacity(ctx, courierID, pkg.Weight); err != nil {
+		return nil, err
+	}
+	assigned, err := s.packages.AssignCourier(ctx, pkg.PackageID, courierID)
+	if err != nil {
+		s.release(ctx, courierID, pkg.Weight)
+		return nil, err
+	}
+	return assigned, nil
+}
+
+func (s *courierService) GetAssignedPackages(ctx context.Context, courierID, status string, limit, offset int64) ([]*models.Package, error) {
+	return s.packages.GetAllPackages(ctx, models.PackageFilter{
+		CourierID: courierID,
+		Status:    status,
+		Limit:     limit,
+		Offset:    offset,
+	})
+}
+
+func (s *courierService) UpdateDeliveryStatus(ctx context.Context, courierID, packageID, status string) (*models.Package, error) {
+	pkg, err := s.packages.GetByID(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+	if pkg.CourierID != courierID {
+		return nil, models.ErrPackageNotAssigned
+	}
+	if !models.CanCourierTransition(pkg.Status, status) {
+		return nil, fmt.Errorf("%w: %s -> %s", models.ErrInvalidTransition, pkg.Status, status)
+	}
+
+	updated, err := s.packages.TransitionStatus(ctx, packageID, courierID, pkg.Status, status)
+	if err != nil {
+		return nil, err
+	}
+	if models.IsTerminalStatus(status) {
+		s.release(ctx, courierID, pkg.Weight)
+	}
+	return updated, nil
+}
+
+func (s *courierService) release(ctx context.Context, courierID string, weight float64) {
+	if err := s.repo.ReleaseCapacity(ctx, courierID, weight); err != nil {
+		s.logger.WithError(err).Errorf("failed to release capacity of courier %s", courierID)
+	}
+}
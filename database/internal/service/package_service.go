@@ -2,8 +2,11 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,19 +18,29 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// quoteRedemptionGrace keeps a quote's redemption on record a while past the
+// quote's expiry, so clock skew with the calculator can't reopen it.
+const quoteRedemptionGrace = time.Hour
+
 type packageService struct {
-	repo       repository.RouteRepository
-	calculator clients.Calculator
-	producer   kafka.PaymentProducer
-	logger     *logrus.Logger
+	repo        repository.RouteRepository
+	redemptions repository.RedemptionRepository
+	couriers    repository.CourierRepository
+	pickups     repository.PickupRepository
+	calculator  clients.Calculator
+	producer    kafka.PaymentProducer
+	logger      *logrus.Logger
 }
 
-func NewPackageService(repo repository.RouteRepository, calculator clients.Calculator, producer kafka.PaymentProducer, log *logrus.Logger) *packageService {
+func NewPackageService(repo repository.RouteRepository, redemptions repository.RedemptionRepository, couriers repository.CourierRepository, pickups repository.PickupRepository, calculator clients.Calculator, producer kafka.PaymentProducer, log *logrus.Logger) *packageService {
 	return &packageService{
-		repo:       repo,
-		calculator: calculator,
-		producer:   producer,
-		logger:     log,
+		repo:        repo,
+		redemptions: redemptions,
+		couriers:    couriers,
+		pickups:     pickups,
+		calculator:  calculator,
+		producer:    producer,
+		logger:      log,
 	}
 }
 
@@ -49,7 +62,20 @@ func (s *packageService) UpdatePackage(ctx context.Context, packageID string, up
 }
 
 func (s *packageService) DeletePackage(ctx context.Context, packageID string) error {
-	return s.repo.DeletePackage(ctx, packageID)
+	pkg, err := s.repo.GetByID(ctx, packageID)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeletePackage(ctx, packageID); err != nil {
+		return err
+	}
+	if !models.IsTerminalStatus(pkg.Status) {
+		if pkg.CourierID != "" {
+			s.releaseCourier(ctx, pkg)
+		}
+		s.releasePickup(ctx, pkg)
+	}
+	return nil
 }
 
 func (s *packageService) CancelPackage(ctx context.Context, packageID string) (*models.Package, error) {
@@ -63,10 +89,50 @@ func (s *packageService) CancelPackage(ctx context.Context, packageID string) (*
 	if pkg.Status == "Сanceled" {
 		return nil, errors.New("package already canceled")
 	}
-	update := models.PackageUpdate{
-		Status: "Сanceled",
+	if pkg.CourierID == "" {
+		update := models.PackageUpdate{
+			Status: "Сanceled",
+		}
+		canceled, err := s.repo.UpdatePackage(ctx, packageID, update)
+		if err != nil {
+			return nil, err
+		}
+		s.releasePickup(ctx, pkg)
+		return canceled, nil
+	}
+
+	// an assigned package may be delivered meanwhile; only the transition
+	// that wins gives the courier's capacity back
+	canceled, err := s.repo.TransitionStatus(ctx, packageID, pkg.CourierID, pkg.Status, models.StatusCanceled)
+	if err != nil {
+		return nil, err
+	}
+	s.releaseCourier(ctx, pkg)
+	s.releasePickup(ctx, pkg)
+	return canceled, nil
+}
+
+func (s *packageService) releaseCourier(ctx context.Context, pkg *models.Package) {
+	if err := s.couriers.ReleaseCapacity(ctx, pkg.CourierID, pkg.Weight); err != nil {
+		s.logger.WithError(err).Errorf("failed to release capacity of courier %s", pkg.CourierID)
+	}
+}
+
+// releasePickup cancels the package's pickup booking and gives its slot back.
+func (s *packageService) releasePickup(ctx context.Context, pkg *models.Package) {
+	if !pkg.Pickup {
+		return
+	}
+	booking, err := s.pickups.CancelPackageBooking(ctx, pkg.PackageID)
+	if err != nil {
+		if !errors.Is(err, models.ErrPickupNotFound) {
+			s.logger.WithError(err).Errorf("failed to cancel pickup of package %s", pkg.PackageID)
+		}
+		return
+	}
+	if err := s.pickups.ReleaseSlot(ctx, booking.SlotID); err != nil {
+		s.logger.WithError(err).Errorf("failed to release pickup slot %s", booking.SlotID)
 	}
-	return s.repo.UpdatePackage(ctx, packageID, update)
 }
 
 func (s *packageService) GetExpiredPackages(ctx context.Context) ([]*models.Package, error) {
@@ -82,15 +148,62 @@ func (s *packageService) CreatePackageWithCalculation(ctx context.Context, pkg *
 	var err error
 
 	tariff := pkg.TariffCode
-	if tariff == "" {
-		result, err = s.calculator.Calculate(pkg.Weight, pkg.UserID, pkg.From, pkg.To, pkg.Address, pkg.Length, pkg.Width, pkg.Height)
+	switch {
+	case pkg.QuoteID != "":
+		// the quoted price is charged as is, whatever the tariff says now
+		if tariff == "" {
+			tariff = "DEFAULT"
+		}
+		result, err = s.calculator.VerifyQuote(pkg.UserID, &calculatorpb.VerifyQuoteRequest{
+			QuoteId:        pkg.QuoteID,
+			Weight:         pkg.Weight,
+			From:           pkg.From,
+			To:             pkg.To,
+			Length:         int32(pkg.Length),
+			Width:          int32(pkg.Width),
+			Height:         int32(pkg.Height),
+			TariffCode:     tariff,
+			Pickup:         pkg.Pickup,
+			TargetCurrency: pkg.Currency,
+			Category:       pkg.Category,
+		})
+	case tariff == "":
+		result, err = s.calculator.Calculate(pkg.UserID, &calculatorpb.CalculateDeliveryCostRequest{
+			Weight:         pkg.Weight,
+			From:           pkg.From,
+			To:             pkg.To,
+			Address:        pkg.Address,
+			Length:         int32(pkg.Length),
+			Width:          int32(pkg.Width),
+			Height:         int32(pkg.Height),
+			Pickup:         pkg.Pickup,
+			TargetCurrency: pkg.Currency,
+			PromoCode:      pkg.PromoCode,
+			Category:       pkg.Category,
+		})
 		tariff = "DEFAULT"
-	} else {
-		result, err = s.calculator.CalculateByTariff(pkg.Weight, pkg.UserID, pkg.From, pkg.To, pkg.Address, tariff, pkg.Length, pkg.Width, pkg.Height)
+	default:
+		result, err = s.calculator.CalculateByTariff(pkg.UserID, &calculatorpb.CalculateByTariffRequest{
+			Weight:         pkg.Weight,
+			From:           pkg.From,
+			To:             pkg.To,
+			Address:        pkg.Address,
+			Length:         int32(pkg.Length),
+			Width:          int32(pkg.Width),
+			Height:         int32(pkg.Height),
+			TariffCode:     tariff,
+			Pickup:         pkg.Pickup,
+			TargetCurrency: pkg.Currency,
+			PromoCode:      pkg.PromoCode,
+			Category:       pkg.Category,
+		})
 	}
 	if err != nil {
 		return nil, fmt.Errorf("calculation failed: %w", err)
 	}
+	if pkg.PromoCode != "" && !strings.EqualFold(strings.TrimSpace(pkg.PromoCode), result.PromoCode) {
+		return nil, fmt.Errorf("%w: %s", models.ErrPromoRejected, result.PromoRejected)
+	}
 
 	pkg.PackageID = "PKG-" + uuid.New().String()
 	pkg.Status = "Created"
@@ -98,19 +211,56 @@ func (s *packageService) CreatePackageWithCalculation(ctx context.Context, pkg *
 	pkg.Cost = result.Cost
 	pkg.EstimatedHours = int(result.EstimatedHours)
 	pkg.Currency = result.Currency
+	pkg.OriginalCost = result.OriginalCost
+	pkg.OriginalCurrency = result.OriginalCurrency
+	pkg.ExchangeRate = result.ExchangeRate
+	pkg.PromoCode = result.PromoCode
+	pkg.Discount = clients.PromoDiscount(result)
+	pkg.DeliveryFrom, pkg.DeliveryTo = clients.DeliveryWindow(result)
+	pkg.FuelSurchargeRate = result.FuelSurchargeRate
 	pkg.CreatedAt = time.Now()
 	pkg.TariffCode = tariff
+	pkg.TariffVersion = int(result.TariffVersion)
+	pkg.Route = nil
+	for _, leg := range result.Legs {
+		pkg.Route = append(pkg.Route, models.RouteLeg{
+			FromHub:      leg.FromHub,
+			ToHub:        leg.ToHub,
+			DistanceKm:   leg.DistanceKm,
+			TransitHours: leg.TransitHours,
+			Cost:         leg.Cost,
+		})
+	}
+
+	if pkg.QuoteID != "" {
+		if err := s.redeemQuote(ctx, pkg, result); err != nil {
+			return nil, err
+		}
+	}
+	if pkg.PromoCode != "" {
+		if err := s.redeemPromo(ctx, pkg); err != nil {
+			s.releaseQuote(ctx, pkg)
+			return nil, err
+		}
+	}
 
 	created, err := s.repo.Create(ctx, pkg)
 	if err != nil {
+		s.releaseQuote(ctx, pkg)
+		if pkg.PromoCode != "" {
+			s.releasePromo(ctx, pkg)
+		}
 		return nil, err
 	}
 
 	payment := models.Payment{
-		UserID:    pkg.UserID,
-		PackageID: pkg.PackageID,
-		Cost:      pkg.Cost,
-		Currency:  pkg.Currency,
+		UserID:           pkg.UserID,
+		PackageID:        pkg.PackageID,
+		Cost:             pkg.Cost,
+		Currency:         pkg.Currency,
+		OriginalCost:     pkg.OriginalCost,
+		OriginalCurrency: pkg.OriginalCurrency,
+		ExchangeRate:     pkg.ExchangeRate,
 	}
 
 	if err := s.producer.SendPaymentEvent(payment); err != nil {
@@ -120,6 +270,90 @@ func (s *packageService) CreatePackageWithCalculation(ctx context.Context, pkg *
 	return created, nil
 }
 
+// redeemQuote records the quote as spent before the package is stored. The
+// quote_id index on packages alone would free the quote again once the
+// package is deleted or transferred.
+func (s *packageService) redeemQuote(ctx context.Context, pkg *models.Package, result *calculatorpb.CalculateDeliveryCostResponse) error {
+	var expiresAt time.Time
+	if result.QuoteExpiresAt != nil {
+		expiresAt = result.QuoteExpiresAt.AsTime().Add(quoteRedemptionGrace)
+	}
+	err := s.redemptions.Redeem(ctx, quoteKey(pkg.QuoteID), pkg.PackageID, expiresAt)
+	if errors.Is(err, models.ErrAlreadyRedeemed) {
+		return models.ErrQuoteAlreadyUsed
+	}
+	return err
+}
+
+func (s *packageService) releaseQuote(ctx context.Context, pkg *models.Package) {
+	if pkg.QuoteID == "" {
+		return
+	}
+	if err := s.redemptions.Release(ctx, quoteKey(pkg.QuoteID), pkg.PackageID); err != nil {
+		s.logger.WithError(err).Errorf("failed to release quote for %s", pkg.PackageID)
+	}
+}
+
+// quoteKey hashes the quote token, which is long and carries the signed
+// quote, into a fixed-size redemption key.
+func quoteKey(quoteID string) string {
+	sum := sha256.Sum256([]byte(quoteID))
+	return "quote:" + hex.EncodeToString(sum[:])
+}
+
+// redeemPromo books one use of the package's promo code before the package is
+// stored, so concurrent orders can't go over the code's limits.
+func (s *packageService) redeemPromo(ctx context.Context, pkg *models.Package) error {
+	firstOrder, err := s.claimFirstOrder(ctx, pkg)
+	if err != nil {
+		return err
+	}
+	if err := s.calculator.RedeemPromo(pkg.UserID, pkg.PromoCode, pkg.PackageID, pkg.TariffCode, pkg.From, pkg.To, firstOrder); err != nil {
+		s.releaseFirstOrder(ctx, pkg)
+		return fmt.Errorf("%w: %v", models.ErrPromoRejected, err)
+	}
+	return nil
+}
+
+func (s *packageService) releasePromo(ctx context.Context, pkg *models.Package) {
+	if err := s.calculator.ReleasePromo(pkg.UserID, pkg.PromoCode, pkg.PackageID); err != nil {
+		s.logger.WithError(err).Errorf("failed to release promo %s for %s", pkg.PromoCode, pkg.PackageID)
+	}
+	s.releaseFirstOrder(ctx, pkg)
+}
+
+// claimFirstOrder takes the user's first-order record for the package. Only
+// one of two concurrent orders can claim it, so only one of them is priced as
+// the first. Users who ordered before the record existed have none yet, which
+// is why the order history is still checked once the claim succeeds.
+func (s *packageService) claimFirstOrder(ctx context.Context, pkg *models.Package) (bool, error) {
+	err := s.redemptions.Redeem(ctx, firstOrderKey(pkg.UserID), pkg.PackageID, time.Time{})
+	if errors.Is(err, models.ErrAlreadyRedeemed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to check order history: %w", err)
+	}
+
+	previous, err := s.repo.GetAllPackages(ctx, models.PackageFilter{UserID: pkg.UserID, Limit: 1})
+	if err != nil {
+		s.releaseFirstOrder(ctx, pkg)
+		return false, fmt.Errorf("failed to check order history: %w", err)
+	}
+	return len(previous) == 0, nil
+}
+
+// releaseFirstOrder gives the record back only if this package holds it.
+func (s *packageService) releaseFirstOrder(ctx context.Context, pkg *models.Package) {
+	if err := s.redemptions.Release(ctx, firstOrderKey(pkg.UserID), pkg.PackageID); err != nil {
+		s.logger.WithError(err).Errorf("failed to release first order of %s", pkg.UserID)
+	}
+}
+
+func firstOrderKey(userID string) string {
+	return "first_order:" + userID
+}
+
 func (s *packageService) TransferExpiredPackages(ctx context.Context) error {
 	expired, err := s.repo.GetExpiredPackages(ctx)
 	if err != nil {
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/maksroxx/DeliveryService/database/configs"
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"github.com/maksroxx/DeliveryService/database/internal/repository"
+	"github.com/sirupsen/logrus"
+)
+
+const pickupDateLayout = "2006-01-02"
+
+type pickupService struct {
+	repo     repository.PickupRepository
+	packages repository.RouteRepository
+	cfg      configs.PickupConfig
+	logger   *logrus.Logger
+	now      func() time.Time
+}
+
+func NewPickupService(repo repository.PickupRepository, packages repository.RouteRepository, cfg configs.PickupConfig, log *logrus.Logger) *pickupService {
+	if cfg.HorizonDays <= 0 {
+		cfg.HorizonDays = 7
+	}
+	return &pickupService{
+		repo:     repo,
+		packages: packages,
+		cfg:      cfg,
+		logger:   log,
+		now:      time.Now,
+	}
+}
+
+func (s *pickupService) GetAvailableSlots(ctx context.Context, city, date string) ([]models.PickupSlot, error) {
+	if err := s.checkDate(date); err != nil {
+		return nil, err
+	}
+	slots := s.slotsFor(city, date)
+	if len(slots) == 0 {
+		return nil, models.ErrPickupSlotNotFound
+	}
+
+	booked, err := s.repo.GetBookedCounts(ctx, normalizeCity(city), date)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load booked slots: %w", err)
+	}
+	for i := range slots {
+		slots[i].Booked = booked[slots[i].SlotID]
+	}
+	return slots, nil
+}
+
+func (s *pickupService) BookPickup(ctx context.Context, userID, packageID, city, date, start string) (*models.PickupBooking, error) {
+	pkg, err := s.packages.GetByID(ctx, packageID)
+	if err != nil {
+		return nil, err
+	}
+	if pkg.UserID != userID {
+		return nil, errors.New("route not found")
+	}
+	if !pkg.Pickup {
+		return nil, models.ErrPickupNotRequested
+	}
+	if pkg.Status == "Delivered" || pkg.Status == "Сanceled" {
+		return nil, fmt.Errorf("cannot book pickup for package in status %s", pkg.Status)
+	}
+	// the courier collects the parcel where it ships from
+	if !strings.EqualFold(strings.TrimSpace(city), strings.TrimSpace(pkg.From)) {
+		return nil, models.ErrPickupCityMismatch
+	}
+
+	slot, err := s.findSlot(pkg.From, date, start)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReserveSlot(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	booking := &models.PickupBooking{
+		BookingID: "PCK-" + uuid.New().String(),
+		PackageID: packageID,
+		UserID:    userID,
+		SlotID:    slot.SlotID,
+		City:      slot.City,
+		Date:      slot.Date,
+		Start:     slot.Start,
+		End:       slot.End,
+		Status:    models.PickupStatusBooked,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.repo.CreateBooking(ctx, booking); err != nil {
+		s.release(ctx, slot.SlotID)
+		return nil, err
+	}
+	return booking, nil
+}
+
+func (s *pickupService) ReschedulePickup(ctx context.Context, userID, bookingID, date, start string) (*models.PickupBooking, error) {
+	booking, err := s.activeBooking(ctx, userID, bookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	slot, err := s.findSlot(booking.City, date, start)
+	if err != nil {
+		return nil, err
+	}
+	if slot.SlotID == booking.SlotID {
+		return booking, nil
+	}
+
+	// take the new place first so the customer never ends up without a slot
+	if err := s.repo.ReserveSlot(ctx, slot); err != nil {
+		return nil, err
+	}
+	moved, err := s.repo.MoveBooking(ctx, bookingID, booking.SlotID, slot)
+	if err != nil {
+		s.release(ctx, slot.SlotID)
+		return nil, err
+	}
+	s.release(ctx, booking.SlotID)
+	return moved, nil
+}
+
+func (s *pickupService) CancelPickup(ctx context.Context, userID, bookingID string) (*models.PickupBooking, error) {
+	if _, err := s.activeBooking(ctx, userID, bookingID); err != nil {
+		return nil, err
+	}
+	canceled, err := s.repo.CancelBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	s.release(ctx, canceled.SlotID)
+	return canceled, nil
+}
+
+func (s *pickupService) activeBooking(ctx context.Context, userID, bookingID string) (*models.PickupBooking, error) {
+	booking, err := s.repo.GetBooking(ctx, bookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.UserID != userID || booking.Status != models.PickupStatusBooked {
+		return nil, models.ErrPickupNotFound
+	}
+	return booking, nil
+}
+
+func (s *pickupService) release(ctx context.Context, slotID string) {
+	if err := s.repo.ReleaseSlot(ctx, slotID); err != nil {
+		s.logger.WithError(err).Errorf("failed to release pickup slot %s", slotID)
+	}
+}
+
+func (s *pickupService) findSlot(city, date, start string) (models.PickupSlot, error) {
+	if err := s.checkDate(date); err != nil {
+		return models.PickupSlot{}, err
+	}
+	for _, slot := range s.slotsFor(city, date) {
+		if slot.Start == start {
+			return slot, nil
+		}
+	}
+	return models.PickupSlot{}, models.ErrPickupSlotNotFound
+}
+
+func (s *pickupService) checkDate(date string) error {
+	day, err := time.ParseInLocation(pickupDateLayout, date, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid pickup date %q: expected YYYY-MM-DD", date)
+	}
+	now := s.now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if day.Before(today) || day.After(today.AddDate(0, 0, s.cfg.HorizonDays)) {
+		return models.ErrPickupDateOutOfRange
+	}
+	return nil
+}
+
+func (s *pickupService) slotsFor(city, date string) []models.PickupSlot {
+	templates := s.cfg.DefaultSlots
+	for name, citySlots := range s.cfg.Cities {
+		if strings.EqualFold(name, city) {
+			templates = citySlots
+			break
+		}
+	}
+
+	city = normalizeCity(city)
+	slots := make([]models.PickupSlot, 0, len(templates))
+	for _, t := range templates {
+		slots = append(slots, models.PickupSlot{
+			SlotID:   city + "|" + date + "|" + t.Start,
+			City:     city,
+			Date:     date,
+			Start:    t.Start,
+			End:      t.End,
+			Capacity: t.Capacity,
+		})
+	}
+	return slots
+}
+
+func normalizeCity(city string) string {
+	return strings.ToLower(strings.TrimSpace(city))
+}
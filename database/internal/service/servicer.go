@@ -19,3 +19,20 @@ type PackageService interface {
 	CreatePackageWithCalculation(ctx context.Context, req *models.Package) (*models.Package, error)
 	TransferExpiredPackages(ctx context.Context) error
 }
+
+type PickupService interface {
+	GetAvailableSlots(ctx context.Context, city, date string) ([]models.PickupSlot, error)
+	BookPickup(ctx context.Context, userID, packageID, city, date, start string) (*models.PickupBooking, error)
+	ReschedulePickup(ctx context.Context, userID, bookingID, date, start string) (*models.PickupBooking, error)
+	CancelPickup(ctx context.Context, userID, bookingID string) (*models.PickupBooking, error)
+}
+
+type CourierService interface {
+	SaveProfile(ctx context.Context, courier *models.Courier) (*models.Courier, error)
+	GetProfile(ctx context.Context, courierID string) (*models.Courier, error)
+	StartShift(ctx context.Context, courierID string, hours int) (*models.Courier, error)
+	EndShift(ctx context.Context, courierID string) (*models.Courier, error)
+	AssignPackage(ctx context.Context, packageID, courierID string) (*models.Package, error)
+	GetAssignedPackages(ctx context.Context, courierID, status string, limit, offset int64) ([]*models.Package, error)
+	UpdateDeliveryStatus(ctx context.Context, courierID, packageID, status string) (*models.Package, error)
+}
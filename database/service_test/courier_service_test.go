@@ -0,0 +1,206 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"github.com/maksroxx/DeliveryService/database/internal/service"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCourierRepository struct {
+	mock.Mock
+}
+
+func (m *MockCourierRepository) SaveProfile(ctx context.Context, courier *models.Courier) (*models.Courier, error) {
+	args := m.Called(ctx, courier)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) GetByID(ctx context.Context, courierID string) (*models.Courier, error) {
+	args := m.Called(ctx, courierID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) SetShift(ctx context.Context, courierID string, shift *models.Shift) (*models.Courier, error) {
+	args := m.Called(ctx, courierID, shift)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) FindAvailable(ctx context.Context, city string, weight float64, now time.Time) ([]*models.Courier, error) {
+	args := m.Called(ctx, city, weight, now)
+	return args.Get(0).([]*models.Courier), args.Error(1)
+}
+
+func (m *MockCourierRepository) ReserveCapacity(ctx context.Context, courierID string, weight float64) error {
+	args := m.Called(ctx, courierID, weight)
+	return args.Error(0)
+}
+
+func (m *MockCourierRepository) ReleaseCapacity(ctx context.Context, courierID string, weight float64) error {
+	args := m.Called(ctx, courierID, weight)
+	return args.Error(0)
+}
+
+func TestCourierService_AssignPackage(t *testing.T) {
+	pkg := &models.Package{PackageID: "pkg-1", From: "Germany", Weight: 4, Status: models.StatusCreated}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(c *MockCourierRepository, r *MockRouteRepository)
+		expectedID    string
+		expectedError error
+	}{
+		{
+			name: "least loaded courier gets the package",
+			setupMocks: func(c *MockCourierRepository, r *MockRouteRepository) {
+				c.On("FindAvailable", mock.Anything, "Germany", 4.0, mock.Anything).
+					Return([]*models.Courier{{CourierID: "c-1"}, {CourierID: "c-2"}}, nil)
+				c.On("ReserveCapacity", mock.Anything, "c-1", 4.0).Return(nil)
+				r.On("AssignCourier", mock.Anything, "pkg-1", "c-1").Return(&models.Package{PackageID: "pkg-1", CourierID: "c-1"}, nil)
+			},
+			expectedID: "c-1",
+		},
+		{
+			name: "falls through to the next courier when the first fills up",
+			setupMocks: func(c *MockCourierRepository, r *MockRouteRepository) {
+				c.On("FindAvailable", mock.Anything, "Germany", 4.0, mock.Anything).
+					Return([]*models.Courier{{CourierID: "c-1"}, {CourierID: "c-2"}}, nil)
+				c.On("ReserveCapacity", mock.Anything, "c-1", 4.0).Return(models.ErrCourierOverloaded)
+				c.On("ReserveCapacity", mock.Anything, "c-2", 4.0).Return(nil)
+				r.On("AssignCourier", mock.Anything, "pkg-1", "c-2").Return(&models.Package{PackageID: "pkg-1", CourierID: "c-2"}, nil)
+			},
+			expectedID: "c-2",
+		},
+		{
+			name: "no courier on shift",
+			setupMocks: func(c *MockCourierRepository, r *MockRouteRepository) {
+				c.On("FindAvailable", mock.Anything, "Germany", 4.0, mock.Anything).Return([]*models.Courier{}, nil)
+			},
+			expectedError: models.ErrNoCourierAvailable,
+		},
+		{
+			name: "capacity is released when the package was taken concurrently",
+			setupMocks: func(c *MockCourierRepository, r *MockRouteRepository) {
+				c.On("FindAvailable", mock.Anything, "Germany", 4.0, mock.Anything).
+					Return([]*models.Courier{{CourierID: "c-1"}}, nil)
+				c.On("ReserveCapacity", mock.Anything, "c-1", 4.0).Return(nil)
+				r.On("AssignCourier", mock.Anything, "pkg-1", "c-1").Return(nil, models.ErrPackageAlreadyAssigned)
+				c.On("ReleaseCapacity", mock.Anything, "c-1", 4.0).Return(nil)
+			},
+			expectedError: models.ErrPackageAlreadyAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCourier := new(MockCourierRepository)
+			mockRepo := new(MockRouteRepository)
+			courierService := service.NewCourierService(mockCourier, mockRepo, logrus.New())
+
+			mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(pkg, nil)
+			tt.setupMocks(mockCourier, mockRepo)
+
+			assigned, err := courierService.AssignPackage(context.Background(), "pkg-1", "")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, assigned)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, assigned.CourierID)
+			}
+			mockCourier.AssertExpectations(t)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCourierService_AssignPackage_CourierOffShift(t *testing.T) {
+	mockCourier := new(MockCourierRepository)
+	mockRepo := new(MockRouteRepository)
+	courierService := service.NewCourierService(mockCourier, mockRepo, logrus.New())
+
+	mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(&models.Package{PackageID: "pkg-1", Status: models.StatusCreated}, nil)
+	mockCourier.On("GetByID", mock.Anything, "c-1").Return(&models.Courier{CourierID: "c-1"}, nil)
+
+	_, err := courierService.AssignPackage(context.Background(), "pkg-1", "c-1")
+	assert.ErrorIs(t, err, models.ErrCourierNotOnShift)
+}
+
+func TestCourierService_UpdateDeliveryStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		pkg           *models.Package
+		status        string
+		setupMocks    func(c *MockCourierRepository, r *MockRouteRepository)
+		expectedError error
+	}{
+		{
+			name:   "picked up",
+			pkg:    &models.Package{PackageID: "pkg-1", CourierID: "c-1", Status: models.StatusCreated, Weight: 2},
+			status: models.StatusPickedUp,
+			setupMocks: func(c *MockCourierRepository, r *MockRouteRepository) {
+				r.On("TransitionStatus", mock.Anything, "pkg-1", "c-1", models.StatusCreated, models.StatusPickedUp).
+					Return(&models.Package{PackageID: "pkg-1", Status: models.StatusPickedUp}, nil)
+			},
+		},
+		{
+			name:   "delivered frees the courier",
+			pkg:    &models.Package{PackageID: "pkg-1", CourierID: "c-1", Status: models.StatusInTransit, Weight: 2},
+			status: models.StatusDelivered,
+			setupMocks: func(c *MockCourierRepository, r *MockRouteRepository) {
+				r.On("TransitionStatus", mock.Anything, "pkg-1", "c-1", models.StatusInTransit, models.StatusDelivered).
+					Return(&models.Package{PackageID: "pkg-1", Status: models.StatusDelivered}, nil)
+				c.On("ReleaseCapacity", mock.Anything, "c-1", 2.0).Return(nil)
+			},
+		},
+		{
+			name:          "cannot skip a step",
+			pkg:           &models.Package{PackageID: "pkg-1", CourierID: "c-1", Status: models.StatusCreated},
+			status:        models.StatusDelivered,
+			setupMocks:    func(c *MockCourierRepository, r *MockRouteRepository) {},
+			expectedError: models.ErrInvalidTransition,
+		},
+		{
+			name:          "package of another courier",
+			pkg:           &models.Package{PackageID: "pkg-1", CourierID: "c-2", Status: models.StatusCreated},
+			status:        models.StatusPickedUp,
+			setupMocks:    func(c *MockCourierRepository, r *MockRouteRepository) {},
+			expectedError: models.ErrPackageNotAssigned,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCourier := new(MockCourierRepository)
+			mockRepo := new(MockRouteRepository)
+			courierService := service.NewCourierService(mockCourier, mockRepo, logrus.New())
+
+			mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(tt.pkg, nil)
+			tt.setupMocks(mockCourier, mockRepo)
+
+			updated, err := courierService.UpdateDeliveryStatus(context.Background(), "c-1", "pkg-1", tt.status)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.status, updated.Status)
+			}
+			mockCourier.AssertExpectations(t)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
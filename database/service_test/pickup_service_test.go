@@ -0,0 +1,223 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/maksroxx/DeliveryService/database/configs"
+	"github.com/maksroxx/DeliveryService/database/internal/models"
+	"github.com/maksroxx/DeliveryService/database/internal/service"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPickupRepository struct {
+	mock.Mock
+}
+
+func (m *MockPickupRepository) ReserveSlot(ctx context.Context, slot models.PickupSlot) error {
+	args := m.Called(ctx, slot)
+	return args.Error(0)
+}
+
+func (m *MockPickupRepository) ReleaseSlot(ctx context.Context, slotID string) error {
+	args := m.Called(ctx, slotID)
+	return args.Error(0)
+}
+
+func (m *MockPickupRepository) GetBookedCounts(ctx context.Context, city, date string) (map[string]int, error) {
+	args := m.Called(ctx, city, date)
+	return args.Get(0).(map[string]int), args.Error(1)
+}
+
+func (m *MockPickupRepository) CreateBooking(ctx context.Context, booking *models.PickupBooking) error {
+	args := m.Called(ctx, booking)
+	return args.Error(0)
+}
+
+func (m *MockPickupRepository) GetBooking(ctx context.Context, bookingID string) (*models.PickupBooking, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PickupBooking), args.Error(1)
+}
+
+func (m *MockPickupRepository) MoveBooking(ctx context.Context, bookingID, fromSlotID string, slot models.PickupSlot) (*models.PickupBooking, error) {
+	args := m.Called(ctx, bookingID, fromSlotID, slot)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PickupBooking), args.Error(1)
+}
+
+func (m *MockPickupRepository) CancelBooking(ctx context.Context, bookingID string) (*models.PickupBooking, error) {
+	args := m.Called(ctx, bookingID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PickupBooking), args.Error(1)
+}
+
+func (m *MockPickupRepository) CancelPackageBooking(ctx context.Context, packageID string) (*models.PickupBooking, error) {
+	args := m.Called(ctx, packageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.PickupBooking), args.Error(1)
+}
+
+func pickupConfig() configs.PickupConfig {
+	return configs.PickupConfig{
+		HorizonDays: 7,
+		DefaultSlots: []configs.PickupSlotConfig{
+			{Start: "09:00", End: "12:00", Capacity: 2},
+			{Start: "12:00", End: "15:00", Capacity: 2},
+		},
+		Cities: map[string][]configs.PickupSlotConfig{
+			"Russia": {{Start: "08:00", End: "10:00", Capacity: 5}},
+		},
+	}
+}
+
+func TestPickupService_GetAvailableSlots(t *testing.T) {
+	mockPickup := new(MockPickupRepository)
+	mockRepo := new(MockRouteRepository)
+	pickupService := service.NewPickupService(mockPickup, mockRepo, pickupConfig(), logrus.New())
+
+	date := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	mockPickup.On("GetBookedCounts", mock.Anything, "germany", date).
+		Return(map[string]int{"germany|" + date + "|09:00": 2}, nil)
+
+	slots, err := pickupService.GetAvailableSlots(context.Background(), "Germany", date)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 2)
+	assert.Equal(t, 0, slots[0].Available())
+	assert.Equal(t, 2, slots[1].Available())
+
+	mockPickup.On("GetBookedCounts", mock.Anything, "russia", date).Return(map[string]int{}, nil)
+	slots, err = pickupService.GetAvailableSlots(context.Background(), "russia", date)
+	assert.NoError(t, err)
+	assert.Len(t, slots, 1)
+	assert.Equal(t, "08:00", slots[0].Start)
+
+	_, err = pickupService.GetAvailableSlots(context.Background(), "Germany", time.Now().AddDate(0, 0, 30).Format("2006-01-02"))
+	assert.ErrorIs(t, err, models.ErrPickupDateOutOfRange)
+}
+
+func TestPickupService_BookPickup(t *testing.T) {
+	date := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	slotID := "germany|" + date + "|09:00"
+
+	tests := []struct {
+		name          string
+		pkg           *models.Package
+		setupMocks    func(p *MockPickupRepository)
+		expectedError error
+	}{
+		{
+			name: "successful booking",
+			pkg:  &models.Package{PackageID: "pkg-1", UserID: "user-1", From: "Germany", Pickup: true, Status: "Created"},
+			setupMocks: func(p *MockPickupRepository) {
+				p.On("ReserveSlot", mock.Anything, mock.MatchedBy(func(s models.PickupSlot) bool { return s.SlotID == slotID })).Return(nil)
+				p.On("CreateBooking", mock.Anything, mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:          "package without pickup",
+			pkg:           &models.Package{PackageID: "pkg-1", UserID: "user-1", Status: "Created"},
+			setupMocks:    func(p *MockPickupRepository) {},
+			expectedError: models.ErrPickupNotRequested,
+		},
+		{
+			name:          "city is not the package origin",
+			pkg:           &models.Package{PackageID: "pkg-1", UserID: "user-1", From: "France", Pickup: true, Status: "Created"},
+			setupMocks:    func(p *MockPickupRepository) {},
+			expectedError: models.ErrPickupCityMismatch,
+		},
+		{
+			name: "slot is full",
+			pkg:  &models.Package{PackageID: "pkg-1", UserID: "user-1", From: "Germany", Pickup: true, Status: "Created"},
+			setupMocks: func(p *MockPickupRepository) {
+				p.On("ReserveSlot", mock.Anything, mock.Anything).Return(models.ErrPickupSlotFull)
+			},
+			expectedError: models.ErrPickupSlotFull,
+		},
+		{
+			name: "package already has a booking",
+			pkg:  &models.Package{PackageID: "pkg-1", UserID: "user-1", From: "Germany", Pickup: true, Status: "Created"},
+			setupMocks: func(p *MockPickupRepository) {
+				p.On("ReserveSlot", mock.Anything, mock.Anything).Return(nil)
+				p.On("CreateBooking", mock.Anything, mock.Anything).Return(models.ErrPickupAlreadyBooked)
+				p.On("ReleaseSlot", mock.Anything, slotID).Return(nil)
+			},
+			expectedError: models.ErrPickupAlreadyBooked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPickup := new(MockPickupRepository)
+			mockRepo := new(MockRouteRepository)
+			pickupService := service.NewPickupService(mockPickup, mockRepo, pickupConfig(), logrus.New())
+
+			mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(tt.pkg, nil)
+			tt.setupMocks(mockPickup)
+
+			booking, err := pickupService.BookPickup(context.Background(), "user-1", "pkg-1", "Germany", date, "09:00")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, booking)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, slotID, booking.SlotID)
+				assert.Equal(t, models.PickupStatusBooked, booking.Status)
+			}
+			mockPickup.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPickupService_ReschedulePickup(t *testing.T) {
+	mockPickup := new(MockPickupRepository)
+	mockRepo := new(MockRouteRepository)
+	pickupService := service.NewPickupService(mockPickup, mockRepo, pickupConfig(), logrus.New())
+
+	date := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	oldSlot := "germany|" + date + "|09:00"
+	newSlot := "germany|" + date + "|12:00"
+	booking := &models.PickupBooking{BookingID: "b-1", UserID: "user-1", SlotID: oldSlot, City: "germany", Status: models.PickupStatusBooked}
+
+	mockPickup.On("GetBooking", mock.Anything, "b-1").Return(booking, nil)
+	mockPickup.On("ReserveSlot", mock.Anything, mock.MatchedBy(func(s models.PickupSlot) bool { return s.SlotID == newSlot })).Return(nil)
+	mockPickup.On("MoveBooking", mock.Anything, "b-1", oldSlot, mock.Anything).
+		Return(&models.PickupBooking{BookingID: "b-1", SlotID: newSlot, Status: models.PickupStatusBooked}, nil)
+	mockPickup.On("ReleaseSlot", mock.Anything, oldSlot).Return(nil)
+
+	moved, err := pickupService.ReschedulePickup(context.Background(), "user-1", "b-1", date, "12:00")
+	assert.NoError(t, err)
+	assert.Equal(t, newSlot, moved.SlotID)
+	mockPickup.AssertExpectations(t)
+
+	_, err = pickupService.ReschedulePickup(context.Background(), "user-2", "b-1", date, "12:00")
+	assert.ErrorIs(t, err, models.ErrPickupNotFound)
+}
+
+func TestPickupService_CancelPickup(t *testing.T) {
+	mockPickup := new(MockPickupRepository)
+	mockRepo := new(MockRouteRepository)
+	pickupService := service.NewPickupService(mockPickup, mockRepo, pickupConfig(), logrus.New())
+
+	booking := &models.PickupBooking{BookingID: "b-1", UserID: "user-1", SlotID: "slot-1", Status: models.PickupStatusBooked}
+	mockPickup.On("GetBooking", mock.Anything, "b-1").Return(booking, nil)
+	mockPickup.On("CancelBooking", mock.Anything, "b-1").
+		Return(&models.PickupBooking{BookingID: "b-1", SlotID: "slot-1", Status: models.PickupStatusCanceled}, nil)
+	mockPickup.On("ReleaseSlot", mock.Anything, "slot-1").Return(nil)
+
+	canceled, err := pickupService.CancelPickup(context.Background(), "user-1", "b-1")
+	assert.NoError(t, err)
+	assert.Equal(t, models.PickupStatusCanceled, canceled.Status)
+	mockPickup.AssertExpectations(t)
+}
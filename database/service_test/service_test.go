@@ -3,7 +3,9 @@ package service_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/database/internal/models"
 	"github.com/maksroxx/DeliveryService/database/internal/service"
@@ -11,6 +13,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type MockRouteRepository struct {
@@ -64,31 +68,84 @@ func (m *MockRouteRepository) DeletePackage(ctx context.Context, id string) erro
 	return args.Error(0)
 }
 
+func (m *MockRouteRepository) AssignCourier(ctx context.Context, packageID, courierID string) (*models.Package, error) {
+	args := m.Called(ctx, packageID, courierID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Package), args.Error(1)
+}
+
+func (m *MockRouteRepository) TransitionStatus(ctx context.Context, packageID, courierID, from, to string) (*models.Package, error) {
+	args := m.Called(ctx, packageID, courierID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Package), args.Error(1)
+}
+
 func (m *MockRouteRepository) Ping(ctx context.Context) error {
 	args := m.Called(ctx)
 	return args.Error(0)
 }
 
+type MockRedemptionRepository struct {
+	mock.Mock
+}
+
+func (m *MockRedemptionRepository) Redeem(ctx context.Context, key, packageID string, expiresAt time.Time) error {
+	args := m.Called(ctx, key, packageID, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockRedemptionRepository) Release(ctx context.Context, key, packageID string) error {
+	args := m.Called(ctx, key, packageID)
+	return args.Error(0)
+}
+
 type MockCalculator struct {
 	mock.Mock
 }
 
-func (m *MockCalculator) Calculate(weight float64, userID, from, to, address string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error) {
-	args := m.Called(weight, userID, from, to, address, length, width, height)
+func (m *MockCalculator) Calculate(userID string, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+	args := m.Called(userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*calculatorpb.CalculateDeliveryCostResponse), args.Error(1)
+}
+
+func (m *MockCalculator) CalculateByTariff(userID string, req *calculatorpb.CalculateByTariffRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+	args := m.Called(userID, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*calculatorpb.CalculateDeliveryCostResponse), args.Error(1)
 }
 
-func (m *MockCalculator) CalculateByTariff(weight float64, userID, from, to, address, tariffCode string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error) {
-	args := m.Called(weight, userID, from, to, address, tariffCode, length, width, height)
+func (m *MockCalculator) VerifyQuote(userID string, req *calculatorpb.VerifyQuoteRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+	args := m.Called(userID, req)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*calculatorpb.CalculateDeliveryCostResponse), args.Error(1)
 }
 
+// sameRequest matches a calculator request by its fields.
+func sameRequest[T proto.Message](want T) any {
+	return mock.MatchedBy(func(got T) bool { return proto.Equal(want, got) })
+}
+
+func (m *MockCalculator) RedeemPromo(userID, code, packageID, tariffCode, from, to string, firstOrder bool) error {
+	args := m.Called(userID, code, packageID, tariffCode, from, to, firstOrder)
+	return args.Error(0)
+}
+
+func (m *MockCalculator) ReleasePromo(userID, code, packageID string) error {
+	args := m.Called(userID, code, packageID)
+	return args.Error(0)
+}
+
 type MockPaymentProducer struct {
 	mock.Mock
 }
@@ -109,7 +166,7 @@ func TestPackageService_GetPackageByID(t *testing.T) {
 	mockProducer := new(MockPaymentProducer)
 	logger := logrus.New()
 
-	packageService := service.NewPackageService(mockRepo, mockCalc, mockProducer, logger)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logger)
 
 	tests := []struct {
 		name           string
@@ -164,7 +221,7 @@ func TestPackageService_CreatePackage(t *testing.T) {
 	mockProducer := new(MockPaymentProducer)
 	logger := logrus.New()
 
-	packageService := service.NewPackageService(mockRepo, mockCalc, mockProducer, logger)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logger)
 
 	testPackage := &models.Package{
 		PackageID: "test-package-1",
@@ -210,13 +267,361 @@ func TestPackageService_CreatePackage(t *testing.T) {
 	}
 }
 
+func TestPackageService_CreatePackageWithCalculation_StoresRoute(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:           570,
+		EstimatedHours: 10,
+		Currency:       "EUR",
+		TariffVersion:  2,
+		Legs: []*calculatorpb.RouteLeg{
+			{FromHub: "PAR", ToHub: "BRU", DistanceKm: 264, TransitHours: 4, Cost: 30},
+			{FromHub: "BRU", ToHub: "LON", DistanceKm: 320, TransitHours: 6, Cost: 40},
+		},
+	}, nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Package")).Return(&models.Package{}, nil)
+	mockProducer.On("SendPaymentEvent", mock.Anything).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.RouteLeg{
+		{FromHub: "PAR", ToHub: "BRU", DistanceKm: 264, TransitHours: 4, Cost: 30},
+		{FromHub: "BRU", ToHub: "LON", DistanceKm: 320, TransitHours: 6, Cost: 40},
+	}, pkg.Route)
+	assert.Equal(t, 2, pkg.TariffVersion)
+	mockCalc.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_SendsCategory(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, new(MockPaymentProducer), logrus.New())
+
+	priced := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", Category: "batteries"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST", Category: "batteries"})).
+		Return(nil, errors.New("tariff FAST does not accept the parcel: category \"batteries\" is prohibited"))
+	quoted := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", QuoteID: "quote-token", Category: "batteries"}
+	mockCalc.On("VerifyQuote", "test-user", sameRequest(&calculatorpb.VerifyQuoteRequest{QuoteId: "quote-token", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", Category: "batteries"})).
+		Return(nil, errors.New("quote does not match the parcel"))
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), priced)
+	assert.Error(t, err)
+	_, err = packageService.CreatePackageWithCalculation(context.Background(), quoted)
+	assert.Error(t, err)
+
+	mockCalc.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPackageService_CreatePackageWithCalculation_ChargesQuote(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockRedemptions := new(MockRedemptionRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, mockRedemptions, new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	expiresAt := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", QuoteID: "quote-token"}
+	mockCalc.On("VerifyQuote", "test-user", sameRequest(&calculatorpb.VerifyQuoteRequest{QuoteId: "quote-token", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:           432.1,
+		EstimatedHours: 12,
+		Currency:       "EUR",
+		TariffVersion:  1,
+		QuoteExpiresAt: timestamppb.New(expiresAt),
+	}, nil)
+	mockRedemptions.On("Redeem", mock.Anything, mock.MatchedBy(func(key string) bool {
+		return strings.HasPrefix(key, "quote:") && !strings.Contains(key, "quote-token")
+	}), mock.AnythingOfType("string"), expiresAt.Add(time.Hour)).Return(nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Package")).Return(&models.Package{}, nil)
+	mockProducer.On("SendPaymentEvent", mock.MatchedBy(func(p models.Payment) bool {
+		return p.Cost == 432.1 && p.Currency == "EUR"
+	})).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 432.1, pkg.Cost)
+	assert.Equal(t, 12, pkg.EstimatedHours)
+	mockCalc.AssertNotCalled(t, "CalculateByTariff", mock.Anything, mock.Anything)
+	mockProducer.AssertExpectations(t)
+	mockRedemptions.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_QuoteAlreadyRedeemed(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockRedemptions := new(MockRedemptionRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, mockRedemptions, new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", QuoteID: "quote-token"}
+	mockCalc.On("VerifyQuote", "test-user", sameRequest(&calculatorpb.VerifyQuoteRequest{QuoteId: "quote-token", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:     432.1,
+		Currency: "EUR",
+	}, nil)
+	mockRedemptions.On("Redeem", mock.Anything, mock.Anything, mock.Anything, time.Time{}).Return(models.ErrAlreadyRedeemed)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.ErrorIs(t, err, models.ErrQuoteAlreadyUsed)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockProducer.AssertNotCalled(t, "SendPaymentEvent", mock.Anything)
+}
+
+func TestPackageService_CreatePackageWithCalculation_ReleasesQuoteOnCreateError(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockRedemptions := new(MockRedemptionRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, mockRedemptions, new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", QuoteID: "quote-token"}
+	mockCalc.On("VerifyQuote", "test-user", sameRequest(&calculatorpb.VerifyQuoteRequest{QuoteId: "quote-token", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:     432.1,
+		Currency: "EUR",
+	}, nil)
+	mockRedemptions.On("Redeem", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Package")).Return(nil, errors.New("create failed"))
+	mockRedemptions.On("Release", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.Error(t, err)
+	mockRedemptions.AssertCalled(t, "Release", mock.Anything, mock.Anything, pkg.PackageID)
+}
+
+func TestPackageService_CreatePackageWithCalculation_RejectedQuote(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 5, From: "France", To: "UK", TariffCode: "FAST", QuoteID: "quote-token"}
+	mockCalc.On("VerifyQuote", "test-user", sameRequest(&calculatorpb.VerifyQuoteRequest{QuoteId: "quote-token", Weight: 5, From: "France", To: "UK", TariffCode: "FAST"})).Return(nil, errors.New("quote does not match the parcel"))
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPackageService_CreatePackageWithCalculation_TargetCurrency(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "Moscow", To: "Kazan", TariffCode: "FAST", Currency: "USD"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "Moscow", To: "Kazan", TariffCode: "FAST", TargetCurrency: "USD"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:             12.5,
+		Currency:         "USD",
+		OriginalCost:     1000,
+		OriginalCurrency: "RUB",
+		ExchangeRate:     0.0125,
+	}, nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Package")).Return(&models.Package{}, nil)
+	mockProducer.On("SendPaymentEvent", mock.MatchedBy(func(p models.Payment) bool {
+		return p.Cost == 12.5 && p.Currency == "USD" && p.OriginalCost == 1000 && p.OriginalCurrency == "RUB" && p.ExchangeRate == 0.0125
+	})).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", pkg.Currency)
+	assert.Equal(t, 12.5, pkg.Cost)
+	assert.Equal(t, 1000.0, pkg.OriginalCost)
+	assert.Equal(t, "RUB", pkg.OriginalCurrency)
+	assert.Equal(t, 0.0125, pkg.ExchangeRate)
+	mockCalc.AssertExpectations(t)
+	mockProducer.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_RedeemsPromo(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockRedemptions := new(MockRedemptionRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, mockRedemptions, new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "welcome10"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "welcome10"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:      450,
+		Currency:  "EUR",
+		PromoCode: "WELCOME10",
+		LineItems: []*calculatorpb.LineItem{
+			{Code: "base", Amount: 500},
+			{Code: "promo", Amount: -50},
+		},
+	}, nil)
+	mockRedemptions.On("Redeem", mock.Anything, "first_order:test-user", mock.AnythingOfType("string"), time.Time{}).Return(nil)
+	mockRepo.On("GetAllPackages", mock.Anything, models.PackageFilter{UserID: "test-user", Limit: 1}).Return([]*models.Package{}, nil)
+	mockCalc.On("RedeemPromo", "test-user", "WELCOME10", mock.AnythingOfType("string"), "FAST", "France", "UK", true).Return(nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Package")).Return(&models.Package{}, nil)
+	mockProducer.On("SendPaymentEvent", mock.MatchedBy(func(p models.Payment) bool {
+		return p.Cost == 450
+	})).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "WELCOME10", pkg.PromoCode)
+	assert.Equal(t, 50.0, pkg.Discount)
+	mockCalc.AssertExpectations(t)
+	mockProducer.AssertExpectations(t)
+	mockRedemptions.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_FirstOrderTakenConcurrently(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockRedemptions := new(MockRedemptionRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, mockRedemptions, new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	// the other first order hasn't been stored yet, but it holds the record
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "WELCOME10"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "WELCOME10"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:      450,
+		Currency:  "EUR",
+		PromoCode: "WELCOME10",
+	}, nil)
+	mockRedemptions.On("Redeem", mock.Anything, "first_order:test-user", mock.AnythingOfType("string"), time.Time{}).Return(models.ErrAlreadyRedeemed)
+	mockCalc.On("RedeemPromo", "test-user", "WELCOME10", mock.AnythingOfType("string"), "FAST", "France", "UK", false).Return(errors.New("promo code is for first orders only"))
+	mockRedemptions.On("Release", mock.Anything, "first_order:test-user", mock.AnythingOfType("string")).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.ErrorIs(t, err, models.ErrPromoRejected)
+	mockRepo.AssertNotCalled(t, "GetAllPackages", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	mockCalc.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_StoresDeliveryWindow(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	from := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC)
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:           500,
+		Currency:       "EUR",
+		EstimatedHours: 20,
+		DeliveryFrom:   timestamppb.New(from),
+		DeliveryTo:     timestamppb.New(to),
+	}, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *models.Package) bool {
+		return p.DeliveryFrom != nil && p.DeliveryFrom.Equal(from) && p.DeliveryTo != nil && p.DeliveryTo.Equal(to)
+	})).Return(&models.Package{}, nil)
+	mockProducer.On("SendPaymentEvent", mock.Anything).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_StoresFuelSurchargeRate(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:              560,
+		Currency:          "EUR",
+		FuelSurchargeRate: 12,
+	}, nil)
+	mockRepo.On("Create", mock.Anything, mock.MatchedBy(func(p *models.Package) bool {
+		return p.FuelSurchargeRate == 12 && p.Cost == 560
+	})).Return(&models.Package{}, nil)
+	mockProducer.On("SendPaymentEvent", mock.Anything).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPackageService_CreatePackageWithCalculation_PromoRejected(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "EXPIRED"}
+	mockCalc.On("CalculateByTariff", "test-user", sameRequest(&calculatorpb.CalculateByTariffRequest{Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "EXPIRED"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:          500,
+		Currency:      "EUR",
+		PromoRejected: "promo code is not applicable",
+	}, nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.ErrorIs(t, err, models.ErrPromoRejected)
+	mockCalc.AssertNotCalled(t, "RedeemPromo", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPackageService_CreatePackageWithCalculation_ReleasesPromoOnFailure(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockRedemptions := new(MockRedemptionRepository)
+	mockCalc := new(MockCalculator)
+	mockProducer := new(MockPaymentProducer)
+
+	packageService := service.NewPackageService(mockRepo, mockRedemptions, new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logrus.New())
+
+	pkg := &models.Package{UserID: "test-user", Weight: 2, From: "France", To: "UK", TariffCode: "FAST", PromoCode: "SPRING", QuoteID: "quote-token"}
+	mockCalc.On("VerifyQuote", "test-user", sameRequest(&calculatorpb.VerifyQuoteRequest{QuoteId: "quote-token", Weight: 2, From: "France", To: "UK", TariffCode: "FAST"})).Return(&calculatorpb.CalculateDeliveryCostResponse{
+		Cost:      400,
+		Currency:  "EUR",
+		PromoCode: "SPRING",
+	}, nil)
+	mockRedemptions.On("Redeem", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockRedemptions.On("Release", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetAllPackages", mock.Anything, mock.Anything).Return([]*models.Package{{PackageID: "PKG-1"}}, nil)
+	mockCalc.On("RedeemPromo", "test-user", "SPRING", mock.AnythingOfType("string"), "FAST", "France", "UK", false).Return(nil)
+	mockRepo.On("Create", mock.Anything, mock.AnythingOfType("*models.Package")).Return(nil, models.ErrQuoteAlreadyUsed)
+	mockCalc.On("ReleasePromo", "test-user", "SPRING", mock.AnythingOfType("string")).Return(nil)
+
+	_, err := packageService.CreatePackageWithCalculation(context.Background(), pkg)
+
+	assert.ErrorIs(t, err, models.ErrQuoteAlreadyUsed)
+	mockCalc.AssertExpectations(t)
+	mockRedemptions.AssertExpectations(t)
+	mockRedemptions.AssertCalled(t, "Release", mock.Anything, "first_order:test-user", pkg.PackageID)
+	mockProducer.AssertNotCalled(t, "SendPaymentEvent", mock.Anything)
+}
+
 func TestPackageService_CancelPackage(t *testing.T) {
 	mockRepo := new(MockRouteRepository)
 	mockCalc := new(MockCalculator)
 	mockProducer := new(MockPaymentProducer)
 	logger := logrus.New()
 
-	packageService := service.NewPackageService(mockRepo, mockCalc, mockProducer, logger)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), new(MockPickupRepository), mockCalc, mockProducer, logger)
 
 	tests := []struct {
 		name          string
@@ -300,3 +705,87 @@ func TestPackageService_CancelPackage(t *testing.T) {
 		})
 	}
 }
+
+func TestPackageService_CancelPackage_ReleasesCourier(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCouriers := new(MockCourierRepository)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), mockCouriers, new(MockPickupRepository), new(MockCalculator), new(MockPaymentProducer), logrus.New())
+
+	pkg := &models.Package{PackageID: "pkg-1", CourierID: "courier-1", Weight: 4, Status: models.StatusPickedUp}
+	mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(pkg, nil)
+	mockRepo.On("TransitionStatus", mock.Anything, "pkg-1", "courier-1", models.StatusPickedUp, models.StatusCanceled).
+		Return(&models.Package{PackageID: "pkg-1", Status: models.StatusCanceled}, nil)
+	mockCouriers.On("ReleaseCapacity", mock.Anything, "courier-1", 4.0).Return(nil)
+
+	canceled, err := packageService.CancelPackage(context.Background(), "pkg-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.StatusCanceled, canceled.Status)
+	mockRepo.AssertNotCalled(t, "UpdatePackage", mock.Anything, mock.Anything, mock.Anything)
+	mockCouriers.AssertExpectations(t)
+}
+
+func TestPackageService_CancelPackage_LostRaceKeepsCourier(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCouriers := new(MockCourierRepository)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), mockCouriers, new(MockPickupRepository), new(MockCalculator), new(MockPaymentProducer), logrus.New())
+
+	pkg := &models.Package{PackageID: "pkg-1", CourierID: "courier-1", Weight: 4, Status: models.StatusInTransit}
+	mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(pkg, nil)
+	mockRepo.On("TransitionStatus", mock.Anything, "pkg-1", "courier-1", models.StatusInTransit, models.StatusCanceled).
+		Return(nil, models.ErrInvalidTransition)
+
+	_, err := packageService.CancelPackage(context.Background(), "pkg-1")
+
+	assert.ErrorIs(t, err, models.ErrInvalidTransition)
+	mockCouriers.AssertNotCalled(t, "ReleaseCapacity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPackageService_DeletePackage_ReleasesCourier(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockCouriers := new(MockCourierRepository)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), mockCouriers, new(MockPickupRepository), new(MockCalculator), new(MockPaymentProducer), logrus.New())
+
+	mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(&models.Package{PackageID: "pkg-1", CourierID: "courier-1", Weight: 4, Status: models.StatusCreated}, nil)
+	mockRepo.On("GetByID", mock.Anything, "pkg-2").Return(&models.Package{PackageID: "pkg-2", CourierID: "courier-1", Weight: 2, Status: models.StatusDelivered}, nil)
+	mockRepo.On("DeletePackage", mock.Anything, mock.Anything).Return(nil)
+	mockCouriers.On("ReleaseCapacity", mock.Anything, "courier-1", 4.0).Return(nil)
+
+	assert.NoError(t, packageService.DeletePackage(context.Background(), "pkg-1"))
+	assert.NoError(t, packageService.DeletePackage(context.Background(), "pkg-2"))
+	mockCouriers.AssertNumberOfCalls(t, "ReleaseCapacity", 1)
+}
+
+func TestPackageService_CancelPackage_ReleasesPickup(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockPickups := new(MockPickupRepository)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), mockPickups, new(MockCalculator), new(MockPaymentProducer), logrus.New())
+
+	mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(&models.Package{PackageID: "pkg-1", Pickup: true, Status: models.StatusCreated}, nil)
+	mockRepo.On("UpdatePackage", mock.Anything, "pkg-1", models.PackageUpdate{Status: models.StatusCanceled}).
+		Return(&models.Package{PackageID: "pkg-1", Status: models.StatusCanceled}, nil)
+	mockPickups.On("CancelPackageBooking", mock.Anything, "pkg-1").Return(&models.PickupBooking{BookingID: "PCK-1", SlotID: "berlin|2025-03-05|09:00"}, nil)
+	mockPickups.On("ReleaseSlot", mock.Anything, "berlin|2025-03-05|09:00").Return(nil)
+
+	_, err := packageService.CancelPackage(context.Background(), "pkg-1")
+
+	assert.NoError(t, err)
+	mockPickups.AssertExpectations(t)
+}
+
+func TestPackageService_DeletePackage_ReleasesPickup(t *testing.T) {
+	mockRepo := new(MockRouteRepository)
+	mockPickups := new(MockPickupRepository)
+	packageService := service.NewPackageService(mockRepo, new(MockRedemptionRepository), new(MockCourierRepository), mockPickups, new(MockCalculator), new(MockPaymentProducer), logrus.New())
+
+	mockRepo.On("GetByID", mock.Anything, "pkg-1").Return(&models.Package{PackageID: "pkg-1", Pickup: true, Status: models.StatusCreated}, nil)
+	mockRepo.On("GetByID", mock.Anything, "pkg-2").Return(&models.Package{PackageID: "pkg-2", Pickup: true, Status: models.StatusCreated}, nil)
+	mockRepo.On("DeletePackage", mock.Anything, mock.Anything).Return(nil)
+	mockPickups.On("CancelPackageBooking", mock.Anything, "pkg-1").Return(&models.PickupBooking{BookingID: "PCK-1", SlotID: "berlin|2025-03-05|09:00"}, nil)
+	mockPickups.On("CancelPackageBooking", mock.Anything, "pkg-2").Return(nil, models.ErrPickupNotFound)
+	mockPickups.On("ReleaseSlot", mock.Anything, "berlin|2025-03-05|09:00").Return(nil)
+
+	assert.NoError(t, packageService.DeletePackage(context.Background(), "pkg-1"))
+	assert.NoError(t, packageService.DeletePackage(context.Background(), "pkg-2"))
+	mockPickups.AssertNumberOfCalls(t, "ReleaseSlot", 1)
+}
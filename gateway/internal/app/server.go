@@ -31,8 +31,16 @@ func NewServer(logger *logrus.Logger) *http.Server {
 	if err != nil {
 		logger.Fatalf("Failed to connect to package gRPC: %v", err)
 	}
+	pickupClient, err := grpcclient.NewPickupGRPCClient("localhost:50054")
+	if err != nil {
+		logger.Fatalf("Failed to connect to pickup gRPC: %v", err)
+	}
+	courierClient, err := grpcclient.NewCourierGRPCClient("localhost:50054")
+	if err != nil {
+		logger.Fatalf("Failed to connect to courier gRPC: %v", err)
+	}
 	mux := http.NewServeMux()
-	handlers.RegisterRoutes(mux, logger, authClient, calculatorClient, paymentClient, packageClient, auctionClient)
+	handlers.RegisterRoutes(mux, logger, authClient, calculatorClient, paymentClient, packageClient, auctionClient, pickupClient, courierClient)
 
 	return &http.Server{
 		Addr:              ":8228",
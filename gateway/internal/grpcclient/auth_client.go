@@ -61,6 +61,18 @@ func (c *AuthGRPCClient) RegisterModerator(email, password string) (*authpb.Auth
 	return c.client.RegisterModerator(ctx, req)
 }
 
+func (c *AuthGRPCClient) RegisterCourier(email, password string) (*authpb.AuthResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := &authpb.RegisterRequest{
+		Email:    email,
+		Password: password,
+	}
+
+	return c.client.RegisterCourier(ctx, req)
+}
+
 func (c *AuthGRPCClient) Login(email, password string) (*authpb.AuthResponse, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
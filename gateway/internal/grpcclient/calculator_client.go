@@ -8,11 +8,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CalculatorGRPCClient struct {
 	conn   *grpc.ClientConn
 	client calculatorpb.CalculatorServiceClient
+	cities calculatorpb.CityServiceClient
 }
 
 func NewCalculatorClient(address string) (*CalculatorGRPCClient, error) {
@@ -27,14 +29,18 @@ func NewCalculatorClient(address string) (*CalculatorGRPCClient, error) {
 
 	client := calculatorpb.NewCalculatorServiceClient(conn)
 
-	return &CalculatorGRPCClient{conn: conn, client: client}, nil
+	return &CalculatorGRPCClient{
+		conn:   conn,
+		client: client,
+		cities: calculatorpb.NewCityServiceClient(conn),
+	}, nil
 }
 
 func (c *CalculatorGRPCClient) Close() error {
 	return c.conn.Close()
 }
 
-func (c *CalculatorGRPCClient) Calculate(weight float64, userID, from, to, address string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+func (c *CalculatorGRPCClient) Calculate(userID string, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
 	md := metadata.New(map[string]string{
 		"authorization": userID,
 	})
@@ -42,50 +48,62 @@ func (c *CalculatorGRPCClient) Calculate(weight float64, userID, from, to, addre
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	return c.client.CalculateDeliveryCost(ctx, &calculatorpb.CalculateDeliveryCostRequest{
-		Weight:  weight,
-		From:    from,
-		To:      to,
-		Address: address,
-		Width:   int32(width),
-		Length:  int32(length),
-		Height:  int32(height),
-	})
+	return c.client.CalculateDeliveryCost(ctx, req)
 }
 
-func (c *CalculatorGRPCClient) CalculateByTariffCode(weight float64, userID, from, to, address, tariffCode string, length, width, height int) (*calculatorpb.CalculateDeliveryCostResponse, error) {
+func (c *CalculatorGRPCClient) CalculateByTariffCode(userID string, req *calculatorpb.CalculateByTariffRequest) (*calculatorpb.CalculateDeliveryCostResponse, error) {
 	md := metadata.New(map[string]string{"authorization": userID})
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	return c.client.CalculateByTariffCode(ctx, &calculatorpb.CalculateByTariffRequest{
-		Weight:     weight,
-		From:       from,
-		To:         to,
-		Address:    address,
-		Length:     int32(length),
-		Width:      int32(width),
-		Height:     int32(height),
-		TariffCode: tariffCode,
-	})
+	return c.client.CalculateByTariffCode(ctx, req)
 }
 
-func (c *CalculatorGRPCClient) GetTariffList(userID string) (*calculatorpb.TariffListResponse, error) {
+func (c *CalculatorGRPCClient) QuoteAllTariffs(userID string, req *calculatorpb.CalculateDeliveryCostRequest) (*calculatorpb.QuoteAllTariffsResponse, error) {
 	md := metadata.New(map[string]string{"authorization": userID})
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
-	return c.client.GetTariffList(ctx, &calculatorpb.TariffListRequest{})
+	return c.client.QuoteAllTariffs(ctx, req)
 }
 
-func (c *CalculatorGRPCClient) CreateTariff(userID, code, name, currency string, baseRate, PricePerKm, PricePerKg, VolumetricDivider, SpeedKmph float64) (*calculatorpb.Tariff, error) {
+// GetTariffList lists the tariffs in force; a filter describing a parcel
+// narrows the list to the tariffs that accept it.
+func (c *CalculatorGRPCClient) GetTariffList(userID string, filter *calculatorpb.TariffListRequest) (*calculatorpb.TariffListResponse, error) {
 	md := metadata.New(map[string]string{"authorization": userID})
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
 
+	if filter == nil {
+		filter = &calculatorpb.TariffListRequest{}
+	}
+	return c.client.GetTariffList(ctx, filter)
+}
+
+func (c *CalculatorGRPCClient) GetTariffVersions(userID, code string) (*calculatorpb.TariffListResponse, error) {
+	md := metadata.New(map[string]string{"authorization": userID})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.client.GetTariffVersions(ctx, &calculatorpb.TariffCodeRequest{
+		Code: code,
+	})
+}
+
+func (c *CalculatorGRPCClient) CreateTariff(userID, role, code, name, currency string, baseRate, PricePerKm, PricePerKg, VolumetricDivider, SpeedKmph, PickupSurcharge float64, validFrom time.Time, pricingMode, distanceProvider string, fuelSurcharge bool, limits *calculatorpb.TariffLimits) (*calculatorpb.Tariff, error) {
+	md := metadata.New(map[string]string{"authorization": userID, "role": role})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var from *timestamppb.Timestamp
+	if !validFrom.IsZero() {
+		from = timestamppb.New(validFrom)
+	}
 	return c.client.CreateTariff(ctx, &calculatorpb.Tariff{
 		Code:              code,
 		Name:              name,
@@ -95,11 +113,37 @@ func (c *CalculatorGRPCClient) CreateTariff(userID, code, name, currency string,
 		PricePerKg:        PricePerKg,
 		VolumetricDivider: VolumetricDivider,
 		SpeedKmph:         int32(SpeedKmph),
+		PickupSurcharge:   PickupSurcharge,
+		ValidFrom:         from,
+		PricingMode:       pricingMode,
+		DistanceProvider:  distanceProvider,
+		FuelSurcharge:     fuelSurcharge,
+		Limits:            limits,
 	})
 }
 
-func (c *CalculatorGRPCClient) DeleteTariff(userID, code string) (*calculatorpb.Empty, error) {
-	md := metadata.New(map[string]string{"authorization": userID})
+func (c *CalculatorGRPCClient) UpdateTariff(userID, role string, req *calculatorpb.UpdateTariffRequest) (*calculatorpb.Tariff, error) {
+	md := metadata.New(map[string]string{"authorization": userID, "role": role})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.client.UpdateTariff(ctx, req)
+}
+
+func (c *CalculatorGRPCClient) GetTariffAudit(userID, role, code string) (*calculatorpb.TariffAuditList, error) {
+	md := metadata.New(map[string]string{"authorization": userID, "role": role})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.client.GetTariffAudit(ctx, &calculatorpb.TariffCodeRequest{
+		Code: code,
+	})
+}
+
+func (c *CalculatorGRPCClient) DeleteTariff(userID, role, code string) (*calculatorpb.Empty, error) {
+	md := metadata.New(map[string]string{"authorization": userID, "role": role})
 	ctx := metadata.NewOutgoingContext(context.Background(), md)
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -108,3 +152,40 @@ func (c *CalculatorGRPCClient) DeleteTariff(userID, code string) (*calculatorpb.
 		Code: code,
 	})
 }
+
+func (c *CalculatorGRPCClient) SearchCities(userID, query string, limit int) (*calculatorpb.CityList, error) {
+	md := metadata.New(map[string]string{"authorization": userID})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.cities.SearchCities(ctx, &calculatorpb.CitySearchRequest{
+		Query: query,
+		Limit: int32(limit),
+	})
+}
+
+func (c *CalculatorGRPCClient) NearestCities(userID string, latitude, longitude float64, limit int) (*calculatorpb.CityList, error) {
+	md := metadata.New(map[string]string{"authorization": userID})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.cities.NearestCities(ctx, &calculatorpb.NearestCitiesRequest{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Limit:     int32(limit),
+	})
+}
+
+func (c *CalculatorGRPCClient) SetCityAliases(userID, role, name string, aliases []string) (*calculatorpb.City, error) {
+	md := metadata.New(map[string]string{"authorization": userID, "role": role})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	return c.cities.SetCityAliases(ctx, &calculatorpb.CityAliasesRequest{
+		Name:    name,
+		Aliases: aliases,
+	})
+}
@@ -0,0 +1,94 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	databasepb "github.com/maksroxx/DeliveryService/proto/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type CourierGRPCClient struct {
+	conn   *grpc.ClientConn
+	client databasepb.CourierServiceClient
+}
+
+func NewCourierGRPCClient(address string) (*CourierGRPCClient, error) {
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{MinConnectTimeout: 5 * time.Second}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	client := databasepb.NewCourierServiceClient(conn)
+	return &CourierGRPCClient{conn: conn, client: client}, nil
+}
+
+func (c *CourierGRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *CourierGRPCClient) withContext(userID, role string) (context.Context, context.CancelFunc) {
+	md := metadata.New(map[string]string{
+		"authorization": userID,
+		"role":          role,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	return context.WithTimeout(ctx, 5*time.Second)
+}
+
+func (c *CourierGRPCClient) SaveProfile(userID, role string, profile *databasepb.CourierProfile) (*databasepb.Courier, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.SaveCourierProfile(ctx, profile)
+}
+
+func (c *CourierGRPCClient) GetProfile(userID, role string) (*databasepb.Courier, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.GetCourierProfile(ctx, &databasepb.Empty{})
+}
+
+func (c *CourierGRPCClient) StartShift(userID, role string, hours int32) (*databasepb.Courier, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.StartShift(ctx, &databasepb.StartShiftRequest{Hours: hours})
+}
+
+func (c *CourierGRPCClient) EndShift(userID, role string) (*databasepb.Courier, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.EndShift(ctx, &databasepb.Empty{})
+}
+
+func (c *CourierGRPCClient) AssignPackage(userID, role, packageID, courierID string) (*databasepb.Package, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.AssignPackage(ctx, &databasepb.AssignPackageRequest{
+		PackageId: packageID,
+		CourierId: courierID,
+	})
+}
+
+func (c *CourierGRPCClient) GetAssignedPackages(userID, role, status string, limit, offset int64) (*databasepb.PackageList, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.GetAssignedPackages(ctx, &databasepb.PackageFilter{
+		Status: status,
+		Limit:  limit,
+		Offset: offset,
+	})
+}
+
+func (c *CourierGRPCClient) UpdateDeliveryStatus(userID, role, packageID, status string) (*databasepb.Package, error) {
+	ctx, cancel := c.withContext(userID, role)
+	defer cancel()
+	return c.client.UpdateDeliveryStatus(ctx, &databasepb.DeliveryStatusUpdate{
+		PackageId: packageID,
+		Status:    status,
+	})
+}
@@ -0,0 +1,74 @@
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	databasepb "github.com/maksroxx/DeliveryService/proto/database"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+type PickupGRPCClient struct {
+	conn   *grpc.ClientConn
+	client databasepb.PickupServiceClient
+}
+
+func NewPickupGRPCClient(address string) (*PickupGRPCClient, error) {
+	conn, err := grpc.NewClient(
+		address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithConnectParams(grpc.ConnectParams{MinConnectTimeout: 5 * time.Second}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	client := databasepb.NewPickupServiceClient(conn)
+	return &PickupGRPCClient{conn: conn, client: client}, nil
+}
+
+func (p *PickupGRPCClient) Close() error {
+	return p.conn.Close()
+}
+
+func (p *PickupGRPCClient) withContext(userID string) (context.Context, context.CancelFunc) {
+	md := metadata.New(map[string]string{
+		"authorization": userID,
+	})
+	ctx := metadata.NewOutgoingContext(context.Background(), md)
+	return context.WithTimeout(ctx, 5*time.Second)
+}
+
+func (p *PickupGRPCClient) GetSlots(userID, city, date string) (*databasepb.PickupSlotList, error) {
+	ctx, cancel := p.withContext(userID)
+	defer cancel()
+	return p.client.GetPickupSlots(ctx, &databasepb.PickupSlotsRequest{City: city, Date: date})
+}
+
+func (p *PickupGRPCClient) Book(userID, packageID, city, date, start string) (*databasepb.PickupBooking, error) {
+	ctx, cancel := p.withContext(userID)
+	defer cancel()
+	return p.client.BookPickup(ctx, &databasepb.BookPickupRequest{
+		PackageId: packageID,
+		City:      city,
+		Date:      date,
+		Start:     start,
+	})
+}
+
+func (p *PickupGRPCClient) Reschedule(userID, bookingID, date, start string) (*databasepb.PickupBooking, error) {
+	ctx, cancel := p.withContext(userID)
+	defer cancel()
+	return p.client.ReschedulePickup(ctx, &databasepb.ReschedulePickupRequest{
+		BookingId: bookingID,
+		Date:      date,
+		Start:     start,
+	})
+}
+
+func (p *PickupGRPCClient) Cancel(userID, bookingID string) (*databasepb.PickupBooking, error) {
+	ctx, cancel := p.withContext(userID)
+	defer cancel()
+	return p.client.CancelPickup(ctx, &databasepb.PickupBookingID{BookingId: bookingID})
+}
@@ -76,6 +76,28 @@ func (h *AuthHandlers) RegisterModerator(w http.ResponseWriter, r *http.Request)
 	})
 }
 
+func (h *AuthHandlers) RegisterCourier(w http.ResponseWriter, r *http.Request) {
+	var req RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Invalid register request: ", err)
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	resp, err := h.authClient.RegisterCourier(req.Email, req.Password)
+	if err != nil {
+		h.logger.Error("gRPC register error: ", err)
+		utils.RespondError(w, r, http.StatusInternalServerError, "Registration failed")
+		return
+	}
+
+	utils.RespondJSON(w, r, http.StatusOK, map[string]string{
+		"user_id": resp.UserId,
+		"token":   resp.Token,
+		"role":    resp.Role,
+	})
+}
+
 func (h *AuthHandlers) Login(w http.ResponseWriter, r *http.Request) {
 	var req LoginRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -7,7 +7,10 @@ import (
 	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
 	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
 	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CalculateByTariffHandler struct {
@@ -20,14 +23,35 @@ func NewCalculateByTariffHandler(client *grpcclient.CalculatorGRPCClient, logger
 }
 
 type CalculateByTariffRequest struct {
-	Weight     float64 `json:"weight"`
-	From       string  `json:"from"`
-	To         string  `json:"to"`
-	Address    string  `json:"address"`
-	Length     int     `json:"length"`
-	Width      int     `json:"width"`
-	Height     int     `json:"height"`
-	TariffCode string  `json:"tariff_code"`
+	Weight         float64 `json:"weight"`
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	Address        string  `json:"address"`
+	Length         int     `json:"length"`
+	Width          int     `json:"width"`
+	Height         int     `json:"height"`
+	TariffCode     string  `json:"tariff_code"`
+	Pickup         bool    `json:"pickup"`
+	TargetCurrency string  `json:"target_currency"`
+	PromoCode      string  `json:"promo_code"`
+	Category       string  `json:"category"`
+}
+
+func (r CalculateByTariffRequest) proto() *calculatorpb.CalculateByTariffRequest {
+	return &calculatorpb.CalculateByTariffRequest{
+		Weight:         r.Weight,
+		From:           r.From,
+		To:             r.To,
+		Address:        r.Address,
+		Length:         int32(r.Length),
+		Width:          int32(r.Width),
+		Height:         int32(r.Height),
+		TariffCode:     r.TariffCode,
+		Pickup:         r.Pickup,
+		TargetCurrency: r.TargetCurrency,
+		PromoCode:      r.PromoCode,
+		Category:       r.Category,
+	}
 }
 
 func (h *CalculateByTariffHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -44,16 +68,107 @@ func (h *CalculateByTariffHandler) ServeHTTP(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	resp, err := h.client.CalculateByTariffCode(req.Weight, userID, req.From, req.To, req.Address, req.TariffCode, req.Length, req.Width, req.Height)
+	resp, err := h.client.CalculateByTariffCode(userID, req.proto())
 	if err != nil {
 		h.logger.Errorf("Failed to calculate by tariff code: %v", err)
+		if respondUnknownCity(w, r, err) || respondIneligible(w, r, err) {
+			return
+		}
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			utils.RespondError(w, r, http.StatusUnprocessableEntity, status.Convert(err).Message())
+			return
+		case codes.NotFound:
+			utils.RespondError(w, r, http.StatusNotFound, status.Convert(err).Message())
+			return
+		}
 		utils.RespondError(w, r, http.StatusInternalServerError, "Calculation failed")
 		return
 	}
 
 	utils.RespondJSON(w, r, http.StatusOK, map[string]any{
-		"cost":            resp.GetCost(),
-		"estimated_hours": resp.GetEstimatedHours(),
-		"currency":        resp.GetCurrency(),
+		"cost":             resp.GetCost(),
+		"estimated_hours":  resp.GetEstimatedHours(),
+		"currency":         resp.GetCurrency(),
+		"tariff_version":   resp.GetTariffVersion(),
+		"quote_id":         resp.GetQuoteId(),
+		"quote_expires_at": utils.FormatProtoTimestamp(resp.GetQuoteExpiresAt()),
+		"breakdown":        breakdown(resp),
 	})
 }
+
+// respondUnknownCity answers with the calculator's "did you mean" names when
+// the request named a city it doesn't know.
+func respondUnknownCity(w http.ResponseWriter, r *http.Request, err error) bool {
+	st := status.Convert(err)
+	if st.Code() != codes.NotFound {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if city, ok := detail.(*calculatorpb.UnknownCity); ok {
+			utils.RespondJSON(w, r, http.StatusUnprocessableEntity, map[string]any{
+				"error":       st.Message(),
+				"field":       city.GetField(),
+				"city":        city.GetName(),
+				"suggestions": city.GetSuggestions(),
+			})
+			return true
+		}
+	}
+	return false
+}
+
+// respondIneligible lists the tariff limits the parcel breaks.
+func respondIneligible(w http.ResponseWriter, r *http.Request, err error) bool {
+	st := status.Convert(err)
+	if st.Code() != codes.FailedPrecondition {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if ineligible, ok := detail.(*calculatorpb.Ineligible); ok {
+			utils.RespondJSON(w, r, http.StatusUnprocessableEntity, map[string]any{
+				"error":   st.Message(),
+				"tariff":  ineligible.GetTariffCode(),
+				"reasons": ineligible.GetReasons(),
+			})
+			return true
+		}
+	}
+	return false
+}
+
+func breakdown(resp *calculatorpb.CalculateDeliveryCostResponse) map[string]any {
+	items := make([]map[string]any, 0, len(resp.GetLineItems()))
+	for _, item := range resp.GetLineItems() {
+		items = append(items, map[string]any{
+			"code":        item.GetCode(),
+			"description": item.GetDescription(),
+			"amount":      item.GetAmount(),
+		})
+	}
+	out := map[string]any{
+		"line_items":        items,
+		"distance_km":       resp.GetDistanceKm(),
+		"chargeable_weight": resp.GetChargeableWeight(),
+		"volumetric_weight": resp.GetVolumetricWeight(),
+	}
+	if resp.GetOriginalCurrency() != "" {
+		out["original_cost"] = resp.GetOriginalCost()
+		out["original_currency"] = resp.GetOriginalCurrency()
+		out["exchange_rate"] = resp.GetExchangeRate()
+	}
+	if resp.GetFuelSurchargeRate() > 0 {
+		out["fuel_surcharge_rate"] = resp.GetFuelSurchargeRate()
+	}
+	if resp.GetPromoCode() != "" {
+		out["promo_code"] = resp.GetPromoCode()
+	}
+	if resp.GetPromoRejected() != "" {
+		out["promo_rejected"] = resp.GetPromoRejected()
+	}
+	if resp.GetDeliveryFrom() != nil {
+		out["delivery_from"] = utils.FormatProtoTimestamp(resp.GetDeliveryFrom())
+		out["delivery_to"] = utils.FormatProtoTimestamp(resp.GetDeliveryTo())
+	}
+	return out
+}
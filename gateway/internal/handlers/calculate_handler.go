@@ -3,11 +3,16 @@ package handlers
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
 	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
 	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CalculateHandler struct {
@@ -23,13 +28,33 @@ func NewCalculateHandler(client *grpcclient.CalculatorGRPCClient, logger *logrus
 }
 
 type calculateRequest struct {
-	Weight  float64 `json:"weight"`
-	From    string  `json:"from"`
-	To      string  `json:"to"`
-	Address string  `json:"address"`
-	Length  int     `json:"length"`
-	Width   int     `json:"width"`
-	Height  int     `json:"height"`
+	Weight         float64 `json:"weight"`
+	From           string  `json:"from"`
+	To             string  `json:"to"`
+	Address        string  `json:"address"`
+	Length         int     `json:"length"`
+	Width          int     `json:"width"`
+	Height         int     `json:"height"`
+	Pickup         bool    `json:"pickup"`
+	TargetCurrency string  `json:"target_currency"`
+	PromoCode      string  `json:"promo_code"`
+	Category       string  `json:"category"`
+}
+
+func (r calculateRequest) proto() *calculatorpb.CalculateDeliveryCostRequest {
+	return &calculatorpb.CalculateDeliveryCostRequest{
+		Weight:         r.Weight,
+		From:           r.From,
+		To:             r.To,
+		Address:        r.Address,
+		Length:         int32(r.Length),
+		Width:          int32(r.Width),
+		Height:         int32(r.Height),
+		Pickup:         r.Pickup,
+		TargetCurrency: r.TargetCurrency,
+		PromoCode:      r.PromoCode,
+		Category:       r.Category,
+	}
 }
 
 func (h *CalculateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -46,29 +71,70 @@ func (h *CalculateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	grpcResp, err := h.client.Calculate(req.Weight, userID, req.From, req.To, req.Address, req.Length, req.Width, req.Height)
+	grpcResp, err := h.client.Calculate(userID, req.proto())
 	if err != nil {
 		h.logger.Errorf("Failed to call gRPC: %v", err)
+		if respondUnknownCity(w, r, err) {
+			return
+		}
+		if status.Code(err) == codes.FailedPrecondition {
+			utils.RespondError(w, r, http.StatusUnprocessableEntity, status.Convert(err).Message())
+			return
+		}
 		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to calculate cost")
 		return
 	}
 
 	utils.RespondJSON(w, r, http.StatusOK, map[string]any{
-		"cost":            grpcResp.GetCost(),
-		"estimated_hours": grpcResp.GetEstimatedHours(),
-		"currency":        grpcResp.GetCurrency(),
+		"cost":             grpcResp.GetCost(),
+		"estimated_hours":  grpcResp.GetEstimatedHours(),
+		"currency":         grpcResp.GetCurrency(),
+		"quote_id":         grpcResp.GetQuoteId(),
+		"quote_expires_at": utils.FormatProtoTimestamp(grpcResp.GetQuoteExpiresAt()),
+		"breakdown":        breakdown(grpcResp),
 	})
 }
 
 type tariff struct {
-	Code              string  `json:"code"`
-	Name              string  `json:"name"`
-	BaseRate          float64 `json:"base_rate"`
-	PricePerKm        float64 `json:"price_per_km"`
-	PricePerKg        float64 `json:"price_per_kg"`
-	Currency          string  `json:"currency"`
-	VolumetricDivider float64 `json:"volumetric_divider"`
-	SpeedKmph         float64 `json:"speed_kmph"`
+	Code              string        `json:"code"`
+	Name              string        `json:"name"`
+	BaseRate          float64       `json:"base_rate"`
+	PricePerKm        float64       `json:"price_per_km"`
+	PricePerKg        float64       `json:"price_per_kg"`
+	Currency          string        `json:"currency"`
+	VolumetricDivider float64       `json:"volumetric_divider"`
+	SpeedKmph         float64       `json:"speed_kmph"`
+	PickupSurcharge   float64       `json:"pickup_surcharge"`
+	ValidFrom         time.Time     `json:"valid_from"`
+	PricingMode       string        `json:"pricing_mode"`
+	DistanceProvider  string        `json:"distance_provider"`
+	FuelSurcharge     bool          `json:"fuel_surcharge"`
+	Limits            *tariffLimits `json:"limits"`
+}
+
+type tariffLimits struct {
+	MaxWeight            float64  `json:"max_weight"`
+	MaxVolumetricWeight  float64  `json:"max_volumetric_weight"`
+	MaxDimension         int      `json:"max_dimension"`
+	MaxGirth             int      `json:"max_girth"`
+	Origins              []string `json:"origins"`
+	Destinations         []string `json:"destinations"`
+	ProhibitedCategories []string `json:"prohibited_categories"`
+}
+
+func (l *tariffLimits) toProto() *calculatorpb.TariffLimits {
+	if l == nil {
+		return nil
+	}
+	return &calculatorpb.TariffLimits{
+		MaxWeight:            l.MaxWeight,
+		MaxVolumetricWeight:  l.MaxVolumetricWeight,
+		MaxDimension:         int32(l.MaxDimension),
+		MaxGirth:             int32(l.MaxGirth),
+		Origins:              l.Origins,
+		Destinations:         l.Destinations,
+		ProhibitedCategories: l.ProhibitedCategories,
+	}
 }
 
 func (h *CalculateHandler) CreateTariff(w http.ResponseWriter, r *http.Request) {
@@ -77,21 +143,128 @@ func (h *CalculateHandler) CreateTariff(w http.ResponseWriter, r *http.Request)
 		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
+	role, _ := middleware.RoleFromContext(r.Context())
 	var req tariff
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Errorf("Failed to decode request: %v", err)
 		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
-	gprcResp, err := h.client.CreateTariff(userID, req.Code, req.Name, req.Currency, req.BaseRate, req.PricePerKm, req.PricePerKg, req.VolumetricDivider, req.SpeedKmph)
+	gprcResp, err := h.client.CreateTariff(userID, role, req.Code, req.Name, req.Currency, req.BaseRate, req.PricePerKm, req.PricePerKg, req.VolumetricDivider, req.SpeedKmph, req.PickupSurcharge, req.ValidFrom, req.PricingMode, req.DistanceProvider, req.FuelSurcharge, req.Limits.toProto())
 	if err != nil {
 		h.logger.Errorf("Failed to call gRPC: %v", err)
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			utils.RespondError(w, r, http.StatusConflict, status.Convert(err).Message())
+			return
+		case codes.InvalidArgument:
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
 		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to create tariff")
 		return
 	}
 	utils.RespondJSON(w, r, http.StatusOK, gprcResp)
 }
 
+type updateTariff struct {
+	Code              string        `json:"code"`
+	ExpectedVersion   int           `json:"expected_version"`
+	Name              *string       `json:"name"`
+	BaseRate          *float64      `json:"base_rate"`
+	PricePerKm        *float64      `json:"price_per_km"`
+	PricePerKg        *float64      `json:"price_per_kg"`
+	Currency          *string       `json:"currency"`
+	VolumetricDivider *float64      `json:"volumetric_divider"`
+	SpeedKmph         *float64      `json:"speed_kmph"`
+	PickupSurcharge   *float64      `json:"pickup_surcharge"`
+	PricingMode       *string       `json:"pricing_mode"`
+	DistanceProvider  *string       `json:"distance_provider"`
+	FuelSurcharge     *bool         `json:"fuel_surcharge"`
+	Limits            *tariffLimits `json:"limits"`
+	ValidFrom         *time.Time    `json:"valid_from"`
+}
+
+// UpdateTariff publishes a new version with only the fields present in the
+// body changed. expected_version is the version the caller edited; a 409
+// means someone else changed the tariff in the meantime.
+func (h *CalculateHandler) UpdateTariff(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+	role, _ := middleware.RoleFromContext(r.Context())
+	var req updateTariff
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode request: %v", err)
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	update := &calculatorpb.UpdateTariffRequest{
+		Code:              req.Code,
+		ExpectedVersion:   int32(req.ExpectedVersion),
+		Name:              req.Name,
+		BaseRate:          req.BaseRate,
+		PricePerKm:        req.PricePerKm,
+		PricePerKg:        req.PricePerKg,
+		Currency:          req.Currency,
+		VolumetricDivider: req.VolumetricDivider,
+		PickupSurcharge:   req.PickupSurcharge,
+		PricingMode:       req.PricingMode,
+		DistanceProvider:  req.DistanceProvider,
+		FuelSurcharge:     req.FuelSurcharge,
+		Limits:            req.Limits.toProto(),
+	}
+	if req.SpeedKmph != nil {
+		speed := int32(*req.SpeedKmph)
+		update.SpeedKmph = &speed
+	}
+	if req.ValidFrom != nil {
+		update.ValidFrom = timestamppb.New(*req.ValidFrom)
+	}
+
+	grpcResp, err := h.client.UpdateTariff(userID, role, update)
+	if err != nil {
+		h.logger.Errorf("Failed to call gRPC: %v", err)
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		case codes.NotFound:
+			utils.RespondError(w, r, http.StatusNotFound, status.Convert(err).Message())
+			return
+		case codes.Aborted:
+			utils.RespondError(w, r, http.StatusConflict, status.Convert(err).Message())
+			return
+		}
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to update tariff")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, grpcResp)
+}
+
+func (h *CalculateHandler) TariffAudit(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+	role, _ := middleware.RoleFromContext(r.Context())
+	code := r.URL.Query().Get("code")
+	if code == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "Missing tariff code")
+		return
+	}
+	resp, err := h.client.GetTariffAudit(userID, role, code)
+	if err != nil {
+		h.logger.Errorf("Failed to call gRPC: %v", err)
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to get tariff audit")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp.Entries)
+}
+
 type deleteTariff struct {
 	Code string `json:"code"`
 }
@@ -102,13 +275,14 @@ func (h *CalculateHandler) DeleteTariff(w http.ResponseWriter, r *http.Request)
 		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
 		return
 	}
+	role, _ := middleware.RoleFromContext(r.Context())
 	var req deleteTariff
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		h.logger.Errorf("Failed to decode request: %v", err)
 		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
-	_, err := h.client.DeleteTariff(userID, req.Code)
+	_, err := h.client.DeleteTariff(userID, role, req.Code)
 	if err != nil {
 		h.logger.Errorf("Failed to call gRPC: %v", err)
 		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to delete tariff")
@@ -116,3 +290,27 @@ func (h *CalculateHandler) DeleteTariff(w http.ResponseWriter, r *http.Request)
 	}
 	utils.RespondJSON(w, r, http.StatusOK, map[string]string{"status": "ok"})
 }
+
+func (h *CalculateHandler) TariffVersions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+	code := r.URL.Query().Get("code")
+	if code == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "Missing tariff code")
+		return
+	}
+	resp, err := h.client.GetTariffVersions(userID, code)
+	if err != nil {
+		h.logger.Errorf("Failed to fetch tariff versions: %v", err)
+		if status.Code(err) == codes.NotFound {
+			utils.RespondError(w, r, http.StatusNotFound, "Tariff not found")
+			return
+		}
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to fetch tariff versions")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp.Tariffs)
+}
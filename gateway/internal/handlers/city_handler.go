@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
+	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
+	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CityHandler struct {
+	client *grpcclient.CalculatorGRPCClient
+	logger *logrus.Logger
+}
+
+func NewCityHandler(client *grpcclient.CalculatorGRPCClient, logger *logrus.Logger) *CityHandler {
+	return &CityHandler{client: client, logger: logger}
+}
+
+type cityAliasesRequest struct {
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+func (h *CityHandler) Search(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "Missing query")
+		return
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	resp, err := h.client.SearchCities(userID, query, limit)
+	if err != nil {
+		h.logger.Errorf("Failed to search cities: %v", err)
+		if status.Code(err) == codes.InvalidArgument {
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to search cities")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp.Cities)
+}
+
+func (h *CityHandler) Nearest(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+	lat, latErr := strconv.ParseFloat(r.URL.Query().Get("lat"), 64)
+	lon, lonErr := strconv.ParseFloat(r.URL.Query().Get("lon"), 64)
+	if latErr != nil || lonErr != nil {
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid lat or lon")
+		return
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+	resp, err := h.client.NearestCities(userID, lat, lon, limit)
+	if err != nil {
+		h.logger.Errorf("Failed to find nearest cities: %v", err)
+		if status.Code(err) == codes.InvalidArgument {
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to find nearest cities")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp.Cities)
+}
+
+func (h *CityHandler) SetAliases(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+	role, _ := middleware.RoleFromContext(r.Context())
+	var req cityAliasesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode request: %v", err)
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	resp, err := h.client.SetCityAliases(userID, role, req.Name, req.Aliases)
+	if err != nil {
+		h.logger.Errorf("Failed to set city aliases: %v", err)
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		case codes.NotFound:
+			utils.RespondError(w, r, http.StatusNotFound, "City not found")
+			return
+		}
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to set city aliases")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
+	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
+	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	databasepb "github.com/maksroxx/DeliveryService/proto/database"
+	"github.com/sirupsen/logrus"
+)
+
+type CourierHandler struct {
+	client *grpcclient.CourierGRPCClient
+	logger *logrus.Logger
+}
+
+func NewCourierHandler(client *grpcclient.CourierGRPCClient, log *logrus.Logger) *CourierHandler {
+	return &CourierHandler{
+		client: client,
+		logger: log,
+	}
+}
+
+type startShiftRequest struct {
+	Hours int32 `json:"hours"`
+}
+
+type assignPackageRequest struct {
+	PackageID string `json:"package_id"`
+	CourierID string `json:"courier_id"`
+}
+
+type deliveryStatusRequest struct {
+	PackageID string `json:"package_id"`
+	Status    string `json:"status"`
+}
+
+func (h *CourierHandler) Profile(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	role, _ := middleware.RoleFromContext(r.Context())
+
+	switch r.Method {
+	case http.MethodGet:
+		resp, err := h.client.GetProfile(userID, role)
+		if err != nil {
+			h.logger.WithError(err).Error("GetCourierProfile failed")
+			utils.RespondError(w, r, http.StatusNotFound, "Courier profile not found")
+			return
+		}
+		utils.RespondJSON(w, r, http.StatusOK, resp)
+	case http.MethodPost, http.MethodPut:
+		var req databasepb.CourierProfile
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+		resp, err := h.client.SaveProfile(userID, role, &req)
+		if err != nil {
+			h.logger.WithError(err).Error("SaveCourierProfile failed")
+			utils.RespondError(w, r, http.StatusBadRequest, "Failed to save courier profile")
+			return
+		}
+		utils.RespondJSON(w, r, http.StatusOK, resp)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *CourierHandler) StartShift(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	role, _ := middleware.RoleFromContext(r.Context())
+
+	var req startShiftRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	resp, err := h.client.StartShift(userID, role, req.Hours)
+	if err != nil {
+		h.logger.WithError(err).Error("StartShift failed")
+		utils.RespondError(w, r, http.StatusBadRequest, "Failed to start shift")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *CourierHandler) EndShift(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	role, _ := middleware.RoleFromContext(r.Context())
+
+	resp, err := h.client.EndShift(userID, role)
+	if err != nil {
+		h.logger.WithError(err).Error("EndShift failed")
+		utils.RespondError(w, r, http.StatusBadRequest, "Failed to end shift")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *CourierHandler) GetAssignedPackages(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	role, _ := middleware.RoleFromContext(r.Context())
+
+	status := r.URL.Query().Get("status")
+	limit, _ := strconv.ParseInt(r.URL.Query().Get("limit"), 10, 64)
+	offset, _ := strconv.ParseInt(r.URL.Query().Get("offset"), 10, 64)
+
+	resp, err := h.client.GetAssignedPackages(userID, role, status, limit, offset)
+	if err != nil {
+		h.logger.WithError(err).Error("GetAssignedPackages failed")
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to fetch packages")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *CourierHandler) UpdateDeliveryStatus(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	role, _ := middleware.RoleFromContext(r.Context())
+
+	var req deliveryStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.PackageID == "" || req.Status == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "package_id and status are required")
+		return
+	}
+
+	resp, err := h.client.UpdateDeliveryStatus(userID, role, req.PackageID, req.Status)
+	if err != nil {
+		h.logger.WithError(err).Error("UpdateDeliveryStatus failed")
+		utils.RespondError(w, r, http.StatusConflict, "Failed to update status")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *CourierHandler) AssignPackage(w http.ResponseWriter, r *http.Request) {
+	userID, _ := middleware.UserIDFromContext(r.Context())
+	role, _ := middleware.RoleFromContext(r.Context())
+
+	var req assignPackageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.PackageID == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "package_id is required")
+		return
+	}
+
+	resp, err := h.client.AssignPackage(userID, role, req.PackageID, req.CourierID)
+	if err != nil {
+		h.logger.WithError(err).Error("AssignPackage failed")
+		utils.RespondError(w, r, http.StatusConflict, "Failed to assign package")
+		return
+	}
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
 	databasepb "github.com/maksroxx/DeliveryService/proto/database"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PackageHandler struct {
@@ -116,7 +118,9 @@ func (h *PackageHandler) CreatePackage(w http.ResponseWriter, r *http.Request) {
 
 type PackageWithFormattedTime struct {
 	*databasepb.Package
-	CreatedAtStr string `json:"created_at_str"`
+	CreatedAtStr    string `json:"created_at_str"`
+	DeliveryFromStr string `json:"delivery_from_str,omitempty"`
+	DeliveryToStr   string `json:"delivery_to_str,omitempty"`
 }
 
 func (h *PackageHandler) CreatePackageWithCalc(w http.ResponseWriter, r *http.Request) {
@@ -138,12 +142,21 @@ func (h *PackageHandler) CreatePackageWithCalc(w http.ResponseWriter, r *http.Re
 	created, err := h.client.CreatePackageWithCalc(userID, &pkg)
 	if err != nil {
 		h.logger.Errorf("Failed to create package: %v", err)
-		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to create package")
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+		case codes.FailedPrecondition, codes.AlreadyExists:
+			utils.RespondError(w, r, http.StatusConflict, status.Convert(err).Message())
+		default:
+			utils.RespondError(w, r, http.StatusInternalServerError, "Failed to create package")
+		}
 		return
 	}
 	out := PackageWithFormattedTime{
-		Package:      created,
-		CreatedAtStr: utils.FormatProtoTimestamp(created.CreatedAt),
+		Package:         created,
+		CreatedAtStr:    utils.FormatProtoTimestamp(created.CreatedAt),
+		DeliveryFromStr: utils.FormatProtoTimestamp(created.DeliveryFrom),
+		DeliveryToStr:   utils.FormatProtoTimestamp(created.DeliveryTo),
 	}
 
 	utils.RespondJSON(w, r, http.StatusCreated, out)
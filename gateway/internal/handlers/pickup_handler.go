@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
+	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
+	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	"github.com/sirupsen/logrus"
+)
+
+type PickupHandler struct {
+	client *grpcclient.PickupGRPCClient
+	logger *logrus.Logger
+}
+
+func NewPickupHandler(client *grpcclient.PickupGRPCClient, log *logrus.Logger) *PickupHandler {
+	return &PickupHandler{
+		client: client,
+		logger: log,
+	}
+}
+
+type bookPickupRequest struct {
+	PackageID string `json:"package_id"`
+	City      string `json:"city"`
+	Date      string `json:"date"`
+	Start     string `json:"start"`
+}
+
+type reschedulePickupRequest struct {
+	BookingID string `json:"booking_id"`
+	Date      string `json:"date"`
+	Start     string `json:"start"`
+}
+
+func (h *PickupHandler) GetSlots(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		utils.RespondError(w, r, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	city := r.URL.Query().Get("city")
+	date := r.URL.Query().Get("date")
+	if city == "" || date == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "Missing city or date")
+		return
+	}
+
+	resp, err := h.client.GetSlots(userID, city, date)
+	if err != nil {
+		h.logger.WithError(err).Error("GetPickupSlots failed")
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to fetch pickup slots")
+		return
+	}
+
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *PickupHandler) Book(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		utils.RespondError(w, r, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req bookPickupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.PackageID == "" || req.City == "" || req.Date == "" || req.Start == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "package_id, city, date and start are required")
+		return
+	}
+
+	resp, err := h.client.Book(userID, req.PackageID, req.City, req.Date, req.Start)
+	if err != nil {
+		h.logger.WithError(err).Error("BookPickup failed")
+		utils.RespondError(w, r, http.StatusConflict, "Failed to book pickup")
+		return
+	}
+
+	utils.RespondJSON(w, r, http.StatusCreated, resp)
+}
+
+func (h *PickupHandler) Reschedule(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		utils.RespondError(w, r, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req reschedulePickupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.BookingID == "" || req.Date == "" || req.Start == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "booking_id, date and start are required")
+		return
+	}
+
+	resp, err := h.client.Reschedule(userID, req.BookingID, req.Date, req.Start)
+	if err != nil {
+		h.logger.WithError(err).Error("ReschedulePickup failed")
+		utils.RespondError(w, r, http.StatusConflict, "Failed to reschedule pickup")
+		return
+	}
+
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
+
+func (h *PickupHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok {
+		utils.RespondError(w, r, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	bookingID := r.URL.Query().Get("id")
+	if bookingID == "" {
+		utils.RespondError(w, r, http.StatusBadRequest, "Missing booking ID")
+		return
+	}
+
+	resp, err := h.client.Cancel(userID, bookingID)
+	if err != nil {
+		h.logger.WithError(err).Error("CancelPickup failed")
+		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to cancel pickup")
+		return
+	}
+
+	utils.RespondJSON(w, r, http.StatusOK, resp)
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
+	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
+	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type QuoteAllTariffsHandler struct {
+	client *grpcclient.CalculatorGRPCClient
+	logger *logrus.Logger
+}
+
+func NewQuoteAllTariffsHandler(client *grpcclient.CalculatorGRPCClient, logger *logrus.Logger) *QuoteAllTariffsHandler {
+	return &QuoteAllTariffsHandler{client: client, logger: logger}
+}
+
+func (h *QuoteAllTariffsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		utils.RespondError(w, r, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+	userID, ok := middleware.UserIDFromContext(r.Context())
+	if !ok || userID == "" {
+		utils.RespondError(w, r, http.StatusUnauthorized, "Missing user ID")
+		return
+	}
+
+	var req calculateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Errorf("Failed to decode request: %v", err)
+		utils.RespondError(w, r, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	resp, err := h.client.QuoteAllTariffs(userID, req.proto())
+	if err != nil {
+		h.logger.Errorf("Failed to quote tariffs: %v", err)
+		if respondUnknownCity(w, r, err) {
+			return
+		}
+		if status.Code(err) == codes.InvalidArgument {
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
+		utils.RespondError(w, r, http.StatusInternalServerError, "Quote failed")
+		return
+	}
+
+	quotes := make([]map[string]any, 0, len(resp.GetQuotes()))
+	for _, q := range resp.GetQuotes() {
+		quote := map[string]any{
+			"tariff_code":     q.GetTariffCode(),
+			"tariff_name":     q.GetTariffName(),
+			"cost":            q.GetCost(),
+			"estimated_hours": q.GetEstimatedHours(),
+			"currency":        q.GetCurrency(),
+			"tariff_version":  q.GetTariffVersion(),
+			"eligible":        q.GetEligible(),
+			"reason":          q.GetReason(),
+			"cheapest":        q.GetCheapest(),
+			"fastest":         q.GetFastest(),
+		}
+		if q.GetPromoCode() != "" {
+			quote["promo_code"] = q.GetPromoCode()
+		}
+		if q.GetPromoRejected() != "" {
+			quote["promo_rejected"] = q.GetPromoRejected()
+		}
+		if q.GetDeliveryFrom() != nil {
+			quote["delivery_from"] = utils.FormatProtoTimestamp(q.GetDeliveryFrom())
+			quote["delivery_to"] = utils.FormatProtoTimestamp(q.GetDeliveryTo())
+		}
+		quotes = append(quotes, quote)
+	}
+	utils.RespondJSON(w, r, http.StatusOK, map[string]any{"quotes": quotes})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
+	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 )
@@ -16,6 +17,8 @@ func RegisterRoutes(
 	paymentClient *grpcclient.PaymentGRPCClient,
 	packageClient *grpcclient.PackageGRPCClient,
 	auctionClient *grpcclient.AuctionGRPCClient,
+	pickupClient *grpcclient.PickupGRPCClient,
+	courierClient *grpcclient.CourierGRPCClient,
 ) {
 	// Default
 	defaultHandler := NewDefaultHandler()
@@ -25,6 +28,7 @@ func RegisterRoutes(
 	authHandlers := NewAuthHandlers(authClient, logger)
 	mux.Handle("/api/register", logAndCORS(http.HandlerFunc(authHandlers.Register), logger))
 	mux.Handle("/api/register-moderator", logAndCORS(http.HandlerFunc(authHandlers.RegisterModerator), logger))
+	mux.Handle("/api/register-courier", logAndCORS(http.HandlerFunc(authHandlers.RegisterCourier), logger))
 	mux.Handle("/api/login", logAndCORS(http.HandlerFunc(authHandlers.Login), logger))
 	mux.Handle("/api/telegram/code", protectAndLog(http.HandlerFunc(authHandlers.GenerateTelegramCode), authClient, logger))
 
@@ -32,17 +36,34 @@ func RegisterRoutes(
 	calcHandler := NewCalculateHandler(calculatorClient, logger)
 	mux.Handle("/api/calculate", protectAndLog(calcHandler, authClient, logger))
 	mux.Handle("/api/calculate-by-tariff", protectAndLog(NewCalculateByTariffHandler(calculatorClient, logger), authClient, logger))
+	mux.Handle("/api/quote-all-tariffs", protectAndLog(NewQuoteAllTariffsHandler(calculatorClient, logger), authClient, logger))
 	mux.Handle("/api/tariffs", protectAndLog(NewTariffListHandler(calculatorClient, logger), authClient, logger))
-	mux.Handle("/api/tariff", protectAndLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	// tariff changes are audited and limited to moderators
+	mux.Handle("/api/tariff", protectAndLog(middleware.RequireRole(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.Method {
 		case http.MethodPost:
 			calcHandler.CreateTariff(w, r)
+		case http.MethodPatch:
+			calcHandler.UpdateTariff(w, r)
 		case http.MethodDelete:
 			calcHandler.DeleteTariff(w, r)
 		default:
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		}
-	}), authClient, logger))
+	}), "moderator"), authClient, logger))
+	// GET /tariff/versions?code=xxx
+	mux.Handle("/api/tariff/versions", protectAndLog(http.HandlerFunc(calcHandler.TariffVersions), authClient, logger))
+	// GET /tariff/audit?code=xxx
+	mux.Handle("/api/tariff/audit", protectAndLog(middleware.RequireRole(http.HandlerFunc(calcHandler.TariffAudit), "moderator"), authClient, logger))
+
+	// Cities
+	// GET /cities/search?q=mos&limit=10
+	// GET /cities/nearest?lat=55.75&lon=37.61&limit=5
+	// POST /cities/aliases (json body)
+	cityHandler := NewCityHandler(calculatorClient, logger)
+	mux.Handle("/api/cities/search", protectAndLog(http.HandlerFunc(cityHandler.Search), authClient, logger))
+	mux.Handle("/api/cities/nearest", protectAndLog(http.HandlerFunc(cityHandler.Nearest), authClient, logger))
+	mux.Handle("/api/cities/aliases", protectAndLog(middleware.RequireRole(http.HandlerFunc(cityHandler.SetAliases), "moderator"), authClient, logger))
 
 	// Auction
 	auctionHandler := NewAuctionHandler(auctionClient, logger)
@@ -74,6 +95,29 @@ func RegisterRoutes(
 	mux.Handle("/api/packages", protectAndLog(NewPackageHTTPHandler(packageHandler), authClient, logger))
 	mux.Handle("/api/packages/", protectAndLog(NewPackageHTTPHandler(packageHandler), authClient, logger))
 
+	// Pickup
+	// GET /pickup/slots?city=xxx&date=2006-01-02
+	// POST /pickup/book (json body)
+	// POST /pickup/reschedule (json body)
+	// POST /pickup/cancel?id=xxx
+	pickupHandler := NewPickupHandler(pickupClient, logger)
+	mux.Handle("/api/pickup/slots", protectAndLog(http.HandlerFunc(pickupHandler.GetSlots), authClient, logger))
+	mux.Handle("/api/pickup/book", protectAndLog(http.HandlerFunc(pickupHandler.Book), authClient, logger))
+	mux.Handle("/api/pickup/reschedule", protectAndLog(http.HandlerFunc(pickupHandler.Reschedule), authClient, logger))
+	mux.Handle("/api/pickup/cancel", protectAndLog(http.HandlerFunc(pickupHandler.Cancel), authClient, logger))
+
+	// Courier
+	courierHandler := NewCourierHandler(courierClient, logger)
+	courierOnly := func(h http.HandlerFunc) http.Handler {
+		return protectAndLog(middleware.RequireRole(h, "courier"), authClient, logger)
+	}
+	mux.Handle("/api/courier/profile", courierOnly(courierHandler.Profile))
+	mux.Handle("/api/courier/shift/start", courierOnly(courierHandler.StartShift))
+	mux.Handle("/api/courier/shift/end", courierOnly(courierHandler.EndShift))
+	mux.Handle("/api/courier/packages", courierOnly(courierHandler.GetAssignedPackages))
+	mux.Handle("/api/courier/status", courierOnly(courierHandler.UpdateDeliveryStatus))
+	mux.Handle("/api/courier/assign", protectAndLog(middleware.RequireRole(http.HandlerFunc(courierHandler.AssignPackage), "moderator"), authClient, logger))
+
 	// Metrics
 	mux.Handle("/metrics", promhttp.Handler())
 
@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/maksroxx/DeliveryService/gateway/internal/grpcclient"
 	"github.com/maksroxx/DeliveryService/gateway/internal/middleware"
 	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+	calculatorpb "github.com/maksroxx/DeliveryService/proto/calculator"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type TariffListHandler struct {
@@ -18,6 +22,9 @@ func NewTariffListHandler(client *grpcclient.CalculatorGRPCClient, logger *logru
 	return &TariffListHandler{client: client, logger: logger}
 }
 
+// ServeHTTP lists the tariffs in force. Parcel parameters in the query
+// (weight, length, width, height, from, to, category) keep only the tariffs
+// that accept such a parcel.
 func (h *TariffListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.UserIDFromContext(r.Context())
 	if !ok || userID == "" {
@@ -25,9 +32,41 @@ func (h *TariffListHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := h.client.GetTariffList(userID)
+	query := r.URL.Query()
+	filter := &calculatorpb.TariffListRequest{
+		From:     query.Get("from"),
+		To:       query.Get("to"),
+		Category: query.Get("category"),
+	}
+	if v := query.Get("weight"); v != "" {
+		weight, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			utils.RespondError(w, r, http.StatusBadRequest, "Invalid weight")
+			return
+		}
+		filter.Weight = weight
+	}
+	for name, dst := range map[string]*int32{"length": &filter.Length, "width": &filter.Width, "height": &filter.Height} {
+		if v := query.Get(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				utils.RespondError(w, r, http.StatusBadRequest, "Invalid "+name)
+				return
+			}
+			*dst = int32(n)
+		}
+	}
+
+	resp, err := h.client.GetTariffList(userID, filter)
 	if err != nil {
 		h.logger.Errorf("Failed to fetch tariffs: %v", err)
+		if respondUnknownCity(w, r, err) {
+			return
+		}
+		if status.Code(err) == codes.InvalidArgument {
+			utils.RespondError(w, r, http.StatusBadRequest, status.Convert(err).Message())
+			return
+		}
 		utils.RespondError(w, r, http.StatusInternalServerError, "Failed to fetch tariffs")
 		return
 	}
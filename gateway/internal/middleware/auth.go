@@ -14,13 +14,21 @@ import (
 
 type contextKey string
 
-const userIDContextKey contextKey = "userID"
+const (
+	userIDContextKey contextKey = "userID"
+	roleContextKey   contextKey = "role"
+)
 
 func UserIDFromContext(ctx context.Context) (string, bool) {
 	val, ok := ctx.Value(userIDContextKey).(string)
 	return val, ok
 }
 
+func RoleFromContext(ctx context.Context) (string, bool) {
+	val, ok := ctx.Value(roleContextKey).(string)
+	return val, ok
+}
+
 type AuthMiddleware struct {
 	next       http.Handler
 	logger     *logrus.Logger
@@ -59,7 +67,7 @@ func (m *AuthMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	userID, valid := m.validateToken(token)
+	userID, role, valid := m.validateToken(token)
 	if !valid {
 		m.logger.Warn("Invalid token")
 		utils.RespondError(lrw, r, http.StatusUnauthorized, "Invalid token")
@@ -68,24 +76,25 @@ func (m *AuthMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := context.WithValue(r.Context(), userIDContextKey, userID)
+	ctx = context.WithValue(ctx, roleContextKey, role)
 	r = r.WithContext(ctx)
 	m.next.ServeHTTP(lrw, r)
 	m.observeMetrics(r, lrw.StatusCode, start)
 }
 
-func (m *AuthMiddleware) validateToken(token string) (string, bool) {
+func (m *AuthMiddleware) validateToken(token string) (string, string, bool) {
 	resp, err := m.authClient.Validate(token)
 	if err != nil {
 		m.logger.Errorf("Failed to validate token: %v", err)
-		return "", false
+		return "", "", false
 	}
 
 	if resp.Valid != "ok" {
 		m.logger.Warnf("Token validation failed: valid=%s", resp.Valid)
-		return "", false
+		return "", "", false
 	}
 
-	return resp.UserId, true
+	return resp.UserId, resp.Role, true
 }
 
 func (m *AuthMiddleware) observeMetrics(r *http.Request, statusCode int, start time.Time) {
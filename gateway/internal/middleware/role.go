@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/maksroxx/DeliveryService/gateway/internal/utils"
+)
+
+// RequireRole must be wrapped by AuthMiddleware so the role is already in the context.
+func RequireRole(next http.Handler, roles ...string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		role, _ := RoleFromContext(r.Context())
+		for _, allowed := range roles {
+			if role == allowed {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+		utils.RespondError(w, r, http.StatusForbidden, "Forbidden")
+	})
+}
@@ -4,5 +4,12 @@ CREATE TABLE IF NOT EXISTS payments (
     cost DECIMAL(10, 2) NOT NULL,
     currency VARCHAR(10) NOT NULL,
     status VARCHAR(20) NOT NULL,
+    original_cost DECIMAL(10, 2),
+    original_currency VARCHAR(10),
+    exchange_rate DECIMAL(18, 6),
     PRIMARY KEY (user_id, package_id)
 );
+
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS original_cost DECIMAL(10, 2);
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS original_currency VARCHAR(10);
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS exchange_rate DECIMAL(18, 6);
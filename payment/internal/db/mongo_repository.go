@@ -43,6 +43,11 @@ func (r *PaymentMongoRepository) CreatePayment(ctx context.Context, payment mode
 		"created_at": now,
 		"updated_at": now,
 	}
+	if payment.OriginalCurrency != "" {
+		doc["original_cost"] = payment.OriginalCost
+		doc["original_currency"] = payment.OriginalCurrency
+		doc["exchange_rate"] = payment.ExchangeRate
+	}
 	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
 		if mongo.IsDuplicateKeyError(err) {
@@ -23,10 +23,15 @@ func (p *PostgresPaymenter) CreatePayment(ctx context.Context, payment models.Pa
 		return fmt.Errorf("PostgreSQL connection is nil")
 	}
 
-	query := `INSERT INTO payments (user_id, package_id, cost, currency, status) 
-			  VALUES ($1, $2, $3, $4, $5)`
+	query := `INSERT INTO payments (user_id, package_id, cost, currency, status, original_cost, original_currency, exchange_rate) 
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
 
-	_, err := p.db.Exec(ctx, query, payment.UserID, payment.PackageID, payment.Cost, payment.Currency, payment.Status)
+	var originalCost, exchangeRate *float64
+	var originalCurrency *string
+	if payment.OriginalCurrency != "" {
+		originalCost, originalCurrency, exchangeRate = &payment.OriginalCost, &payment.OriginalCurrency, &payment.ExchangeRate
+	}
+	_, err := p.db.Exec(ctx, query, payment.UserID, payment.PackageID, payment.Cost, payment.Currency, payment.Status, originalCost, originalCurrency, exchangeRate)
 	if err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok {
 			if pgErr.Code == "23505" {
@@ -43,12 +48,14 @@ func (p *PostgresPaymenter) UpdatePayment(ctx context.Context, update models.Pay
 	query := `UPDATE payments 
 			  SET status = $1 
 			  WHERE user_id = $2 AND package_id = $3 AND status != 'PAID'
-			  RETURNING user_id, package_id, cost, currency, status`
+			  RETURNING user_id, package_id, cost, currency, status,
+			  COALESCE(original_cost, 0), COALESCE(original_currency, ''), COALESCE(exchange_rate, 0)`
 
 	row := p.db.QueryRow(ctx, query, update.Status, update.UserID, update.PackageID)
 
 	var payment models.Payment
-	err := row.Scan(&payment.UserID, &payment.PackageID, &payment.Cost, &payment.Currency, &payment.Status)
+	err := row.Scan(&payment.UserID, &payment.PackageID, &payment.Cost, &payment.Currency, &payment.Status,
+		&payment.OriginalCost, &payment.OriginalCurrency, &payment.ExchangeRate)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return nil, fmt.Errorf("payment already confirmed or not found")
@@ -12,9 +12,12 @@ const (
 )
 
 type Payment struct {
-	UserID    string        `bson:"user_id" json:"user_id"`
-	PackageID string        `bson:"package_id" json:"package_id"`
-	Cost      float64       `bson:"cost" json:"cost"`
-	Currency  string        `bson:"currency" json:"currency"`
-	Status    PaymentStatus `bson:"status" json:"status"`
+	UserID           string        `bson:"user_id" json:"user_id"`
+	PackageID        string        `bson:"package_id" json:"package_id"`
+	Cost             float64       `bson:"cost" json:"cost"`
+	Currency         string        `bson:"currency" json:"currency"`
+	Status           PaymentStatus `bson:"status" json:"status"`
+	OriginalCost     float64       `bson:"original_cost,omitempty" json:"original_cost,omitempty"`
+	OriginalCurrency string        `bson:"original_currency,omitempty" json:"original_currency,omitempty"`
+	ExchangeRate     float64       `bson:"exchange_rate,omitempty" json:"exchange_rate,omitempty"`
 }
@@ -228,6 +228,7 @@ type Bid struct {
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	Timestamp     string                 `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AuctionEndsAt string                 `protobuf:"bytes,6,opt,name=auction_ends_at,json=auctionEndsAt,proto3" json:"auction_ends_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -297,6 +298,13 @@ func (x *Bid) GetTimestamp() string {
 	return ""
 }
 
+func (x *Bid) GetAuctionEndsAt() string {
+	if x != nil {
+		return x.AuctionEndsAt
+	}
+	return ""
+}
+
 type Packages struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Package       []*Package             `protobuf:"bytes,1,rep,name=package,proto3" json:"package,omitempty"`
@@ -342,20 +350,24 @@ func (x *Packages) GetPackage() []*Package {
 }
 
 type Package struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PackageId     string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Cost          float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
-	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
-	TariffCode    string                 `protobuf:"bytes,7,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
-	Weight        float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`
-	Length        int32                  `protobuf:"varint,9,opt,name=length,proto3" json:"length,omitempty"`
-	Width         int32                  `protobuf:"varint,10,opt,name=width,proto3" json:"width,omitempty"`
-	Height        int32                  `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	PackageId           string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Status              string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	From                string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                  string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Cost                float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	Currency            string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	TariffCode          string                 `protobuf:"bytes,7,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	Weight              float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Length              int32                  `protobuf:"varint,9,opt,name=length,proto3" json:"length,omitempty"`
+	Width               int32                  `protobuf:"varint,10,opt,name=width,proto3" json:"width,omitempty"`
+	Height              int32                  `protobuf:"varint,11,opt,name=height,proto3" json:"height,omitempty"`
+	AuctionEndsAt       string                 `protobuf:"bytes,12,opt,name=auction_ends_at,json=auctionEndsAt,proto3" json:"auction_ends_at,omitempty"`
+	StartingPrice       float64                `protobuf:"fixed64,13,opt,name=starting_price,json=startingPrice,proto3" json:"starting_price,omitempty"`
+	MinIncrement        float64                `protobuf:"fixed64,14,opt,name=min_increment,json=minIncrement,proto3" json:"min_increment,omitempty"`
+	MinIncrementPercent float64                `protobuf:"fixed64,15,opt,name=min_increment_percent,json=minIncrementPercent,proto3" json:"min_increment_percent,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Package) Reset() {
@@ -465,6 +477,34 @@ func (x *Package) GetHeight() int32 {
 	return 0
 }
 
+func (x *Package) GetAuctionEndsAt() string {
+	if x != nil {
+		return x.AuctionEndsAt
+	}
+	return ""
+}
+
+func (x *Package) GetStartingPrice() float64 {
+	if x != nil {
+		return x.StartingPrice
+	}
+	return 0
+}
+
+func (x *Package) GetMinIncrement() float64 {
+	if x != nil {
+		return x.MinIncrement
+	}
+	return 0
+}
+
+func (x *Package) GetMinIncrementPercent() float64 {
+	if x != nil {
+		return x.MinIncrementPercent
+	}
+	return 0
+}
+
 type Empty struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -519,16 +559,17 @@ const file_auction_auction_proto_rawDesc = "" +
 	"\n" +
 	"package_id\x18\x01 \x01(\tR\tpackageId\"0\n" +
 	"\fBidsResponse\x12 \n" +
-	"\x04bids\x18\x01 \x03(\v2\f.auction.BidR\x04bids\"\x8a\x01\n" +
+	"\x04bids\x18\x01 \x03(\v2\f.auction.BidR\x04bids\"\xb2\x01\n" +
 	"\x03Bid\x12\x15\n" +
 	"\x06bid_id\x18\x01 \x01(\tR\x05bidId\x12\x1d\n" +
 	"\n" +
 	"package_id\x18\x02 \x01(\tR\tpackageId\x12\x17\n" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
 	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1c\n" +
-	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\"6\n" +
+	"\ttimestamp\x18\x05 \x01(\tR\ttimestamp\x12&\n" +
+	"\x0fauction_ends_at\x18\x06 \x01(\tR\rauctionEndsAt\"6\n" +
 	"\bPackages\x12*\n" +
-	"\apackage\x18\x01 \x03(\v2\x10.auction.PackageR\apackage\"\x93\x02\n" +
+	"\apackage\x18\x01 \x03(\v2\x10.auction.PackageR\apackage\"\xbb\x03\n" +
 	"\aPackage\x12\x1d\n" +
 	"\n" +
 	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x16\n" +
@@ -543,7 +584,11 @@ const file_auction_auction_proto_rawDesc = "" +
 	"\x06length\x18\t \x01(\x05R\x06length\x12\x14\n" +
 	"\x05width\x18\n" +
 	" \x01(\x05R\x05width\x12\x16\n" +
-	"\x06height\x18\v \x01(\x05R\x06height\"\a\n" +
+	"\x06height\x18\v \x01(\x05R\x06height\x12&\n" +
+	"\x0fauction_ends_at\x18\f \x01(\tR\rauctionEndsAt\x12%\n" +
+	"\x0estarting_price\x18\r \x01(\x01R\rstartingPrice\x12#\n" +
+	"\rmin_increment\x18\x0e \x01(\x01R\fminIncrement\x122\n" +
+	"\x15min_increment_percent\x18\x0f \x01(\x01R\x13minIncrementPercent\"\a\n" +
 	"\x05Empty2\xcb\x03\n" +
 	"\x0eAuctionService\x125\n" +
 	"\bPlaceBid\x12\x13.auction.BidRequest\x1a\x14.auction.BidResponse\x12?\n" +
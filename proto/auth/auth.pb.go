@@ -225,6 +225,7 @@ type ValidateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Valid         string                 `protobuf:"bytes,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -273,6 +274,13 @@ func (x *ValidateResponse) GetValid() string {
 	return ""
 }
 
+func (x *ValidateResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 type TelegramCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -464,10 +472,11 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\fAuthResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x12\n" +
-	"\x04role\x18\x03 \x01(\tR\x04role\"A\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"U\n" +
 	"\x10ValidateResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05valid\x18\x02 \x01(\tR\x05valid\".\n" +
+	"\x05valid\x18\x02 \x01(\tR\x05valid\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\".\n" +
 	"\x13TelegramCodeRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"*\n" +
 	"\x14TelegramCodeResponse\x12\x12\n" +
@@ -475,10 +484,11 @@ const file_auth_auth_proto_rawDesc = "" +
 	"\x19TelegramCodeLookupRequest\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\"5\n" +
 	"\x1aTelegramCodeLookupResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId2\x9b\x03\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId2\xd9\x03\n" +
 	"\vAuthService\x125\n" +
 	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x12.auth.AuthResponse\x12>\n" +
-	"\x11RegisterModerator\x12\x15.auth.RegisterRequest\x1a\x12.auth.AuthResponse\x12/\n" +
+	"\x11RegisterModerator\x12\x15.auth.RegisterRequest\x1a\x12.auth.AuthResponse\x12<\n" +
+	"\x0fRegisterCourier\x12\x15.auth.RegisterRequest\x1a\x12.auth.AuthResponse\x12/\n" +
 	"\x05Login\x12\x12.auth.LoginRequest\x1a\x12.auth.AuthResponse\x129\n" +
 	"\bValidate\x12\x15.auth.ValidateRequest\x1a\x16.auth.ValidateResponse\x12Z\n" +
 	"\x15GetUserByTelegramCode\x12\x1f.auth.TelegramCodeLookupRequest\x1a .auth.TelegramCodeLookupResponse\x12M\n" +
@@ -511,18 +521,20 @@ var file_auth_auth_proto_goTypes = []any{
 var file_auth_auth_proto_depIdxs = []int32{
 	0, // 0: auth.AuthService.Register:input_type -> auth.RegisterRequest
 	0, // 1: auth.AuthService.RegisterModerator:input_type -> auth.RegisterRequest
-	1, // 2: auth.AuthService.Login:input_type -> auth.LoginRequest
-	2, // 3: auth.AuthService.Validate:input_type -> auth.ValidateRequest
-	7, // 4: auth.AuthService.GetUserByTelegramCode:input_type -> auth.TelegramCodeLookupRequest
-	5, // 5: auth.AuthService.GenerateTelegramCode:input_type -> auth.TelegramCodeRequest
-	3, // 6: auth.AuthService.Register:output_type -> auth.AuthResponse
-	3, // 7: auth.AuthService.RegisterModerator:output_type -> auth.AuthResponse
-	3, // 8: auth.AuthService.Login:output_type -> auth.AuthResponse
-	4, // 9: auth.AuthService.Validate:output_type -> auth.ValidateResponse
-	8, // 10: auth.AuthService.GetUserByTelegramCode:output_type -> auth.TelegramCodeLookupResponse
-	6, // 11: auth.AuthService.GenerateTelegramCode:output_type -> auth.TelegramCodeResponse
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
+	0, // 2: auth.AuthService.RegisterCourier:input_type -> auth.RegisterRequest
+	1, // 3: auth.AuthService.Login:input_type -> auth.LoginRequest
+	2, // 4: auth.AuthService.Validate:input_type -> auth.ValidateRequest
+	7, // 5: auth.AuthService.GetUserByTelegramCode:input_type -> auth.TelegramCodeLookupRequest
+	5, // 6: auth.AuthService.GenerateTelegramCode:input_type -> auth.TelegramCodeRequest
+	3, // 7: auth.AuthService.Register:output_type -> auth.AuthResponse
+	3, // 8: auth.AuthService.RegisterModerator:output_type -> auth.AuthResponse
+	3, // 9: auth.AuthService.RegisterCourier:output_type -> auth.AuthResponse
+	3, // 10: auth.AuthService.Login:output_type -> auth.AuthResponse
+	4, // 11: auth.AuthService.Validate:output_type -> auth.ValidateResponse
+	8, // 12: auth.AuthService.GetUserByTelegramCode:output_type -> auth.TelegramCodeLookupResponse
+	6, // 13: auth.AuthService.GenerateTelegramCode:output_type -> auth.TelegramCodeResponse
+	7, // [7:14] is the sub-list for method output_type
+	0, // [0:7] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
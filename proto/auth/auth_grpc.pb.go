@@ -21,6 +21,7 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	AuthService_Register_FullMethodName              = "/auth.AuthService/Register"
 	AuthService_RegisterModerator_FullMethodName     = "/auth.AuthService/RegisterModerator"
+	AuthService_RegisterCourier_FullMethodName       = "/auth.AuthService/RegisterCourier"
 	AuthService_Login_FullMethodName                 = "/auth.AuthService/Login"
 	AuthService_Validate_FullMethodName              = "/auth.AuthService/Validate"
 	AuthService_GetUserByTelegramCode_FullMethodName = "/auth.AuthService/GetUserByTelegramCode"
@@ -33,6 +34,7 @@ const (
 type AuthServiceClient interface {
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	RegisterModerator(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
+	RegisterCourier(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error)
 	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
 	GetUserByTelegramCode(ctx context.Context, in *TelegramCodeLookupRequest, opts ...grpc.CallOption) (*TelegramCodeLookupResponse, error)
@@ -67,6 +69,16 @@ func (c *authServiceClient) RegisterModerator(ctx context.Context, in *RegisterR
 	return out, nil
 }
 
+func (c *authServiceClient) RegisterCourier(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthResponse)
+	err := c.cc.Invoke(ctx, AuthService_RegisterCourier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*AuthResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AuthResponse)
@@ -113,6 +125,7 @@ func (c *authServiceClient) GenerateTelegramCode(ctx context.Context, in *Telegr
 type AuthServiceServer interface {
 	Register(context.Context, *RegisterRequest) (*AuthResponse, error)
 	RegisterModerator(context.Context, *RegisterRequest) (*AuthResponse, error)
+	RegisterCourier(context.Context, *RegisterRequest) (*AuthResponse, error)
 	Login(context.Context, *LoginRequest) (*AuthResponse, error)
 	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
 	GetUserByTelegramCode(context.Context, *TelegramCodeLookupRequest) (*TelegramCodeLookupResponse, error)
@@ -133,6 +146,9 @@ func (UnimplementedAuthServiceServer) Register(context.Context, *RegisterRequest
 func (UnimplementedAuthServiceServer) RegisterModerator(context.Context, *RegisterRequest) (*AuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterModerator not implemented")
 }
+func (UnimplementedAuthServiceServer) RegisterCourier(context.Context, *RegisterRequest) (*AuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCourier not implemented")
+}
 func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*AuthResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -202,6 +218,24 @@ func _AuthService_RegisterModerator_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_RegisterCourier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).RegisterCourier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_RegisterCourier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).RegisterCourier(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +323,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterModerator",
 			Handler:    _AuthService_RegisterModerator_Handler,
 		},
+		{
+			MethodName: "RegisterCourier",
+			Handler:    _AuthService_RegisterCourier_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _AuthService_Login_Handler,
@@ -9,6 +9,7 @@ package calculatorpb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,16 +23,20 @@ const (
 )
 
 type CalculateDeliveryCostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Length        int32                  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
-	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
-	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Weight         float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	From           string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Address        string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Length         int32                  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Width          int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Pickup         bool                   `protobuf:"varint,8,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	TargetCurrency string                 `protobuf:"bytes,9,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	PromoCode      string                 `protobuf:"bytes,10,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Category       string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CalculateDeliveryCostRequest) Reset() {
@@ -113,13 +118,58 @@ func (x *CalculateDeliveryCostRequest) GetHeight() int32 {
 	return 0
 }
 
+func (x *CalculateDeliveryCostRequest) GetPickup() bool {
+	if x != nil {
+		return x.Pickup
+	}
+	return false
+}
+
+func (x *CalculateDeliveryCostRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *CalculateDeliveryCostRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CalculateDeliveryCostRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type CalculateDeliveryCostResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Cost           float64                `protobuf:"fixed64,1,opt,name=cost,proto3" json:"cost,omitempty"`
-	EstimatedHours int32                  `protobuf:"varint,2,opt,name=estimated_hours,json=estimatedHours,proto3" json:"estimated_hours,omitempty"`
-	Currency       string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Cost              float64                `protobuf:"fixed64,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	EstimatedHours    int32                  `protobuf:"varint,2,opt,name=estimated_hours,json=estimatedHours,proto3" json:"estimated_hours,omitempty"`
+	Currency          string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Legs              []*RouteLeg            `protobuf:"bytes,4,rep,name=legs,proto3" json:"legs,omitempty"`
+	TariffVersion     int32                  `protobuf:"varint,5,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"`
+	QuoteId           string                 `protobuf:"bytes,6,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	QuoteExpiresAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=quote_expires_at,json=quoteExpiresAt,proto3" json:"quote_expires_at,omitempty"`
+	LineItems         []*LineItem            `protobuf:"bytes,8,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	DistanceKm        float64                `protobuf:"fixed64,9,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	ChargeableWeight  float64                `protobuf:"fixed64,10,opt,name=chargeable_weight,json=chargeableWeight,proto3" json:"chargeable_weight,omitempty"`
+	VolumetricWeight  float64                `protobuf:"fixed64,11,opt,name=volumetric_weight,json=volumetricWeight,proto3" json:"volumetric_weight,omitempty"`
+	PricedAt          *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=priced_at,json=pricedAt,proto3" json:"priced_at,omitempty"`
+	OriginalCost      float64                `protobuf:"fixed64,13,opt,name=original_cost,json=originalCost,proto3" json:"original_cost,omitempty"`
+	OriginalCurrency  string                 `protobuf:"bytes,14,opt,name=original_currency,json=originalCurrency,proto3" json:"original_currency,omitempty"`
+	ExchangeRate      float64                `protobuf:"fixed64,15,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	PromoCode         string                 `protobuf:"bytes,16,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	PromoRejected     string                 `protobuf:"bytes,17,opt,name=promo_rejected,json=promoRejected,proto3" json:"promo_rejected,omitempty"`
+	DeliveryFrom      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=delivery_from,json=deliveryFrom,proto3" json:"delivery_from,omitempty"`
+	DeliveryTo        *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=delivery_to,json=deliveryTo,proto3" json:"delivery_to,omitempty"`
+	FuelSurchargeRate float64                `protobuf:"fixed64,20,opt,name=fuel_surcharge_rate,json=fuelSurchargeRate,proto3" json:"fuel_surcharge_rate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CalculateDeliveryCostResponse) Reset() {
@@ -173,171 +223,149 @@ func (x *CalculateDeliveryCostResponse) GetCurrency() string {
 	return ""
 }
 
-type CalculateByTariffRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	Length        int32                  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
-	Width         int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
-	Height        int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
-	TariffCode    string                 `protobuf:"bytes,8,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CalculateDeliveryCostResponse) GetLegs() []*RouteLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
 }
 
-func (x *CalculateByTariffRequest) Reset() {
-	*x = CalculateByTariffRequest{}
-	mi := &file_calculator_calculator_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CalculateDeliveryCostResponse) GetTariffVersion() int32 {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return 0
 }
 
-func (x *CalculateByTariffRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CalculateDeliveryCostResponse) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
 }
 
-func (*CalculateByTariffRequest) ProtoMessage() {}
-
-func (x *CalculateByTariffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calculator_calculator_proto_msgTypes[2]
+func (x *CalculateDeliveryCostResponse) GetQuoteExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.QuoteExpiresAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CalculateByTariffRequest.ProtoReflect.Descriptor instead.
-func (*CalculateByTariffRequest) Descriptor() ([]byte, []int) {
-	return file_calculator_calculator_proto_rawDescGZIP(), []int{2}
+func (x *CalculateDeliveryCostResponse) GetLineItems() []*LineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
 }
 
-func (x *CalculateByTariffRequest) GetWeight() float64 {
+func (x *CalculateDeliveryCostResponse) GetDistanceKm() float64 {
 	if x != nil {
-		return x.Weight
+		return x.DistanceKm
 	}
 	return 0
 }
 
-func (x *CalculateByTariffRequest) GetFrom() string {
+func (x *CalculateDeliveryCostResponse) GetChargeableWeight() float64 {
 	if x != nil {
-		return x.From
+		return x.ChargeableWeight
 	}
-	return ""
+	return 0
 }
 
-func (x *CalculateByTariffRequest) GetTo() string {
+func (x *CalculateDeliveryCostResponse) GetVolumetricWeight() float64 {
 	if x != nil {
-		return x.To
+		return x.VolumetricWeight
 	}
-	return ""
+	return 0
 }
 
-func (x *CalculateByTariffRequest) GetAddress() string {
+func (x *CalculateDeliveryCostResponse) GetPricedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Address
+		return x.PricedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CalculateByTariffRequest) GetLength() int32 {
+func (x *CalculateDeliveryCostResponse) GetOriginalCost() float64 {
 	if x != nil {
-		return x.Length
+		return x.OriginalCost
 	}
 	return 0
 }
 
-func (x *CalculateByTariffRequest) GetWidth() int32 {
+func (x *CalculateDeliveryCostResponse) GetOriginalCurrency() string {
 	if x != nil {
-		return x.Width
+		return x.OriginalCurrency
 	}
-	return 0
+	return ""
 }
 
-func (x *CalculateByTariffRequest) GetHeight() int32 {
+func (x *CalculateDeliveryCostResponse) GetExchangeRate() float64 {
 	if x != nil {
-		return x.Height
+		return x.ExchangeRate
 	}
 	return 0
 }
 
-func (x *CalculateByTariffRequest) GetTariffCode() string {
+func (x *CalculateDeliveryCostResponse) GetPromoCode() string {
 	if x != nil {
-		return x.TariffCode
+		return x.PromoCode
 	}
 	return ""
 }
 
-type TariffListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TariffListRequest) Reset() {
-	*x = TariffListRequest{}
-	mi := &file_calculator_calculator_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CalculateDeliveryCostResponse) GetPromoRejected() string {
+	if x != nil {
+		return x.PromoRejected
+	}
+	return ""
 }
 
-func (x *TariffListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CalculateDeliveryCostResponse) GetDeliveryFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryFrom
+	}
+	return nil
 }
 
-func (*TariffListRequest) ProtoMessage() {}
-
-func (x *TariffListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calculator_calculator_proto_msgTypes[3]
+func (x *CalculateDeliveryCostResponse) GetDeliveryTo() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DeliveryTo
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TariffListRequest.ProtoReflect.Descriptor instead.
-func (*TariffListRequest) Descriptor() ([]byte, []int) {
-	return file_calculator_calculator_proto_rawDescGZIP(), []int{3}
+func (x *CalculateDeliveryCostResponse) GetFuelSurchargeRate() float64 {
+	if x != nil {
+		return x.FuelSurchargeRate
+	}
+	return 0
 }
 
-type Tariff struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BaseRate          float64                `protobuf:"fixed64,3,opt,name=base_rate,json=baseRate,proto3" json:"base_rate,omitempty"`
-	PricePerKm        float64                `protobuf:"fixed64,4,opt,name=price_per_km,json=pricePerKm,proto3" json:"price_per_km,omitempty"`
-	PricePerKg        float64                `protobuf:"fixed64,5,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`
-	Currency          string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
-	VolumetricDivider float64                `protobuf:"fixed64,7,opt,name=volumetric_divider,json=volumetricDivider,proto3" json:"volumetric_divider,omitempty"`
-	SpeedKmph         int32                  `protobuf:"varint,8,opt,name=speed_kmph,json=speedKmph,proto3" json:"speed_kmph,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type UnknownCity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Suggestions   []string               `protobuf:"bytes,3,rep,name=suggestions,proto3" json:"suggestions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tariff) Reset() {
-	*x = Tariff{}
-	mi := &file_calculator_calculator_proto_msgTypes[4]
+func (x *UnknownCity) Reset() {
+	*x = UnknownCity{}
+	mi := &file_calculator_calculator_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tariff) String() string {
+func (x *UnknownCity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tariff) ProtoMessage() {}
+func (*UnknownCity) ProtoMessage() {}
 
-func (x *Tariff) ProtoReflect() protoreflect.Message {
-	mi := &file_calculator_calculator_proto_msgTypes[4]
+func (x *UnknownCity) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,89 +376,108 @@ func (x *Tariff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
-func (*Tariff) Descriptor() ([]byte, []int) {
-	return file_calculator_calculator_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UnknownCity.ProtoReflect.Descriptor instead.
+func (*UnknownCity) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Tariff) GetCode() string {
+func (x *UnknownCity) GetField() string {
 	if x != nil {
-		return x.Code
+		return x.Field
 	}
 	return ""
 }
 
-func (x *Tariff) GetName() string {
+func (x *UnknownCity) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Tariff) GetBaseRate() float64 {
+func (x *UnknownCity) GetSuggestions() []string {
 	if x != nil {
-		return x.BaseRate
+		return x.Suggestions
 	}
-	return 0
+	return nil
 }
 
-func (x *Tariff) GetPricePerKm() float64 {
-	if x != nil {
-		return x.PricePerKm
-	}
-	return 0
+type Ineligible struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TariffCode    string                 `protobuf:"bytes,1,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	Reasons       []string               `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Tariff) GetPricePerKg() float64 {
-	if x != nil {
-		return x.PricePerKg
-	}
-	return 0
+func (x *Ineligible) Reset() {
+	*x = Ineligible{}
+	mi := &file_calculator_calculator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Tariff) GetCurrency() string {
+func (x *Ineligible) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ineligible) ProtoMessage() {}
+
+func (x *Ineligible) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[3]
 	if x != nil {
-		return x.Currency
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Tariff) GetVolumetricDivider() float64 {
+// Deprecated: Use Ineligible.ProtoReflect.Descriptor instead.
+func (*Ineligible) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Ineligible) GetTariffCode() string {
 	if x != nil {
-		return x.VolumetricDivider
+		return x.TariffCode
 	}
-	return 0
+	return ""
 }
 
-func (x *Tariff) GetSpeedKmph() int32 {
+func (x *Ineligible) GetReasons() []string {
 	if x != nil {
-		return x.SpeedKmph
+		return x.Reasons
 	}
-	return 0
+	return nil
 }
 
-type TariffCodeRequest struct {
+type LineItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TariffCodeRequest) Reset() {
-	*x = TariffCodeRequest{}
-	mi := &file_calculator_calculator_proto_msgTypes[5]
+func (x *LineItem) Reset() {
+	*x = LineItem{}
+	mi := &file_calculator_calculator_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TariffCodeRequest) String() string {
+func (x *LineItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TariffCodeRequest) ProtoMessage() {}
+func (*LineItem) ProtoMessage() {}
 
-func (x *TariffCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_calculator_calculator_proto_msgTypes[5]
+func (x *LineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,39 +488,64 @@ func (x *TariffCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TariffCodeRequest.ProtoReflect.Descriptor instead.
-func (*TariffCodeRequest) Descriptor() ([]byte, []int) {
-	return file_calculator_calculator_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use LineItem.ProtoReflect.Descriptor instead.
+func (*LineItem) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TariffCodeRequest) GetCode() string {
+func (x *LineItem) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-type Empty struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LineItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *Empty) Reset() {
-	*x = Empty{}
-	mi := &file_calculator_calculator_proto_msgTypes[6]
+func (x *LineItem) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type VerifyQuoteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId        string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	Weight         float64                `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	From           string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Length         int32                  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Width          int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	TariffCode     string                 `protobuf:"bytes,8,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	Pickup         bool                   `protobuf:"varint,9,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	TargetCurrency string                 `protobuf:"bytes,10,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	Category       string                 `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VerifyQuoteRequest) Reset() {
+	*x = VerifyQuoteRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Empty) String() string {
+func (x *VerifyQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Empty) ProtoMessage() {}
+func (*VerifyQuoteRequest) ProtoMessage() {}
 
-func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_calculator_calculator_proto_msgTypes[6]
+func (x *VerifyQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,33 +556,3207 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
-func (*Empty) Descriptor() ([]byte, []int) {
-	return file_calculator_calculator_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use VerifyQuoteRequest.ProtoReflect.Descriptor instead.
+func (*VerifyQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *VerifyQuoteRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *VerifyQuoteRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *VerifyQuoteRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *VerifyQuoteRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *VerifyQuoteRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *VerifyQuoteRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *VerifyQuoteRequest) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *VerifyQuoteRequest) GetPickup() bool {
+	if x != nil {
+		return x.Pickup
+	}
+	return false
+}
+
+func (x *VerifyQuoteRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *VerifyQuoteRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type RouteLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromHub       string                 `protobuf:"bytes,1,opt,name=from_hub,json=fromHub,proto3" json:"from_hub,omitempty"`
+	ToHub         string                 `protobuf:"bytes,2,opt,name=to_hub,json=toHub,proto3" json:"to_hub,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,3,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	TransitHours  float64                `protobuf:"fixed64,4,opt,name=transit_hours,json=transitHours,proto3" json:"transit_hours,omitempty"`
+	Cost          float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteLeg) Reset() {
+	*x = RouteLeg{}
+	mi := &file_calculator_calculator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteLeg) ProtoMessage() {}
+
+func (x *RouteLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteLeg.ProtoReflect.Descriptor instead.
+func (*RouteLeg) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RouteLeg) GetFromHub() string {
+	if x != nil {
+		return x.FromHub
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetToHub() string {
+	if x != nil {
+		return x.ToHub
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetTransitHours() float64 {
+	if x != nil {
+		return x.TransitHours
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type CalculateByTariffRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Weight         float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	From           string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To             string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Address        string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Length         int32                  `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Width          int32                  `protobuf:"varint,6,opt,name=width,proto3" json:"width,omitempty"`
+	Height         int32                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	TariffCode     string                 `protobuf:"bytes,8,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	Pickup         bool                   `protobuf:"varint,9,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	TargetCurrency string                 `protobuf:"bytes,10,opt,name=target_currency,json=targetCurrency,proto3" json:"target_currency,omitempty"`
+	PromoCode      string                 `protobuf:"bytes,11,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Category       string                 `protobuf:"bytes,12,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CalculateByTariffRequest) Reset() {
+	*x = CalculateByTariffRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateByTariffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateByTariffRequest) ProtoMessage() {}
+
+func (x *CalculateByTariffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateByTariffRequest.ProtoReflect.Descriptor instead.
+func (*CalculateByTariffRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CalculateByTariffRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CalculateByTariffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CalculateByTariffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CalculateByTariffRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CalculateByTariffRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *CalculateByTariffRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *CalculateByTariffRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CalculateByTariffRequest) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *CalculateByTariffRequest) GetPickup() bool {
+	if x != nil {
+		return x.Pickup
+	}
+	return false
+}
+
+func (x *CalculateByTariffRequest) GetTargetCurrency() string {
+	if x != nil {
+		return x.TargetCurrency
+	}
+	return ""
+}
+
+func (x *CalculateByTariffRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *CalculateByTariffRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type BatchCalculateRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	CorrelationId string                    `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Parcel        *CalculateByTariffRequest `protobuf:"bytes,2,opt,name=parcel,proto3" json:"parcel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCalculateRequest) Reset() {
+	*x = BatchCalculateRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCalculateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCalculateRequest) ProtoMessage() {}
+
+func (x *BatchCalculateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCalculateRequest.ProtoReflect.Descriptor instead.
+func (*BatchCalculateRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchCalculateRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BatchCalculateRequest) GetParcel() *CalculateByTariffRequest {
+	if x != nil {
+		return x.Parcel
+	}
+	return nil
+}
+
+type BatchCalculateResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	CorrelationId string                         `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Result        *CalculateDeliveryCostResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ErrorCode     string                         `protobuf:"bytes,3,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Error         string                         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCalculateResponse) Reset() {
+	*x = BatchCalculateResponse{}
+	mi := &file_calculator_calculator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCalculateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCalculateResponse) ProtoMessage() {}
+
+func (x *BatchCalculateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCalculateResponse.ProtoReflect.Descriptor instead.
+func (*BatchCalculateResponse) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchCalculateResponse) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BatchCalculateResponse) GetResult() *CalculateDeliveryCostResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *BatchCalculateResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *BatchCalculateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TariffQuote struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TariffCode     string                 `protobuf:"bytes,1,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	TariffName     string                 `protobuf:"bytes,2,opt,name=tariff_name,json=tariffName,proto3" json:"tariff_name,omitempty"`
+	Cost           float64                `protobuf:"fixed64,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	EstimatedHours int32                  `protobuf:"varint,4,opt,name=estimated_hours,json=estimatedHours,proto3" json:"estimated_hours,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	TariffVersion  int32                  `protobuf:"varint,6,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"`
+	Eligible       bool                   `protobuf:"varint,7,opt,name=eligible,proto3" json:"eligible,omitempty"`
+	Reason         string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cheapest       bool                   `protobuf:"varint,9,opt,name=cheapest,proto3" json:"cheapest,omitempty"`
+	Fastest        bool                   `protobuf:"varint,10,opt,name=fastest,proto3" json:"fastest,omitempty"`
+	PromoCode      string                 `protobuf:"bytes,11,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	PromoRejected  string                 `protobuf:"bytes,12,opt,name=promo_rejected,json=promoRejected,proto3" json:"promo_rejected,omitempty"`
+	DeliveryFrom   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=delivery_from,json=deliveryFrom,proto3" json:"delivery_from,omitempty"`
+	DeliveryTo     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=delivery_to,json=deliveryTo,proto3" json:"delivery_to,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TariffQuote) Reset() {
+	*x = TariffQuote{}
+	mi := &file_calculator_calculator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffQuote) ProtoMessage() {}
+
+func (x *TariffQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffQuote.ProtoReflect.Descriptor instead.
+func (*TariffQuote) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TariffQuote) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetTariffName() string {
+	if x != nil {
+		return x.TariffName
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetEstimatedHours() int32 {
+	if x != nil {
+		return x.EstimatedHours
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetTariffVersion() int32 {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return 0
+}
+
+func (x *TariffQuote) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *TariffQuote) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetCheapest() bool {
+	if x != nil {
+		return x.Cheapest
+	}
+	return false
+}
+
+func (x *TariffQuote) GetFastest() bool {
+	if x != nil {
+		return x.Fastest
+	}
+	return false
+}
+
+func (x *TariffQuote) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetPromoRejected() string {
+	if x != nil {
+		return x.PromoRejected
+	}
+	return ""
+}
+
+func (x *TariffQuote) GetDeliveryFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryFrom
+	}
+	return nil
+}
+
+func (x *TariffQuote) GetDeliveryTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryTo
+	}
+	return nil
+}
+
+type QuoteAllTariffsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotes        []*TariffQuote         `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteAllTariffsResponse) Reset() {
+	*x = QuoteAllTariffsResponse{}
+	mi := &file_calculator_calculator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteAllTariffsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteAllTariffsResponse) ProtoMessage() {}
+
+func (x *QuoteAllTariffsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteAllTariffsResponse.ProtoReflect.Descriptor instead.
+func (*QuoteAllTariffsResponse) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QuoteAllTariffsResponse) GetQuotes() []*TariffQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type TariffListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Length        int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	Width         int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffListRequest) Reset() {
+	*x = TariffListRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffListRequest) ProtoMessage() {}
+
+func (x *TariffListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffListRequest.ProtoReflect.Descriptor instead.
+func (*TariffListRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TariffListRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TariffListRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TariffListRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TariffListRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *TariffListRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *TariffListRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TariffListRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type Tariff struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BaseRate          float64                `protobuf:"fixed64,3,opt,name=base_rate,json=baseRate,proto3" json:"base_rate,omitempty"`
+	PricePerKm        float64                `protobuf:"fixed64,4,opt,name=price_per_km,json=pricePerKm,proto3" json:"price_per_km,omitempty"`
+	PricePerKg        float64                `protobuf:"fixed64,5,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`
+	Currency          string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	VolumetricDivider float64                `protobuf:"fixed64,7,opt,name=volumetric_divider,json=volumetricDivider,proto3" json:"volumetric_divider,omitempty"`
+	SpeedKmph         int32                  `protobuf:"varint,8,opt,name=speed_kmph,json=speedKmph,proto3" json:"speed_kmph,omitempty"`
+	PickupSurcharge   float64                `protobuf:"fixed64,9,opt,name=pickup_surcharge,json=pickupSurcharge,proto3" json:"pickup_surcharge,omitempty"`
+	Version           int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	ValidFrom         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	PricingMode       string                 `protobuf:"bytes,13,opt,name=pricing_mode,json=pricingMode,proto3" json:"pricing_mode,omitempty"`
+	DistanceProvider  string                 `protobuf:"bytes,14,opt,name=distance_provider,json=distanceProvider,proto3" json:"distance_provider,omitempty"`
+	Limits            *TariffLimits          `protobuf:"bytes,15,opt,name=limits,proto3" json:"limits,omitempty"`
+	FuelSurcharge     bool                   `protobuf:"varint,16,opt,name=fuel_surcharge,json=fuelSurcharge,proto3" json:"fuel_surcharge,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Tariff) Reset() {
+	*x = Tariff{}
+	mi := &file_calculator_calculator_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tariff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tariff) ProtoMessage() {}
+
+func (x *Tariff) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tariff.ProtoReflect.Descriptor instead.
+func (*Tariff) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Tariff) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Tariff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tariff) GetBaseRate() float64 {
+	if x != nil {
+		return x.BaseRate
+	}
+	return 0
+}
+
+func (x *Tariff) GetPricePerKm() float64 {
+	if x != nil {
+		return x.PricePerKm
+	}
+	return 0
+}
+
+func (x *Tariff) GetPricePerKg() float64 {
+	if x != nil {
+		return x.PricePerKg
+	}
+	return 0
+}
+
+func (x *Tariff) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Tariff) GetVolumetricDivider() float64 {
+	if x != nil {
+		return x.VolumetricDivider
+	}
+	return 0
+}
+
+func (x *Tariff) GetSpeedKmph() int32 {
+	if x != nil {
+		return x.SpeedKmph
+	}
+	return 0
+}
+
+func (x *Tariff) GetPickupSurcharge() float64 {
+	if x != nil {
+		return x.PickupSurcharge
+	}
+	return 0
+}
+
+func (x *Tariff) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Tariff) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Tariff) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *Tariff) GetPricingMode() string {
+	if x != nil {
+		return x.PricingMode
+	}
+	return ""
+}
+
+func (x *Tariff) GetDistanceProvider() string {
+	if x != nil {
+		return x.DistanceProvider
+	}
+	return ""
+}
+
+func (x *Tariff) GetLimits() *TariffLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *Tariff) GetFuelSurcharge() bool {
+	if x != nil {
+		return x.FuelSurcharge
+	}
+	return false
+}
+
+type TariffLimits struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	MaxWeight            float64                `protobuf:"fixed64,1,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	MaxVolumetricWeight  float64                `protobuf:"fixed64,2,opt,name=max_volumetric_weight,json=maxVolumetricWeight,proto3" json:"max_volumetric_weight,omitempty"`
+	MaxDimension         int32                  `protobuf:"varint,3,opt,name=max_dimension,json=maxDimension,proto3" json:"max_dimension,omitempty"`
+	MaxGirth             int32                  `protobuf:"varint,4,opt,name=max_girth,json=maxGirth,proto3" json:"max_girth,omitempty"`
+	Origins              []string               `protobuf:"bytes,5,rep,name=origins,proto3" json:"origins,omitempty"`
+	Destinations         []string               `protobuf:"bytes,6,rep,name=destinations,proto3" json:"destinations,omitempty"`
+	ProhibitedCategories []string               `protobuf:"bytes,7,rep,name=prohibited_categories,json=prohibitedCategories,proto3" json:"prohibited_categories,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TariffLimits) Reset() {
+	*x = TariffLimits{}
+	mi := &file_calculator_calculator_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffLimits) ProtoMessage() {}
+
+func (x *TariffLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffLimits.ProtoReflect.Descriptor instead.
+func (*TariffLimits) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TariffLimits) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *TariffLimits) GetMaxVolumetricWeight() float64 {
+	if x != nil {
+		return x.MaxVolumetricWeight
+	}
+	return 0
+}
+
+func (x *TariffLimits) GetMaxDimension() int32 {
+	if x != nil {
+		return x.MaxDimension
+	}
+	return 0
+}
+
+func (x *TariffLimits) GetMaxGirth() int32 {
+	if x != nil {
+		return x.MaxGirth
+	}
+	return 0
+}
+
+func (x *TariffLimits) GetOrigins() []string {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *TariffLimits) GetDestinations() []string {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *TariffLimits) GetProhibitedCategories() []string {
+	if x != nil {
+		return x.ProhibitedCategories
+	}
+	return nil
+}
+
+type TariffCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffCodeRequest) Reset() {
+	*x = TariffCodeRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffCodeRequest) ProtoMessage() {}
+
+func (x *TariffCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffCodeRequest.ProtoReflect.Descriptor instead.
+func (*TariffCodeRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TariffCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type UpdateTariffRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Code              string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ExpectedVersion   int32                  `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	Name              *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	BaseRate          *float64               `protobuf:"fixed64,4,opt,name=base_rate,json=baseRate,proto3,oneof" json:"base_rate,omitempty"`
+	PricePerKm        *float64               `protobuf:"fixed64,5,opt,name=price_per_km,json=pricePerKm,proto3,oneof" json:"price_per_km,omitempty"`
+	PricePerKg        *float64               `protobuf:"fixed64,6,opt,name=price_per_kg,json=pricePerKg,proto3,oneof" json:"price_per_kg,omitempty"`
+	Currency          *string                `protobuf:"bytes,7,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	VolumetricDivider *float64               `protobuf:"fixed64,8,opt,name=volumetric_divider,json=volumetricDivider,proto3,oneof" json:"volumetric_divider,omitempty"`
+	SpeedKmph         *int32                 `protobuf:"varint,9,opt,name=speed_kmph,json=speedKmph,proto3,oneof" json:"speed_kmph,omitempty"`
+	PickupSurcharge   *float64               `protobuf:"fixed64,10,opt,name=pickup_surcharge,json=pickupSurcharge,proto3,oneof" json:"pickup_surcharge,omitempty"`
+	PricingMode       *string                `protobuf:"bytes,11,opt,name=pricing_mode,json=pricingMode,proto3,oneof" json:"pricing_mode,omitempty"`
+	DistanceProvider  *string                `protobuf:"bytes,12,opt,name=distance_provider,json=distanceProvider,proto3,oneof" json:"distance_provider,omitempty"`
+	ValidFrom         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	Limits            *TariffLimits          `protobuf:"bytes,14,opt,name=limits,proto3" json:"limits,omitempty"`
+	FuelSurcharge     *bool                  `protobuf:"varint,15,opt,name=fuel_surcharge,json=fuelSurcharge,proto3,oneof" json:"fuel_surcharge,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateTariffRequest) Reset() {
+	*x = UpdateTariffRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTariffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTariffRequest) ProtoMessage() {}
+
+func (x *UpdateTariffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTariffRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTariffRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateTariffRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateTariffRequest) GetExpectedVersion() int32 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateTariffRequest) GetBaseRate() float64 {
+	if x != nil && x.BaseRate != nil {
+		return *x.BaseRate
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetPricePerKm() float64 {
+	if x != nil && x.PricePerKm != nil {
+		return *x.PricePerKm
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetPricePerKg() float64 {
+	if x != nil && x.PricePerKg != nil {
+		return *x.PricePerKg
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateTariffRequest) GetVolumetricDivider() float64 {
+	if x != nil && x.VolumetricDivider != nil {
+		return *x.VolumetricDivider
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetSpeedKmph() int32 {
+	if x != nil && x.SpeedKmph != nil {
+		return *x.SpeedKmph
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetPickupSurcharge() float64 {
+	if x != nil && x.PickupSurcharge != nil {
+		return *x.PickupSurcharge
+	}
+	return 0
+}
+
+func (x *UpdateTariffRequest) GetPricingMode() string {
+	if x != nil && x.PricingMode != nil {
+		return *x.PricingMode
+	}
+	return ""
+}
+
+func (x *UpdateTariffRequest) GetDistanceProvider() string {
+	if x != nil && x.DistanceProvider != nil {
+		return *x.DistanceProvider
+	}
+	return ""
+}
+
+func (x *UpdateTariffRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *UpdateTariffRequest) GetLimits() *TariffLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *UpdateTariffRequest) GetFuelSurcharge() bool {
+	if x != nil && x.FuelSurcharge != nil {
+		return *x.FuelSurcharge
+	}
+	return false
+}
+
+type TariffAuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TariffCode    string                 `protobuf:"bytes,1,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Actor         string                 `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	At            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	Before        *Tariff                `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After         *Tariff                `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffAuditEntry) Reset() {
+	*x = TariffAuditEntry{}
+	mi := &file_calculator_calculator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffAuditEntry) ProtoMessage() {}
+
+func (x *TariffAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffAuditEntry.ProtoReflect.Descriptor instead.
+func (*TariffAuditEntry) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TariffAuditEntry) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *TariffAuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TariffAuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TariffAuditEntry) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *TariffAuditEntry) GetBefore() *Tariff {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *TariffAuditEntry) GetAfter() *Tariff {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type TariffAuditList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TariffAuditEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffAuditList) Reset() {
+	*x = TariffAuditList{}
+	mi := &file_calculator_calculator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffAuditList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffAuditList) ProtoMessage() {}
+
+func (x *TariffAuditList) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffAuditList.ProtoReflect.Descriptor instead.
+func (*TariffAuditList) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TariffAuditList) GetEntries() []*TariffAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type Empty struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	mi := &file_calculator_calculator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{19}
 }
 
 type TariffListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tariffs       []*Tariff              `protobuf:"bytes,1,rep,name=tariffs,proto3" json:"tariffs,omitempty"`
+	Tariffs       []*Tariff              `protobuf:"bytes,1,rep,name=tariffs,proto3" json:"tariffs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TariffListResponse) Reset() {
+	*x = TariffListResponse{}
+	mi := &file_calculator_calculator_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TariffListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TariffListResponse) ProtoMessage() {}
+
+func (x *TariffListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TariffListResponse.ProtoReflect.Descriptor instead.
+func (*TariffListResponse) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TariffListResponse) GetTariffs() []*Tariff {
+	if x != nil {
+		return x.Tariffs
+	}
+	return nil
+}
+
+type RouteStop struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location       string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Latitude       float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude      float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Weight         float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	ServiceMinutes int32                  `protobuf:"varint,6,opt,name=service_minutes,json=serviceMinutes,proto3" json:"service_minutes,omitempty"`
+	WindowStart    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RouteStop) Reset() {
+	*x = RouteStop{}
+	mi := &file_calculator_calculator_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteStop) ProtoMessage() {}
+
+func (x *RouteStop) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteStop.ProtoReflect.Descriptor instead.
+func (*RouteStop) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RouteStop) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RouteStop) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *RouteStop) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RouteStop) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *RouteStop) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RouteStop) GetServiceMinutes() int32 {
+	if x != nil {
+		return x.ServiceMinutes
+	}
+	return 0
+}
+
+func (x *RouteStop) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *RouteStop) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+type OptimizeRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Depot         *RouteStop             `protobuf:"bytes,1,opt,name=depot,proto3" json:"depot,omitempty"`
+	Stops         []*RouteStop           `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	SpeedKmph     float64                `protobuf:"fixed64,4,opt,name=speed_kmph,json=speedKmph,proto3" json:"speed_kmph,omitempty"`
+	Capacity      float64                `protobuf:"fixed64,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	ReturnToDepot bool                   `protobuf:"varint,6,opt,name=return_to_depot,json=returnToDepot,proto3" json:"return_to_depot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OptimizeRouteRequest) Reset() {
+	*x = OptimizeRouteRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimizeRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeRouteRequest) ProtoMessage() {}
+
+func (x *OptimizeRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeRouteRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeRouteRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *OptimizeRouteRequest) GetDepot() *RouteStop {
+	if x != nil {
+		return x.Depot
+	}
+	return nil
+}
+
+func (x *OptimizeRouteRequest) GetStops() []*RouteStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *OptimizeRouteRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *OptimizeRouteRequest) GetSpeedKmph() float64 {
+	if x != nil {
+		return x.SpeedKmph
+	}
+	return 0
+}
+
+func (x *OptimizeRouteRequest) GetCapacity() float64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *OptimizeRouteRequest) GetReturnToDepot() bool {
+	if x != nil {
+		return x.ReturnToDepot
+	}
+	return false
+}
+
+type PlannedStop struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Stop                   *RouteStop             `protobuf:"bytes,1,opt,name=stop,proto3" json:"stop,omitempty"`
+	Sequence               int32                  `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	DistanceFromPreviousKm float64                `protobuf:"fixed64,3,opt,name=distance_from_previous_km,json=distanceFromPreviousKm,proto3" json:"distance_from_previous_km,omitempty"`
+	Eta                    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=eta,proto3" json:"eta,omitempty"`
+	Departure              *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=departure,proto3" json:"departure,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PlannedStop) Reset() {
+	*x = PlannedStop{}
+	mi := &file_calculator_calculator_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedStop) ProtoMessage() {}
+
+func (x *PlannedStop) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedStop.ProtoReflect.Descriptor instead.
+func (*PlannedStop) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PlannedStop) GetStop() *RouteStop {
+	if x != nil {
+		return x.Stop
+	}
+	return nil
+}
+
+func (x *PlannedStop) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PlannedStop) GetDistanceFromPreviousKm() float64 {
+	if x != nil {
+		return x.DistanceFromPreviousKm
+	}
+	return 0
+}
+
+func (x *PlannedStop) GetEta() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Eta
+	}
+	return nil
+}
+
+func (x *PlannedStop) GetDeparture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Departure
+	}
+	return nil
+}
+
+type OptimizeRouteResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Stops           []*PlannedStop         `protobuf:"bytes,1,rep,name=stops,proto3" json:"stops,omitempty"`
+	TotalDistanceKm float64                `protobuf:"fixed64,2,opt,name=total_distance_km,json=totalDistanceKm,proto3" json:"total_distance_km,omitempty"`
+	FinishTime      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	Unassigned      []*RouteStop           `protobuf:"bytes,4,rep,name=unassigned,proto3" json:"unassigned,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OptimizeRouteResponse) Reset() {
+	*x = OptimizeRouteResponse{}
+	mi := &file_calculator_calculator_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OptimizeRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeRouteResponse) ProtoMessage() {}
+
+func (x *OptimizeRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeRouteResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeRouteResponse) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OptimizeRouteResponse) GetStops() []*PlannedStop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *OptimizeRouteResponse) GetTotalDistanceKm() float64 {
+	if x != nil {
+		return x.TotalDistanceKm
+	}
+	return 0
+}
+
+func (x *OptimizeRouteResponse) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+func (x *OptimizeRouteResponse) GetUnassigned() []*RouteStop {
+	if x != nil {
+		return x.Unassigned
+	}
+	return nil
+}
+
+type Hub struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Hub) Reset() {
+	*x = Hub{}
+	mi := &file_calculator_calculator_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Hub) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hub) ProtoMessage() {}
+
+func (x *Hub) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hub.ProtoReflect.Descriptor instead.
+func (*Hub) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Hub) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Hub) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hub) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Hub) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Hub) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type HubLink struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TransitHours  float64                `protobuf:"fixed64,3,opt,name=transit_hours,json=transitHours,proto3" json:"transit_hours,omitempty"`
+	Cost          float64                `protobuf:"fixed64,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HubLink) Reset() {
+	*x = HubLink{}
+	mi := &file_calculator_calculator_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubLink) ProtoMessage() {}
+
+func (x *HubLink) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HubLink.ProtoReflect.Descriptor instead.
+func (*HubLink) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HubLink) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HubLink) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *HubLink) GetTransitHours() float64 {
+	if x != nil {
+		return x.TransitHours
+	}
+	return 0
+}
+
+func (x *HubLink) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+type HubRouteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HubRouteRequest) Reset() {
+	*x = HubRouteRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubRouteRequest) ProtoMessage() {}
+
+func (x *HubRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HubRouteRequest.ProtoReflect.Descriptor instead.
+func (*HubRouteRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HubRouteRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *HubRouteRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type HubRouteResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Legs            []*RouteLeg            `protobuf:"bytes,1,rep,name=legs,proto3" json:"legs,omitempty"`
+	TotalDistanceKm float64                `protobuf:"fixed64,2,opt,name=total_distance_km,json=totalDistanceKm,proto3" json:"total_distance_km,omitempty"`
+	TotalHours      float64                `protobuf:"fixed64,3,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	TotalCost       float64                `protobuf:"fixed64,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HubRouteResponse) Reset() {
+	*x = HubRouteResponse{}
+	mi := &file_calculator_calculator_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HubRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HubRouteResponse) ProtoMessage() {}
+
+func (x *HubRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HubRouteResponse.ProtoReflect.Descriptor instead.
+func (*HubRouteResponse) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HubRouteResponse) GetLegs() []*RouteLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *HubRouteResponse) GetTotalDistanceKm() float64 {
+	if x != nil {
+		return x.TotalDistanceKm
+	}
+	return 0
+}
+
+func (x *HubRouteResponse) GetTotalHours() float64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+func (x *HubRouteResponse) GetTotalCost() float64 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+type HourRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          int32                  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            int32                  `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourRange) Reset() {
+	*x = HourRange{}
+	mi := &file_calculator_calculator_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourRange) ProtoMessage() {}
+
+func (x *HourRange) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourRange.ProtoReflect.Descriptor instead.
+func (*HourRange) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HourRange) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *HourRange) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type Band struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Band) Reset() {
+	*x = Band{}
+	mi := &file_calculator_calculator_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Band) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Band) ProtoMessage() {}
+
+func (x *Band) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Band.ProtoReflect.Descriptor instead.
+func (*Band) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Band) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *Band) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type SurchargeCondition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         *HourRange             `protobuf:"bytes,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	Weekdays      []int32                `protobuf:"varint,2,rep,packed,name=weekdays,proto3" json:"weekdays,omitempty"`
+	Distance      *Band                  `protobuf:"bytes,3,opt,name=distance,proto3" json:"distance,omitempty"`
+	Weight        *Band                  `protobuf:"bytes,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	FromZones     []string               `protobuf:"bytes,5,rep,name=from_zones,json=fromZones,proto3" json:"from_zones,omitempty"`
+	ToZones       []string               `protobuf:"bytes,6,rep,name=to_zones,json=toZones,proto3" json:"to_zones,omitempty"`
+	From          string                 `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	Tariffs       []string               `protobuf:"bytes,9,rep,name=tariffs,proto3" json:"tariffs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurchargeCondition) Reset() {
+	*x = SurchargeCondition{}
+	mi := &file_calculator_calculator_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurchargeCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurchargeCondition) ProtoMessage() {}
+
+func (x *SurchargeCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurchargeCondition.ProtoReflect.Descriptor instead.
+func (*SurchargeCondition) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SurchargeCondition) GetHours() *HourRange {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *SurchargeCondition) GetWeekdays() []int32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *SurchargeCondition) GetDistance() *Band {
+	if x != nil {
+		return x.Distance
+	}
+	return nil
+}
+
+func (x *SurchargeCondition) GetWeight() *Band {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *SurchargeCondition) GetFromZones() []string {
+	if x != nil {
+		return x.FromZones
+	}
+	return nil
+}
+
+func (x *SurchargeCondition) GetToZones() []string {
+	if x != nil {
+		return x.ToZones
+	}
+	return nil
+}
+
+func (x *SurchargeCondition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SurchargeCondition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *SurchargeCondition) GetTariffs() []string {
+	if x != nil {
+		return x.Tariffs
+	}
+	return nil
+}
+
+type SurchargeAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurchargeAction) Reset() {
+	*x = SurchargeAction{}
+	mi := &file_calculator_calculator_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurchargeAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurchargeAction) ProtoMessage() {}
+
+func (x *SurchargeAction) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurchargeAction.ProtoReflect.Descriptor instead.
+func (*SurchargeAction) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SurchargeAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SurchargeAction) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SurchargeAction) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type SurchargeRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Enabled       bool                   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Condition     *SurchargeCondition    `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	Action        *SurchargeAction       `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurchargeRule) Reset() {
+	*x = SurchargeRule{}
+	mi := &file_calculator_calculator_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurchargeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurchargeRule) ProtoMessage() {}
+
+func (x *SurchargeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurchargeRule.ProtoReflect.Descriptor instead.
+func (*SurchargeRule) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SurchargeRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SurchargeRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SurchargeRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SurchargeRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SurchargeRule) GetCondition() *SurchargeCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *SurchargeRule) GetAction() *SurchargeAction {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type SurchargeRuleList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*SurchargeRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurchargeRuleList) Reset() {
+	*x = SurchargeRuleList{}
+	mi := &file_calculator_calculator_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurchargeRuleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurchargeRuleList) ProtoMessage() {}
+
+func (x *SurchargeRuleList) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurchargeRuleList.ProtoReflect.Descriptor instead.
+func (*SurchargeRuleList) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SurchargeRuleList) GetRules() []*SurchargeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SurchargeRuleID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SurchargeRuleID) Reset() {
+	*x = SurchargeRuleID{}
+	mi := &file_calculator_calculator_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SurchargeRuleID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SurchargeRuleID) ProtoMessage() {}
+
+func (x *SurchargeRuleID) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SurchargeRuleID.ProtoReflect.Descriptor instead.
+func (*SurchargeRuleID) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SurchargeRuleID) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ZoneRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TariffCode    string                 `protobuf:"bytes,1,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	FromZone      string                 `protobuf:"bytes,2,opt,name=from_zone,json=fromZone,proto3" json:"from_zone,omitempty"`
+	ToZone        string                 `protobuf:"bytes,3,opt,name=to_zone,json=toZone,proto3" json:"to_zone,omitempty"`
+	BaseRate      float64                `protobuf:"fixed64,4,opt,name=base_rate,json=baseRate,proto3" json:"base_rate,omitempty"`
+	PricePerKg    float64                `protobuf:"fixed64,5,opt,name=price_per_kg,json=pricePerKg,proto3" json:"price_per_kg,omitempty"`
+	TransitDays   int32                  `protobuf:"varint,6,opt,name=transit_days,json=transitDays,proto3" json:"transit_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneRate) Reset() {
+	*x = ZoneRate{}
+	mi := &file_calculator_calculator_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneRate) ProtoMessage() {}
+
+func (x *ZoneRate) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneRate.ProtoReflect.Descriptor instead.
+func (*ZoneRate) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ZoneRate) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *ZoneRate) GetFromZone() string {
+	if x != nil {
+		return x.FromZone
+	}
+	return ""
+}
+
+func (x *ZoneRate) GetToZone() string {
+	if x != nil {
+		return x.ToZone
+	}
+	return ""
+}
+
+func (x *ZoneRate) GetBaseRate() float64 {
+	if x != nil {
+		return x.BaseRate
+	}
+	return 0
+}
+
+func (x *ZoneRate) GetPricePerKg() float64 {
+	if x != nil {
+		return x.PricePerKg
+	}
+	return 0
+}
+
+func (x *ZoneRate) GetTransitDays() int32 {
+	if x != nil {
+		return x.TransitDays
+	}
+	return 0
+}
+
+type ZoneRateKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TariffCode    string                 `protobuf:"bytes,1,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	FromZone      string                 `protobuf:"bytes,2,opt,name=from_zone,json=fromZone,proto3" json:"from_zone,omitempty"`
+	ToZone        string                 `protobuf:"bytes,3,opt,name=to_zone,json=toZone,proto3" json:"to_zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneRateKey) Reset() {
+	*x = ZoneRateKey{}
+	mi := &file_calculator_calculator_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneRateKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneRateKey) ProtoMessage() {}
+
+func (x *ZoneRateKey) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneRateKey.ProtoReflect.Descriptor instead.
+func (*ZoneRateKey) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ZoneRateKey) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *ZoneRateKey) GetFromZone() string {
+	if x != nil {
+		return x.FromZone
+	}
+	return ""
+}
+
+func (x *ZoneRateKey) GetToZone() string {
+	if x != nil {
+		return x.ToZone
+	}
+	return ""
+}
+
+type ZoneMatrix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ZoneRate            `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneMatrix) Reset() {
+	*x = ZoneMatrix{}
+	mi := &file_calculator_calculator_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneMatrix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneMatrix) ProtoMessage() {}
+
+func (x *ZoneMatrix) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneMatrix.ProtoReflect.Descriptor instead.
+func (*ZoneMatrix) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ZoneMatrix) GetRates() []*ZoneRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type ExchangeRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Quote         string                 `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	mi := &file_calculator_calculator_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExchangeRate) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ExchangeRate) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type ExchangeRateList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*ExchangeRate        `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExchangeRateList) Reset() {
+	*x = ExchangeRateList{}
+	mi := &file_calculator_calculator_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExchangeRateList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRateList) ProtoMessage() {}
+
+func (x *ExchangeRateList) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRateList.ProtoReflect.Descriptor instead.
+func (*ExchangeRateList) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExchangeRateList) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type FuelIndexEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Percent       float64                `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelIndexEntry) Reset() {
+	*x = FuelIndexEntry{}
+	mi := &file_calculator_calculator_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelIndexEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelIndexEntry) ProtoMessage() {}
+
+func (x *FuelIndexEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelIndexEntry.ProtoReflect.Descriptor instead.
+func (*FuelIndexEntry) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FuelIndexEntry) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *FuelIndexEntry) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type FuelIndexList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*FuelIndexEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FuelIndexList) Reset() {
+	*x = FuelIndexList{}
+	mi := &file_calculator_calculator_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuelIndexList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuelIndexList) ProtoMessage() {}
+
+func (x *FuelIndexList) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuelIndexList.ProtoReflect.Descriptor instead.
+func (*FuelIndexList) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FuelIndexList) GetEntries() []*FuelIndexEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type PromoRoute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoRoute) Reset() {
+	*x = PromoRoute{}
+	mi := &file_calculator_calculator_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoRoute) ProtoMessage() {}
+
+func (x *PromoRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoRoute.ProtoReflect.Descriptor instead.
+func (*PromoRoute) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PromoRoute) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PromoRoute) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type Promotion struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Description    string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Value          float64                `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Tariffs        []string               `protobuf:"bytes,6,rep,name=tariffs,proto3" json:"tariffs,omitempty"`
+	Routes         []*PromoRoute          `protobuf:"bytes,7,rep,name=routes,proto3" json:"routes,omitempty"`
+	ValidFrom      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	MaxUses        int32                  `protobuf:"varint,10,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser int32                  `protobuf:"varint,11,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	FirstOrderOnly bool                   `protobuf:"varint,12,opt,name=first_order_only,json=firstOrderOnly,proto3" json:"first_order_only,omitempty"`
+	Used           int32                  `protobuf:"varint,13,opt,name=used,proto3" json:"used,omitempty"`
+	Active         bool                   `protobuf:"varint,14,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	mi := &file_calculator_calculator_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Promotion) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Promotion) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Promotion) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Promotion) GetTariffs() []string {
+	if x != nil {
+		return x.Tariffs
+	}
+	return nil
+}
+
+func (x *Promotion) GetRoutes() []*PromoRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *Promotion) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Promotion) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *Promotion) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *Promotion) GetFirstOrderOnly() bool {
+	if x != nil {
+		return x.FirstOrderOnly
+	}
+	return false
+}
+
+func (x *Promotion) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *Promotion) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type PromotionList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Promotions    []*Promotion           `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromotionList) Reset() {
+	*x = PromotionList{}
+	mi := &file_calculator_calculator_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionList) ProtoMessage() {}
+
+func (x *PromotionList) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionList.ProtoReflect.Descriptor instead.
+func (*PromotionList) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PromotionList) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+type SetPromotionActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Active        bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPromotionActiveRequest) Reset() {
+	*x = SetPromotionActiveRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPromotionActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPromotionActiveRequest) ProtoMessage() {}
+
+func (x *SetPromotionActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPromotionActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetPromotionActiveRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SetPromotionActiveRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetPromotionActiveRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type RedeemPromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PackageId     string                 `protobuf:"bytes,3,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	TariffCode    string                 `protobuf:"bytes,4,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	From          string                 `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	FirstOrder    bool                   `protobuf:"varint,7,opt,name=first_order,json=firstOrder,proto3" json:"first_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemPromoRequest) Reset() {
+	*x = RedeemPromoRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemPromoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemPromoRequest) ProtoMessage() {}
+
+func (x *RedeemPromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemPromoRequest.ProtoReflect.Descriptor instead.
+func (*RedeemPromoRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RedeemPromoRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemPromoRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RedeemPromoRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *RedeemPromoRequest) GetTariffCode() string {
+	if x != nil {
+		return x.TariffCode
+	}
+	return ""
+}
+
+func (x *RedeemPromoRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RedeemPromoRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *RedeemPromoRequest) GetFirstOrder() bool {
+	if x != nil {
+		return x.FirstOrder
+	}
+	return false
+}
+
+type ReleasePromoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	PackageId     string                 `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TariffListResponse) Reset() {
-	*x = TariffListResponse{}
-	mi := &file_calculator_calculator_proto_msgTypes[7]
+func (x *ReleasePromoRequest) Reset() {
+	*x = ReleasePromoRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TariffListResponse) String() string {
+func (x *ReleasePromoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TariffListResponse) ProtoMessage() {}
+func (*ReleasePromoRequest) ProtoMessage() {}
 
-func (x *TariffListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_calculator_calculator_proto_msgTypes[7]
+func (x *ReleasePromoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,14 +3767,321 @@ func (x *TariffListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TariffListResponse.ProtoReflect.Descriptor instead.
-func (*TariffListResponse) Descriptor() ([]byte, []int) {
-	return file_calculator_calculator_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReleasePromoRequest.ProtoReflect.Descriptor instead.
+func (*ReleasePromoRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *TariffListResponse) GetTariffs() []*Tariff {
+func (x *ReleasePromoRequest) GetCode() string {
 	if x != nil {
-		return x.Tariffs
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReleasePromoRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+type City struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Zone          string                 `protobuf:"bytes,5,opt,name=zone,proto3" json:"zone,omitempty"`
+	Aliases       []string               `protobuf:"bytes,6,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,7,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	mi := &file_calculator_calculator_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *City) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *City) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *City) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *City) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *City) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *City) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+func (x *City) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+type CityList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cities        []*City                `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityList) Reset() {
+	*x = CityList{}
+	mi := &file_calculator_calculator_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityList) ProtoMessage() {}
+
+func (x *CityList) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityList.ProtoReflect.Descriptor instead.
+func (*CityList) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CityList) GetCities() []*City {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type CitySearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CitySearchRequest) Reset() {
+	*x = CitySearchRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CitySearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CitySearchRequest) ProtoMessage() {}
+
+func (x *CitySearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CitySearchRequest.ProtoReflect.Descriptor instead.
+func (*CitySearchRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CitySearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *CitySearchRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearestCitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearestCitiesRequest) Reset() {
+	*x = NearestCitiesRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestCitiesRequest) ProtoMessage() {}
+
+func (x *NearestCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestCitiesRequest.ProtoReflect.Descriptor instead.
+func (*NearestCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *NearestCitiesRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *NearestCitiesRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *NearestCitiesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CityAliasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aliases       []string               `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityAliasesRequest) Reset() {
+	*x = CityAliasesRequest{}
+	mi := &file_calculator_calculator_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityAliasesRequest) ProtoMessage() {}
+
+func (x *CityAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_calculator_calculator_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityAliasesRequest.ProtoReflect.Descriptor instead.
+func (*CityAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_calculator_calculator_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CityAliasesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CityAliasesRequest) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
 	}
 	return nil
 }
@@ -538,7 +4091,7 @@ var File_calculator_calculator_proto protoreflect.FileDescriptor
 const file_calculator_calculator_proto_rawDesc = "" +
 	"\n" +
 	"\x1bcalculator/calculator.proto\x12\n" +
-	"calculator\"\xba\x01\n" +
+	"calculator\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb6\x02\n" +
 	"\x1cCalculateDeliveryCostRequest\x12\x16\n" +
 	"\x06weight\x18\x01 \x01(\x01R\x06weight\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
@@ -546,11 +4099,73 @@ const file_calculator_calculator_proto_rawDesc = "" +
 	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x16\n" +
 	"\x06length\x18\x05 \x01(\x05R\x06length\x12\x14\n" +
 	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
-	"\x06height\x18\a \x01(\x05R\x06height\"x\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x16\n" +
+	"\x06pickup\x18\b \x01(\bR\x06pickup\x12'\n" +
+	"\x0ftarget_currency\x18\t \x01(\tR\x0etargetCurrency\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\n" +
+	" \x01(\tR\tpromoCode\x12\x1a\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\"\xfe\x06\n" +
 	"\x1dCalculateDeliveryCostResponse\x12\x12\n" +
 	"\x04cost\x18\x01 \x01(\x01R\x04cost\x12'\n" +
 	"\x0festimated_hours\x18\x02 \x01(\x05R\x0eestimatedHours\x12\x1a\n" +
-	"\bcurrency\x18\x03 \x01(\tR\bcurrency\"\xd7\x01\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12(\n" +
+	"\x04legs\x18\x04 \x03(\v2\x14.calculator.RouteLegR\x04legs\x12%\n" +
+	"\x0etariff_version\x18\x05 \x01(\x05R\rtariffVersion\x12\x19\n" +
+	"\bquote_id\x18\x06 \x01(\tR\aquoteId\x12D\n" +
+	"\x10quote_expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0equoteExpiresAt\x123\n" +
+	"\n" +
+	"line_items\x18\b \x03(\v2\x14.calculator.LineItemR\tlineItems\x12\x1f\n" +
+	"\vdistance_km\x18\t \x01(\x01R\n" +
+	"distanceKm\x12+\n" +
+	"\x11chargeable_weight\x18\n" +
+	" \x01(\x01R\x10chargeableWeight\x12+\n" +
+	"\x11volumetric_weight\x18\v \x01(\x01R\x10volumetricWeight\x127\n" +
+	"\tpriced_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bpricedAt\x12#\n" +
+	"\roriginal_cost\x18\r \x01(\x01R\foriginalCost\x12+\n" +
+	"\x11original_currency\x18\x0e \x01(\tR\x10originalCurrency\x12#\n" +
+	"\rexchange_rate\x18\x0f \x01(\x01R\fexchangeRate\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x10 \x01(\tR\tpromoCode\x12%\n" +
+	"\x0epromo_rejected\x18\x11 \x01(\tR\rpromoRejected\x12?\n" +
+	"\rdelivery_from\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\fdeliveryFrom\x12;\n" +
+	"\vdelivery_to\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deliveryTo\x12.\n" +
+	"\x13fuel_surcharge_rate\x18\x14 \x01(\x01R\x11fuelSurchargeRate\"Y\n" +
+	"\vUnknownCity\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vsuggestions\x18\x03 \x03(\tR\vsuggestions\"G\n" +
+	"\n" +
+	"Ineligible\x12\x1f\n" +
+	"\vtariff_code\x18\x01 \x01(\tR\n" +
+	"tariffCode\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\"X\n" +
+	"\bLineItem\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\xaf\x02\n" +
+	"\x12VerifyQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12\x16\n" +
+	"\x06weight\x18\x02 \x01(\x01R\x06weight\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x16\n" +
+	"\x06length\x18\x05 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\a \x01(\x05R\x06height\x12\x1f\n" +
+	"\vtariff_code\x18\b \x01(\tR\n" +
+	"tariffCode\x12\x16\n" +
+	"\x06pickup\x18\t \x01(\bR\x06pickup\x12'\n" +
+	"\x0ftarget_currency\x18\n" +
+	" \x01(\tR\x0etargetCurrency\x12\x1a\n" +
+	"\bcategory\x18\v \x01(\tR\bcategory\"\x96\x01\n" +
+	"\bRouteLeg\x12\x19\n" +
+	"\bfrom_hub\x18\x01 \x01(\tR\afromHub\x12\x15\n" +
+	"\x06to_hub\x18\x02 \x01(\tR\x05toHub\x12\x1f\n" +
+	"\vdistance_km\x18\x03 \x01(\x01R\n" +
+	"distanceKm\x12#\n" +
+	"\rtransit_hours\x18\x04 \x01(\x01R\ftransitHours\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\"\xd3\x02\n" +
 	"\x18CalculateByTariffRequest\x12\x16\n" +
 	"\x06weight\x18\x01 \x01(\x01R\x06weight\x12\x12\n" +
 	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
@@ -560,8 +4175,52 @@ const file_calculator_calculator_proto_rawDesc = "" +
 	"\x05width\x18\x06 \x01(\x05R\x05width\x12\x16\n" +
 	"\x06height\x18\a \x01(\x05R\x06height\x12\x1f\n" +
 	"\vtariff_code\x18\b \x01(\tR\n" +
-	"tariffCode\"\x13\n" +
-	"\x11TariffListRequest\"\xfb\x01\n" +
+	"tariffCode\x12\x16\n" +
+	"\x06pickup\x18\t \x01(\bR\x06pickup\x12'\n" +
+	"\x0ftarget_currency\x18\n" +
+	" \x01(\tR\x0etargetCurrency\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\v \x01(\tR\tpromoCode\x12\x1a\n" +
+	"\bcategory\x18\f \x01(\tR\bcategory\"|\n" +
+	"\x15BatchCalculateRequest\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x12<\n" +
+	"\x06parcel\x18\x02 \x01(\v2$.calculator.CalculateByTariffRequestR\x06parcel\"\xb7\x01\n" +
+	"\x16BatchCalculateResponse\x12%\n" +
+	"\x0ecorrelation_id\x18\x01 \x01(\tR\rcorrelationId\x12A\n" +
+	"\x06result\x18\x02 \x01(\v2).calculator.CalculateDeliveryCostResponseR\x06result\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x03 \x01(\tR\terrorCode\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xfd\x03\n" +
+	"\vTariffQuote\x12\x1f\n" +
+	"\vtariff_code\x18\x01 \x01(\tR\n" +
+	"tariffCode\x12\x1f\n" +
+	"\vtariff_name\x18\x02 \x01(\tR\n" +
+	"tariffName\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x01R\x04cost\x12'\n" +
+	"\x0festimated_hours\x18\x04 \x01(\x05R\x0eestimatedHours\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12%\n" +
+	"\x0etariff_version\x18\x06 \x01(\x05R\rtariffVersion\x12\x1a\n" +
+	"\beligible\x18\a \x01(\bR\beligible\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x1a\n" +
+	"\bcheapest\x18\t \x01(\bR\bcheapest\x12\x18\n" +
+	"\afastest\x18\n" +
+	" \x01(\bR\afastest\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\v \x01(\tR\tpromoCode\x12%\n" +
+	"\x0epromo_rejected\x18\f \x01(\tR\rpromoRejected\x12?\n" +
+	"\rdelivery_from\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\fdeliveryFrom\x12;\n" +
+	"\vdelivery_to\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deliveryTo\"J\n" +
+	"\x17QuoteAllTariffsResponse\x12/\n" +
+	"\x06quotes\x18\x01 \x03(\v2\x17.calculator.TariffQuoteR\x06quotes\"\xb1\x01\n" +
+	"\x11TariffListRequest\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x01R\x06weight\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategory\"\xdb\x04\n" +
 	"\x06Tariff\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -573,18 +4232,297 @@ const file_calculator_calculator_proto_rawDesc = "" +
 	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12-\n" +
 	"\x12volumetric_divider\x18\a \x01(\x01R\x11volumetricDivider\x12\x1d\n" +
 	"\n" +
-	"speed_kmph\x18\b \x01(\x05R\tspeedKmph\"'\n" +
+	"speed_kmph\x18\b \x01(\x05R\tspeedKmph\x12)\n" +
+	"\x10pickup_surcharge\x18\t \x01(\x01R\x0fpickupSurcharge\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"valid_from\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12!\n" +
+	"\fpricing_mode\x18\r \x01(\tR\vpricingMode\x12+\n" +
+	"\x11distance_provider\x18\x0e \x01(\tR\x10distanceProvider\x120\n" +
+	"\x06limits\x18\x0f \x01(\v2\x18.calculator.TariffLimitsR\x06limits\x12%\n" +
+	"\x0efuel_surcharge\x18\x10 \x01(\bR\rfuelSurcharge\"\x96\x02\n" +
+	"\fTariffLimits\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x01 \x01(\x01R\tmaxWeight\x122\n" +
+	"\x15max_volumetric_weight\x18\x02 \x01(\x01R\x13maxVolumetricWeight\x12#\n" +
+	"\rmax_dimension\x18\x03 \x01(\x05R\fmaxDimension\x12\x1b\n" +
+	"\tmax_girth\x18\x04 \x01(\x05R\bmaxGirth\x12\x18\n" +
+	"\aorigins\x18\x05 \x03(\tR\aorigins\x12\"\n" +
+	"\fdestinations\x18\x06 \x03(\tR\fdestinations\x123\n" +
+	"\x15prohibited_categories\x18\a \x03(\tR\x14prohibitedCategories\"'\n" +
 	"\x11TariffCodeRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"\a\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\xb4\x06\n" +
+	"\x13UpdateTariffRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x05R\x0fexpectedVersion\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12 \n" +
+	"\tbase_rate\x18\x04 \x01(\x01H\x01R\bbaseRate\x88\x01\x01\x12%\n" +
+	"\fprice_per_km\x18\x05 \x01(\x01H\x02R\n" +
+	"pricePerKm\x88\x01\x01\x12%\n" +
+	"\fprice_per_kg\x18\x06 \x01(\x01H\x03R\n" +
+	"pricePerKg\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\a \x01(\tH\x04R\bcurrency\x88\x01\x01\x122\n" +
+	"\x12volumetric_divider\x18\b \x01(\x01H\x05R\x11volumetricDivider\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"speed_kmph\x18\t \x01(\x05H\x06R\tspeedKmph\x88\x01\x01\x12.\n" +
+	"\x10pickup_surcharge\x18\n" +
+	" \x01(\x01H\aR\x0fpickupSurcharge\x88\x01\x01\x12&\n" +
+	"\fpricing_mode\x18\v \x01(\tH\bR\vpricingMode\x88\x01\x01\x120\n" +
+	"\x11distance_provider\x18\f \x01(\tH\tR\x10distanceProvider\x88\x01\x01\x129\n" +
+	"\n" +
+	"valid_from\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x120\n" +
+	"\x06limits\x18\x0e \x01(\v2\x18.calculator.TariffLimitsR\x06limits\x12*\n" +
+	"\x0efuel_surcharge\x18\x0f \x01(\bH\n" +
+	"R\rfuelSurcharge\x88\x01\x01B\a\n" +
+	"\x05_nameB\f\n" +
+	"\n" +
+	"_base_rateB\x0f\n" +
+	"\r_price_per_kmB\x0f\n" +
+	"\r_price_per_kgB\v\n" +
+	"\t_currencyB\x15\n" +
+	"\x13_volumetric_dividerB\r\n" +
+	"\v_speed_kmphB\x13\n" +
+	"\x11_pickup_surchargeB\x0f\n" +
+	"\r_pricing_modeB\x14\n" +
+	"\x12_distance_providerB\x11\n" +
+	"\x0f_fuel_surcharge\"\xe3\x01\n" +
+	"\x10TariffAuditEntry\x12\x1f\n" +
+	"\vtariff_code\x18\x01 \x01(\tR\n" +
+	"tariffCode\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x14\n" +
+	"\x05actor\x18\x03 \x01(\tR\x05actor\x12*\n" +
+	"\x02at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12*\n" +
+	"\x06before\x18\x05 \x01(\v2\x12.calculator.TariffR\x06before\x12(\n" +
+	"\x05after\x18\x06 \x01(\v2\x12.calculator.TariffR\x05after\"I\n" +
+	"\x0fTariffAuditList\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.calculator.TariffAuditEntryR\aentries\"\a\n" +
 	"\x05Empty\"B\n" +
 	"\x12TariffListResponse\x12,\n" +
-	"\atariffs\x18\x01 \x03(\v2\x12.calculator.TariffR\atariffs2\xb5\x03\n" +
+	"\atariffs\x18\x01 \x03(\v2\x12.calculator.TariffR\atariffs\"\xac\x02\n" +
+	"\tRouteStop\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\blocation\x18\x02 \x01(\tR\blocation\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\x12'\n" +
+	"\x0fservice_minutes\x18\x06 \x01(\x05R\x0eserviceMinutes\x12=\n" +
+	"\fwindow_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\"\x8e\x02\n" +
+	"\x14OptimizeRouteRequest\x12+\n" +
+	"\x05depot\x18\x01 \x01(\v2\x15.calculator.RouteStopR\x05depot\x12+\n" +
+	"\x05stops\x18\x02 \x03(\v2\x15.calculator.RouteStopR\x05stops\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1d\n" +
+	"\n" +
+	"speed_kmph\x18\x04 \x01(\x01R\tspeedKmph\x12\x1a\n" +
+	"\bcapacity\x18\x05 \x01(\x01R\bcapacity\x12&\n" +
+	"\x0freturn_to_depot\x18\x06 \x01(\bR\rreturnToDepot\"\xf7\x01\n" +
+	"\vPlannedStop\x12)\n" +
+	"\x04stop\x18\x01 \x01(\v2\x15.calculator.RouteStopR\x04stop\x12\x1a\n" +
+	"\bsequence\x18\x02 \x01(\x05R\bsequence\x129\n" +
+	"\x19distance_from_previous_km\x18\x03 \x01(\x01R\x16distanceFromPreviousKm\x12,\n" +
+	"\x03eta\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x03eta\x128\n" +
+	"\tdeparture\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdeparture\"\xe6\x01\n" +
+	"\x15OptimizeRouteResponse\x12-\n" +
+	"\x05stops\x18\x01 \x03(\v2\x17.calculator.PlannedStopR\x05stops\x12*\n" +
+	"\x11total_distance_km\x18\x02 \x01(\x01R\x0ftotalDistanceKm\x12;\n" +
+	"\vfinish_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishTime\x125\n" +
+	"\n" +
+	"unassigned\x18\x04 \x03(\v2\x15.calculator.RouteStopR\n" +
+	"unassigned\"\x81\x01\n" +
+	"\x03Hub\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"f\n" +
+	"\aHubLink\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12#\n" +
+	"\rtransit_hours\x18\x03 \x01(\x01R\ftransitHours\x12\x12\n" +
+	"\x04cost\x18\x04 \x01(\x01R\x04cost\"5\n" +
+	"\x0fHubRouteRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xa8\x01\n" +
+	"\x10HubRouteResponse\x12(\n" +
+	"\x04legs\x18\x01 \x03(\v2\x14.calculator.RouteLegR\x04legs\x12*\n" +
+	"\x11total_distance_km\x18\x02 \x01(\x01R\x0ftotalDistanceKm\x12\x1f\n" +
+	"\vtotal_hours\x18\x03 \x01(\x01R\n" +
+	"totalHours\x12\x1d\n" +
+	"\n" +
+	"total_cost\x18\x04 \x01(\x01R\ttotalCost\"/\n" +
+	"\tHourRange\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\x05R\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\x05R\x02to\"*\n" +
+	"\x04Band\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x01R\x03max\"\xad\x02\n" +
+	"\x12SurchargeCondition\x12+\n" +
+	"\x05hours\x18\x01 \x01(\v2\x15.calculator.HourRangeR\x05hours\x12\x1a\n" +
+	"\bweekdays\x18\x02 \x03(\x05R\bweekdays\x12,\n" +
+	"\bdistance\x18\x03 \x01(\v2\x10.calculator.BandR\bdistance\x12(\n" +
+	"\x06weight\x18\x04 \x01(\v2\x10.calculator.BandR\x06weight\x12\x1d\n" +
+	"\n" +
+	"from_zones\x18\x05 \x03(\tR\tfromZones\x12\x19\n" +
+	"\bto_zones\x18\x06 \x03(\tR\atoZones\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\b \x01(\tR\x02to\x12\x18\n" +
+	"\atariffs\x18\t \x03(\tR\atariffs\"S\n" +
+	"\x0fSurchargeAction\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"\xdc\x01\n" +
+	"\rSurchargeRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\x12\x18\n" +
+	"\aenabled\x18\x04 \x01(\bR\aenabled\x12<\n" +
+	"\tcondition\x18\x05 \x01(\v2\x1e.calculator.SurchargeConditionR\tcondition\x123\n" +
+	"\x06action\x18\x06 \x01(\v2\x1b.calculator.SurchargeActionR\x06action\"D\n" +
+	"\x11SurchargeRuleList\x12/\n" +
+	"\x05rules\x18\x01 \x03(\v2\x19.calculator.SurchargeRuleR\x05rules\"!\n" +
+	"\x0fSurchargeRuleID\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc3\x01\n" +
+	"\bZoneRate\x12\x1f\n" +
+	"\vtariff_code\x18\x01 \x01(\tR\n" +
+	"tariffCode\x12\x1b\n" +
+	"\tfrom_zone\x18\x02 \x01(\tR\bfromZone\x12\x17\n" +
+	"\ato_zone\x18\x03 \x01(\tR\x06toZone\x12\x1b\n" +
+	"\tbase_rate\x18\x04 \x01(\x01R\bbaseRate\x12 \n" +
+	"\fprice_per_kg\x18\x05 \x01(\x01R\n" +
+	"pricePerKg\x12!\n" +
+	"\ftransit_days\x18\x06 \x01(\x05R\vtransitDays\"d\n" +
+	"\vZoneRateKey\x12\x1f\n" +
+	"\vtariff_code\x18\x01 \x01(\tR\n" +
+	"tariffCode\x12\x1b\n" +
+	"\tfrom_zone\x18\x02 \x01(\tR\bfromZone\x12\x17\n" +
+	"\ato_zone\x18\x03 \x01(\tR\x06toZone\"8\n" +
+	"\n" +
+	"ZoneMatrix\x12*\n" +
+	"\x05rates\x18\x01 \x03(\v2\x14.calculator.ZoneRateR\x05rates\"|\n" +
+	"\fExchangeRate\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\tR\x05quote\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12.\n" +
+	"\x04date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\"B\n" +
+	"\x10ExchangeRateList\x12.\n" +
+	"\x05rates\x18\x01 \x03(\v2\x18.calculator.ExchangeRateR\x05rates\"m\n" +
+	"\x0eFuelIndexEntry\x12\x18\n" +
+	"\apercent\x18\x01 \x01(\x01R\apercent\x12A\n" +
+	"\x0eeffective_from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"E\n" +
+	"\rFuelIndexList\x124\n" +
+	"\aentries\x18\x01 \x03(\v2\x1a.calculator.FuelIndexEntryR\aentries\"0\n" +
+	"\n" +
+	"PromoRoute\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xdf\x03\n" +
+	"\tPromotion\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x01R\x05value\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x18\n" +
+	"\atariffs\x18\x06 \x03(\tR\atariffs\x12.\n" +
+	"\x06routes\x18\a \x03(\v2\x16.calculator.PromoRouteR\x06routes\x129\n" +
+	"\n" +
+	"valid_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12\x19\n" +
+	"\bmax_uses\x18\n" +
+	" \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\v \x01(\x05R\x0emaxUsesPerUser\x12(\n" +
+	"\x10first_order_only\x18\f \x01(\bR\x0efirstOrderOnly\x12\x12\n" +
+	"\x04used\x18\r \x01(\x05R\x04used\x12\x16\n" +
+	"\x06active\x18\x0e \x01(\bR\x06active\"F\n" +
+	"\rPromotionList\x125\n" +
+	"\n" +
+	"promotions\x18\x01 \x03(\v2\x15.calculator.PromotionR\n" +
+	"promotions\"G\n" +
+	"\x19SetPromotionActiveRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\"\xc6\x01\n" +
+	"\x12RedeemPromoRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x03 \x01(\tR\tpackageId\x12\x1f\n" +
+	"\vtariff_code\x18\x04 \x01(\tR\n" +
+	"tariffCode\x12\x12\n" +
+	"\x04from\x18\x05 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x06 \x01(\tR\x02to\x12\x1f\n" +
+	"\vfirst_order\x18\a \x01(\bR\n" +
+	"firstOrder\"H\n" +
+	"\x13ReleasePromoRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\tR\tpackageId\"\xb7\x01\n" +
+	"\x04City\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x04 \x01(\x01R\tlongitude\x12\x12\n" +
+	"\x04zone\x18\x05 \x01(\tR\x04zone\x12\x18\n" +
+	"\aaliases\x18\x06 \x03(\tR\aaliases\x12\x1f\n" +
+	"\vdistance_km\x18\a \x01(\x01R\n" +
+	"distanceKm\"4\n" +
+	"\bCityList\x12(\n" +
+	"\x06cities\x18\x01 \x03(\v2\x10.calculator.CityR\x06cities\"?\n" +
+	"\x11CitySearchRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"f\n" +
+	"\x14NearestCitiesRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"B\n" +
+	"\x12CityAliasesRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaliases\x18\x02 \x03(\tR\aaliases2\xb5\a\n" +
 	"\x11CalculatorService\x12l\n" +
 	"\x15CalculateDeliveryCost\x12(.calculator.CalculateDeliveryCostRequest\x1a).calculator.CalculateDeliveryCostResponse\x12h\n" +
-	"\x15CalculateByTariffCode\x12$.calculator.CalculateByTariffRequest\x1a).calculator.CalculateDeliveryCostResponse\x12N\n" +
-	"\rGetTariffList\x12\x1d.calculator.TariffListRequest\x1a\x1e.calculator.TariffListResponse\x126\n" +
-	"\fCreateTariff\x12\x12.calculator.Tariff\x1a\x12.calculator.Tariff\x12@\n" +
-	"\fDeleteTariff\x12\x1d.calculator.TariffCodeRequest\x1a\x11.calculator.EmptyBCZAgithub.com/maksroxx/DeliveryService/proto/calculator;calculatorpbb\x06proto3"
+	"\x15CalculateByTariffCode\x12$.calculator.CalculateByTariffRequest\x1a).calculator.CalculateDeliveryCostResponse\x12`\n" +
+	"\x0fQuoteAllTariffs\x12(.calculator.CalculateDeliveryCostRequest\x1a#.calculator.QuoteAllTariffsResponse\x12X\n" +
+	"\vVerifyQuote\x12\x1e.calculator.VerifyQuoteRequest\x1a).calculator.CalculateDeliveryCostResponse\x12[\n" +
+	"\x0eCalculateBatch\x12!.calculator.BatchCalculateRequest\x1a\".calculator.BatchCalculateResponse(\x010\x01\x12N\n" +
+	"\rGetTariffList\x12\x1d.calculator.TariffListRequest\x1a\x1e.calculator.TariffListResponse\x12R\n" +
+	"\x11GetTariffVersions\x12\x1d.calculator.TariffCodeRequest\x1a\x1e.calculator.TariffListResponse\x126\n" +
+	"\fCreateTariff\x12\x12.calculator.Tariff\x1a\x12.calculator.Tariff\x12C\n" +
+	"\fUpdateTariff\x12\x1f.calculator.UpdateTariffRequest\x1a\x12.calculator.Tariff\x12@\n" +
+	"\fDeleteTariff\x12\x1d.calculator.TariffCodeRequest\x1a\x11.calculator.Empty\x12L\n" +
+	"\x0eGetTariffAudit\x12\x1d.calculator.TariffCodeRequest\x1a\x1b.calculator.TariffAuditList2\xc7\x01\n" +
+	"\x11HubNetworkService\x12-\n" +
+	"\tCreateHub\x12\x0f.calculator.Hub\x1a\x0f.calculator.Hub\x129\n" +
+	"\rCreateHubLink\x12\x13.calculator.HubLink\x1a\x13.calculator.HubLink\x12H\n" +
+	"\vGetHubRoute\x12\x1b.calculator.HubRouteRequest\x1a\x1c.calculator.HubRouteResponse2m\n" +
+	"\x15RouteOptimizerService\x12T\n" +
+	"\rOptimizeRoute\x12 .calculator.OptimizeRouteRequest\x1a!.calculator.OptimizeRouteResponse2\xbf\x02\n" +
+	"\x14SurchargeRuleService\x12F\n" +
+	"\x12ListSurchargeRules\x12\x11.calculator.Empty\x1a\x1d.calculator.SurchargeRuleList\x12K\n" +
+	"\x13CreateSurchargeRule\x12\x19.calculator.SurchargeRule\x1a\x19.calculator.SurchargeRule\x12K\n" +
+	"\x13UpdateSurchargeRule\x12\x19.calculator.SurchargeRule\x1a\x19.calculator.SurchargeRule\x12E\n" +
+	"\x13DeleteSurchargeRule\x12\x1b.calculator.SurchargeRuleID\x1a\x11.calculator.Empty2\xd4\x01\n" +
+	"\x11ZoneMatrixService\x12F\n" +
+	"\rGetZoneMatrix\x12\x1d.calculator.TariffCodeRequest\x1a\x16.calculator.ZoneMatrix\x129\n" +
+	"\vSetZoneRate\x12\x14.calculator.ZoneRate\x1a\x14.calculator.ZoneRate\x12<\n" +
+	"\x0eDeleteZoneRate\x12\x17.calculator.ZoneRateKey\x1a\x11.calculator.Empty2\xab\x01\n" +
+	"\x13ExchangeRateService\x12D\n" +
+	"\x11ListExchangeRates\x12\x11.calculator.Empty\x1a\x1c.calculator.ExchangeRateList\x12N\n" +
+	"\x10SetExchangeRates\x12\x1c.calculator.ExchangeRateList\x1a\x1c.calculator.ExchangeRateList2\x9b\x01\n" +
+	"\x14FuelSurchargeService\x12=\n" +
+	"\rListFuelIndex\x12\x11.calculator.Empty\x1a\x19.calculator.FuelIndexList\x12D\n" +
+	"\fSetFuelIndex\x12\x19.calculator.FuelIndexList\x1a\x19.calculator.FuelIndexList2\xe9\x02\n" +
+	"\x10PromotionService\x12>\n" +
+	"\x0eListPromotions\x12\x11.calculator.Empty\x1a\x19.calculator.PromotionList\x12?\n" +
+	"\x0fCreatePromotion\x12\x15.calculator.Promotion\x1a\x15.calculator.Promotion\x12N\n" +
+	"\x12SetPromotionActive\x12%.calculator.SetPromotionActiveRequest\x1a\x11.calculator.Empty\x12@\n" +
+	"\vRedeemPromo\x12\x1e.calculator.RedeemPromoRequest\x1a\x11.calculator.Empty\x12B\n" +
+	"\fReleasePromo\x12\x1f.calculator.ReleasePromoRequest\x1a\x11.calculator.Empty2\xdf\x01\n" +
+	"\vCityService\x12C\n" +
+	"\fSearchCities\x12\x1d.calculator.CitySearchRequest\x1a\x14.calculator.CityList\x12G\n" +
+	"\rNearestCities\x12 .calculator.NearestCitiesRequest\x1a\x14.calculator.CityList\x12B\n" +
+	"\x0eSetCityAliases\x12\x1e.calculator.CityAliasesRequest\x1a\x10.calculator.CityBCZAgithub.com/maksroxx/DeliveryService/proto/calculator;calculatorpbb\x06proto3"
 
 var (
 	file_calculator_calculator_proto_rawDescOnce sync.Once
@@ -598,34 +4536,187 @@ func file_calculator_calculator_proto_rawDescGZIP() []byte {
 	return file_calculator_calculator_proto_rawDescData
 }
 
-var file_calculator_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_calculator_calculator_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_calculator_calculator_proto_goTypes = []any{
 	(*CalculateDeliveryCostRequest)(nil),  // 0: calculator.CalculateDeliveryCostRequest
 	(*CalculateDeliveryCostResponse)(nil), // 1: calculator.CalculateDeliveryCostResponse
-	(*CalculateByTariffRequest)(nil),      // 2: calculator.CalculateByTariffRequest
-	(*TariffListRequest)(nil),             // 3: calculator.TariffListRequest
-	(*Tariff)(nil),                        // 4: calculator.Tariff
-	(*TariffCodeRequest)(nil),             // 5: calculator.TariffCodeRequest
-	(*Empty)(nil),                         // 6: calculator.Empty
-	(*TariffListResponse)(nil),            // 7: calculator.TariffListResponse
+	(*UnknownCity)(nil),                   // 2: calculator.UnknownCity
+	(*Ineligible)(nil),                    // 3: calculator.Ineligible
+	(*LineItem)(nil),                      // 4: calculator.LineItem
+	(*VerifyQuoteRequest)(nil),            // 5: calculator.VerifyQuoteRequest
+	(*RouteLeg)(nil),                      // 6: calculator.RouteLeg
+	(*CalculateByTariffRequest)(nil),      // 7: calculator.CalculateByTariffRequest
+	(*BatchCalculateRequest)(nil),         // 8: calculator.BatchCalculateRequest
+	(*BatchCalculateResponse)(nil),        // 9: calculator.BatchCalculateResponse
+	(*TariffQuote)(nil),                   // 10: calculator.TariffQuote
+	(*QuoteAllTariffsResponse)(nil),       // 11: calculator.QuoteAllTariffsResponse
+	(*TariffListRequest)(nil),             // 12: calculator.TariffListRequest
+	(*Tariff)(nil),                        // 13: calculator.Tariff
+	(*TariffLimits)(nil),                  // 14: calculator.TariffLimits
+	(*TariffCodeRequest)(nil),             // 15: calculator.TariffCodeRequest
+	(*UpdateTariffRequest)(nil),           // 16: calculator.UpdateTariffRequest
+	(*TariffAuditEntry)(nil),              // 17: calculator.TariffAuditEntry
+	(*TariffAuditList)(nil),               // 18: calculator.TariffAuditList
+	(*Empty)(nil),                         // 19: calculator.Empty
+	(*TariffListResponse)(nil),            // 20: calculator.TariffListResponse
+	(*RouteStop)(nil),                     // 21: calculator.RouteStop
+	(*OptimizeRouteRequest)(nil),          // 22: calculator.OptimizeRouteRequest
+	(*PlannedStop)(nil),                   // 23: calculator.PlannedStop
+	(*OptimizeRouteResponse)(nil),         // 24: calculator.OptimizeRouteResponse
+	(*Hub)(nil),                           // 25: calculator.Hub
+	(*HubLink)(nil),                       // 26: calculator.HubLink
+	(*HubRouteRequest)(nil),               // 27: calculator.HubRouteRequest
+	(*HubRouteResponse)(nil),              // 28: calculator.HubRouteResponse
+	(*HourRange)(nil),                     // 29: calculator.HourRange
+	(*Band)(nil),                          // 30: calculator.Band
+	(*SurchargeCondition)(nil),            // 31: calculator.SurchargeCondition
+	(*SurchargeAction)(nil),               // 32: calculator.SurchargeAction
+	(*SurchargeRule)(nil),                 // 33: calculator.SurchargeRule
+	(*SurchargeRuleList)(nil),             // 34: calculator.SurchargeRuleList
+	(*SurchargeRuleID)(nil),               // 35: calculator.SurchargeRuleID
+	(*ZoneRate)(nil),                      // 36: calculator.ZoneRate
+	(*ZoneRateKey)(nil),                   // 37: calculator.ZoneRateKey
+	(*ZoneMatrix)(nil),                    // 38: calculator.ZoneMatrix
+	(*ExchangeRate)(nil),                  // 39: calculator.ExchangeRate
+	(*ExchangeRateList)(nil),              // 40: calculator.ExchangeRateList
+	(*FuelIndexEntry)(nil),                // 41: calculator.FuelIndexEntry
+	(*FuelIndexList)(nil),                 // 42: calculator.FuelIndexList
+	(*PromoRoute)(nil),                    // 43: calculator.PromoRoute
+	(*Promotion)(nil),                     // 44: calculator.Promotion
+	(*PromotionList)(nil),                 // 45: calculator.PromotionList
+	(*SetPromotionActiveRequest)(nil),     // 46: calculator.SetPromotionActiveRequest
+	(*RedeemPromoRequest)(nil),            // 47: calculator.RedeemPromoRequest
+	(*ReleasePromoRequest)(nil),           // 48: calculator.ReleasePromoRequest
+	(*City)(nil),                          // 49: calculator.City
+	(*CityList)(nil),                      // 50: calculator.CityList
+	(*CitySearchRequest)(nil),             // 51: calculator.CitySearchRequest
+	(*NearestCitiesRequest)(nil),          // 52: calculator.NearestCitiesRequest
+	(*CityAliasesRequest)(nil),            // 53: calculator.CityAliasesRequest
+	(*timestamppb.Timestamp)(nil),         // 54: google.protobuf.Timestamp
 }
 var file_calculator_calculator_proto_depIdxs = []int32{
-	4, // 0: calculator.TariffListResponse.tariffs:type_name -> calculator.Tariff
-	0, // 1: calculator.CalculatorService.CalculateDeliveryCost:input_type -> calculator.CalculateDeliveryCostRequest
-	2, // 2: calculator.CalculatorService.CalculateByTariffCode:input_type -> calculator.CalculateByTariffRequest
-	3, // 3: calculator.CalculatorService.GetTariffList:input_type -> calculator.TariffListRequest
-	4, // 4: calculator.CalculatorService.CreateTariff:input_type -> calculator.Tariff
-	5, // 5: calculator.CalculatorService.DeleteTariff:input_type -> calculator.TariffCodeRequest
-	1, // 6: calculator.CalculatorService.CalculateDeliveryCost:output_type -> calculator.CalculateDeliveryCostResponse
-	1, // 7: calculator.CalculatorService.CalculateByTariffCode:output_type -> calculator.CalculateDeliveryCostResponse
-	7, // 8: calculator.CalculatorService.GetTariffList:output_type -> calculator.TariffListResponse
-	4, // 9: calculator.CalculatorService.CreateTariff:output_type -> calculator.Tariff
-	6, // 10: calculator.CalculatorService.DeleteTariff:output_type -> calculator.Empty
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	6,  // 0: calculator.CalculateDeliveryCostResponse.legs:type_name -> calculator.RouteLeg
+	54, // 1: calculator.CalculateDeliveryCostResponse.quote_expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 2: calculator.CalculateDeliveryCostResponse.line_items:type_name -> calculator.LineItem
+	54, // 3: calculator.CalculateDeliveryCostResponse.priced_at:type_name -> google.protobuf.Timestamp
+	54, // 4: calculator.CalculateDeliveryCostResponse.delivery_from:type_name -> google.protobuf.Timestamp
+	54, // 5: calculator.CalculateDeliveryCostResponse.delivery_to:type_name -> google.protobuf.Timestamp
+	7,  // 6: calculator.BatchCalculateRequest.parcel:type_name -> calculator.CalculateByTariffRequest
+	1,  // 7: calculator.BatchCalculateResponse.result:type_name -> calculator.CalculateDeliveryCostResponse
+	54, // 8: calculator.TariffQuote.delivery_from:type_name -> google.protobuf.Timestamp
+	54, // 9: calculator.TariffQuote.delivery_to:type_name -> google.protobuf.Timestamp
+	10, // 10: calculator.QuoteAllTariffsResponse.quotes:type_name -> calculator.TariffQuote
+	54, // 11: calculator.Tariff.valid_from:type_name -> google.protobuf.Timestamp
+	54, // 12: calculator.Tariff.valid_to:type_name -> google.protobuf.Timestamp
+	14, // 13: calculator.Tariff.limits:type_name -> calculator.TariffLimits
+	54, // 14: calculator.UpdateTariffRequest.valid_from:type_name -> google.protobuf.Timestamp
+	14, // 15: calculator.UpdateTariffRequest.limits:type_name -> calculator.TariffLimits
+	54, // 16: calculator.TariffAuditEntry.at:type_name -> google.protobuf.Timestamp
+	13, // 17: calculator.TariffAuditEntry.before:type_name -> calculator.Tariff
+	13, // 18: calculator.TariffAuditEntry.after:type_name -> calculator.Tariff
+	17, // 19: calculator.TariffAuditList.entries:type_name -> calculator.TariffAuditEntry
+	13, // 20: calculator.TariffListResponse.tariffs:type_name -> calculator.Tariff
+	54, // 21: calculator.RouteStop.window_start:type_name -> google.protobuf.Timestamp
+	54, // 22: calculator.RouteStop.window_end:type_name -> google.protobuf.Timestamp
+	21, // 23: calculator.OptimizeRouteRequest.depot:type_name -> calculator.RouteStop
+	21, // 24: calculator.OptimizeRouteRequest.stops:type_name -> calculator.RouteStop
+	54, // 25: calculator.OptimizeRouteRequest.start_time:type_name -> google.protobuf.Timestamp
+	21, // 26: calculator.PlannedStop.stop:type_name -> calculator.RouteStop
+	54, // 27: calculator.PlannedStop.eta:type_name -> google.protobuf.Timestamp
+	54, // 28: calculator.PlannedStop.departure:type_name -> google.protobuf.Timestamp
+	23, // 29: calculator.OptimizeRouteResponse.stops:type_name -> calculator.PlannedStop
+	54, // 30: calculator.OptimizeRouteResponse.finish_time:type_name -> google.protobuf.Timestamp
+	21, // 31: calculator.OptimizeRouteResponse.unassigned:type_name -> calculator.RouteStop
+	6,  // 32: calculator.HubRouteResponse.legs:type_name -> calculator.RouteLeg
+	29, // 33: calculator.SurchargeCondition.hours:type_name -> calculator.HourRange
+	30, // 34: calculator.SurchargeCondition.distance:type_name -> calculator.Band
+	30, // 35: calculator.SurchargeCondition.weight:type_name -> calculator.Band
+	31, // 36: calculator.SurchargeRule.condition:type_name -> calculator.SurchargeCondition
+	32, // 37: calculator.SurchargeRule.action:type_name -> calculator.SurchargeAction
+	33, // 38: calculator.SurchargeRuleList.rules:type_name -> calculator.SurchargeRule
+	36, // 39: calculator.ZoneMatrix.rates:type_name -> calculator.ZoneRate
+	54, // 40: calculator.ExchangeRate.date:type_name -> google.protobuf.Timestamp
+	39, // 41: calculator.ExchangeRateList.rates:type_name -> calculator.ExchangeRate
+	54, // 42: calculator.FuelIndexEntry.effective_from:type_name -> google.protobuf.Timestamp
+	41, // 43: calculator.FuelIndexList.entries:type_name -> calculator.FuelIndexEntry
+	43, // 44: calculator.Promotion.routes:type_name -> calculator.PromoRoute
+	54, // 45: calculator.Promotion.valid_from:type_name -> google.protobuf.Timestamp
+	54, // 46: calculator.Promotion.valid_to:type_name -> google.protobuf.Timestamp
+	44, // 47: calculator.PromotionList.promotions:type_name -> calculator.Promotion
+	49, // 48: calculator.CityList.cities:type_name -> calculator.City
+	0,  // 49: calculator.CalculatorService.CalculateDeliveryCost:input_type -> calculator.CalculateDeliveryCostRequest
+	7,  // 50: calculator.CalculatorService.CalculateByTariffCode:input_type -> calculator.CalculateByTariffRequest
+	0,  // 51: calculator.CalculatorService.QuoteAllTariffs:input_type -> calculator.CalculateDeliveryCostRequest
+	5,  // 52: calculator.CalculatorService.VerifyQuote:input_type -> calculator.VerifyQuoteRequest
+	8,  // 53: calculator.CalculatorService.CalculateBatch:input_type -> calculator.BatchCalculateRequest
+	12, // 54: calculator.CalculatorService.GetTariffList:input_type -> calculator.TariffListRequest
+	15, // 55: calculator.CalculatorService.GetTariffVersions:input_type -> calculator.TariffCodeRequest
+	13, // 56: calculator.CalculatorService.CreateTariff:input_type -> calculator.Tariff
+	16, // 57: calculator.CalculatorService.UpdateTariff:input_type -> calculator.UpdateTariffRequest
+	15, // 58: calculator.CalculatorService.DeleteTariff:input_type -> calculator.TariffCodeRequest
+	15, // 59: calculator.CalculatorService.GetTariffAudit:input_type -> calculator.TariffCodeRequest
+	25, // 60: calculator.HubNetworkService.CreateHub:input_type -> calculator.Hub
+	26, // 61: calculator.HubNetworkService.CreateHubLink:input_type -> calculator.HubLink
+	27, // 62: calculator.HubNetworkService.GetHubRoute:input_type -> calculator.HubRouteRequest
+	22, // 63: calculator.RouteOptimizerService.OptimizeRoute:input_type -> calculator.OptimizeRouteRequest
+	19, // 64: calculator.SurchargeRuleService.ListSurchargeRules:input_type -> calculator.Empty
+	33, // 65: calculator.SurchargeRuleService.CreateSurchargeRule:input_type -> calculator.SurchargeRule
+	33, // 66: calculator.SurchargeRuleService.UpdateSurchargeRule:input_type -> calculator.SurchargeRule
+	35, // 67: calculator.SurchargeRuleService.DeleteSurchargeRule:input_type -> calculator.SurchargeRuleID
+	15, // 68: calculator.ZoneMatrixService.GetZoneMatrix:input_type -> calculator.TariffCodeRequest
+	36, // 69: calculator.ZoneMatrixService.SetZoneRate:input_type -> calculator.ZoneRate
+	37, // 70: calculator.ZoneMatrixService.DeleteZoneRate:input_type -> calculator.ZoneRateKey
+	19, // 71: calculator.ExchangeRateService.ListExchangeRates:input_type -> calculator.Empty
+	40, // 72: calculator.ExchangeRateService.SetExchangeRates:input_type -> calculator.ExchangeRateList
+	19, // 73: calculator.FuelSurchargeService.ListFuelIndex:input_type -> calculator.Empty
+	42, // 74: calculator.FuelSurchargeService.SetFuelIndex:input_type -> calculator.FuelIndexList
+	19, // 75: calculator.PromotionService.ListPromotions:input_type -> calculator.Empty
+	44, // 76: calculator.PromotionService.CreatePromotion:input_type -> calculator.Promotion
+	46, // 77: calculator.PromotionService.SetPromotionActive:input_type -> calculator.SetPromotionActiveRequest
+	47, // 78: calculator.PromotionService.RedeemPromo:input_type -> calculator.RedeemPromoRequest
+	48, // 79: calculator.PromotionService.ReleasePromo:input_type -> calculator.ReleasePromoRequest
+	51, // 80: calculator.CityService.SearchCities:input_type -> calculator.CitySearchRequest
+	52, // 81: calculator.CityService.NearestCities:input_type -> calculator.NearestCitiesRequest
+	53, // 82: calculator.CityService.SetCityAliases:input_type -> calculator.CityAliasesRequest
+	1,  // 83: calculator.CalculatorService.CalculateDeliveryCost:output_type -> calculator.CalculateDeliveryCostResponse
+	1,  // 84: calculator.CalculatorService.CalculateByTariffCode:output_type -> calculator.CalculateDeliveryCostResponse
+	11, // 85: calculator.CalculatorService.QuoteAllTariffs:output_type -> calculator.QuoteAllTariffsResponse
+	1,  // 86: calculator.CalculatorService.VerifyQuote:output_type -> calculator.CalculateDeliveryCostResponse
+	9,  // 87: calculator.CalculatorService.CalculateBatch:output_type -> calculator.BatchCalculateResponse
+	20, // 88: calculator.CalculatorService.GetTariffList:output_type -> calculator.TariffListResponse
+	20, // 89: calculator.CalculatorService.GetTariffVersions:output_type -> calculator.TariffListResponse
+	13, // 90: calculator.CalculatorService.CreateTariff:output_type -> calculator.Tariff
+	13, // 91: calculator.CalculatorService.UpdateTariff:output_type -> calculator.Tariff
+	19, // 92: calculator.CalculatorService.DeleteTariff:output_type -> calculator.Empty
+	18, // 93: calculator.CalculatorService.GetTariffAudit:output_type -> calculator.TariffAuditList
+	25, // 94: calculator.HubNetworkService.CreateHub:output_type -> calculator.Hub
+	26, // 95: calculator.HubNetworkService.CreateHubLink:output_type -> calculator.HubLink
+	28, // 96: calculator.HubNetworkService.GetHubRoute:output_type -> calculator.HubRouteResponse
+	24, // 97: calculator.RouteOptimizerService.OptimizeRoute:output_type -> calculator.OptimizeRouteResponse
+	34, // 98: calculator.SurchargeRuleService.ListSurchargeRules:output_type -> calculator.SurchargeRuleList
+	33, // 99: calculator.SurchargeRuleService.CreateSurchargeRule:output_type -> calculator.SurchargeRule
+	33, // 100: calculator.SurchargeRuleService.UpdateSurchargeRule:output_type -> calculator.SurchargeRule
+	19, // 101: calculator.SurchargeRuleService.DeleteSurchargeRule:output_type -> calculator.Empty
+	38, // 102: calculator.ZoneMatrixService.GetZoneMatrix:output_type -> calculator.ZoneMatrix
+	36, // 103: calculator.ZoneMatrixService.SetZoneRate:output_type -> calculator.ZoneRate
+	19, // 104: calculator.ZoneMatrixService.DeleteZoneRate:output_type -> calculator.Empty
+	40, // 105: calculator.ExchangeRateService.ListExchangeRates:output_type -> calculator.ExchangeRateList
+	40, // 106: calculator.ExchangeRateService.SetExchangeRates:output_type -> calculator.ExchangeRateList
+	42, // 107: calculator.FuelSurchargeService.ListFuelIndex:output_type -> calculator.FuelIndexList
+	42, // 108: calculator.FuelSurchargeService.SetFuelIndex:output_type -> calculator.FuelIndexList
+	45, // 109: calculator.PromotionService.ListPromotions:output_type -> calculator.PromotionList
+	44, // 110: calculator.PromotionService.CreatePromotion:output_type -> calculator.Promotion
+	19, // 111: calculator.PromotionService.SetPromotionActive:output_type -> calculator.Empty
+	19, // 112: calculator.PromotionService.RedeemPromo:output_type -> calculator.Empty
+	19, // 113: calculator.PromotionService.ReleasePromo:output_type -> calculator.Empty
+	50, // 114: calculator.CityService.SearchCities:output_type -> calculator.CityList
+	50, // 115: calculator.CityService.NearestCities:output_type -> calculator.CityList
+	49, // 116: calculator.CityService.SetCityAliases:output_type -> calculator.City
+	83, // [83:117] is the sub-list for method output_type
+	49, // [49:83] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_calculator_calculator_proto_init() }
@@ -633,15 +4724,16 @@ func file_calculator_calculator_proto_init() {
 	if File_calculator_calculator_proto != nil {
 		return
 	}
+	file_calculator_calculator_proto_msgTypes[16].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_calculator_calculator_proto_rawDesc), len(file_calculator_calculator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   54,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   9,
 		},
 		GoTypes:           file_calculator_calculator_proto_goTypes,
 		DependencyIndexes: file_calculator_calculator_proto_depIdxs,
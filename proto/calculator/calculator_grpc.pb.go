@@ -21,9 +21,15 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	CalculatorService_CalculateDeliveryCost_FullMethodName = "/calculator.CalculatorService/CalculateDeliveryCost"
 	CalculatorService_CalculateByTariffCode_FullMethodName = "/calculator.CalculatorService/CalculateByTariffCode"
+	CalculatorService_QuoteAllTariffs_FullMethodName       = "/calculator.CalculatorService/QuoteAllTariffs"
+	CalculatorService_VerifyQuote_FullMethodName           = "/calculator.CalculatorService/VerifyQuote"
+	CalculatorService_CalculateBatch_FullMethodName        = "/calculator.CalculatorService/CalculateBatch"
 	CalculatorService_GetTariffList_FullMethodName         = "/calculator.CalculatorService/GetTariffList"
+	CalculatorService_GetTariffVersions_FullMethodName     = "/calculator.CalculatorService/GetTariffVersions"
 	CalculatorService_CreateTariff_FullMethodName          = "/calculator.CalculatorService/CreateTariff"
+	CalculatorService_UpdateTariff_FullMethodName          = "/calculator.CalculatorService/UpdateTariff"
 	CalculatorService_DeleteTariff_FullMethodName          = "/calculator.CalculatorService/DeleteTariff"
+	CalculatorService_GetTariffAudit_FullMethodName        = "/calculator.CalculatorService/GetTariffAudit"
 )
 
 // CalculatorServiceClient is the client API for CalculatorService service.
@@ -32,9 +38,15 @@ const (
 type CalculatorServiceClient interface {
 	CalculateDeliveryCost(ctx context.Context, in *CalculateDeliveryCostRequest, opts ...grpc.CallOption) (*CalculateDeliveryCostResponse, error)
 	CalculateByTariffCode(ctx context.Context, in *CalculateByTariffRequest, opts ...grpc.CallOption) (*CalculateDeliveryCostResponse, error)
+	QuoteAllTariffs(ctx context.Context, in *CalculateDeliveryCostRequest, opts ...grpc.CallOption) (*QuoteAllTariffsResponse, error)
+	VerifyQuote(ctx context.Context, in *VerifyQuoteRequest, opts ...grpc.CallOption) (*CalculateDeliveryCostResponse, error)
+	CalculateBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchCalculateRequest, BatchCalculateResponse], error)
 	GetTariffList(ctx context.Context, in *TariffListRequest, opts ...grpc.CallOption) (*TariffListResponse, error)
+	GetTariffVersions(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*TariffListResponse, error)
 	CreateTariff(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error)
+	UpdateTariff(ctx context.Context, in *UpdateTariffRequest, opts ...grpc.CallOption) (*Tariff, error)
 	DeleteTariff(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetTariffAudit(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*TariffAuditList, error)
 }
 
 type calculatorServiceClient struct {
@@ -65,6 +77,39 @@ func (c *calculatorServiceClient) CalculateByTariffCode(ctx context.Context, in
 	return out, nil
 }
 
+func (c *calculatorServiceClient) QuoteAllTariffs(ctx context.Context, in *CalculateDeliveryCostRequest, opts ...grpc.CallOption) (*QuoteAllTariffsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteAllTariffsResponse)
+	err := c.cc.Invoke(ctx, CalculatorService_QuoteAllTariffs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) VerifyQuote(ctx context.Context, in *VerifyQuoteRequest, opts ...grpc.CallOption) (*CalculateDeliveryCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateDeliveryCostResponse)
+	err := c.cc.Invoke(ctx, CalculatorService_VerifyQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *calculatorServiceClient) CalculateBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[BatchCalculateRequest, BatchCalculateResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CalculatorService_ServiceDesc.Streams[0], CalculatorService_CalculateBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BatchCalculateRequest, BatchCalculateResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CalculatorService_CalculateBatchClient = grpc.BidiStreamingClient[BatchCalculateRequest, BatchCalculateResponse]
+
 func (c *calculatorServiceClient) GetTariffList(ctx context.Context, in *TariffListRequest, opts ...grpc.CallOption) (*TariffListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TariffListResponse)
@@ -75,6 +120,16 @@ func (c *calculatorServiceClient) GetTariffList(ctx context.Context, in *TariffL
 	return out, nil
 }
 
+func (c *calculatorServiceClient) GetTariffVersions(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*TariffListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffListResponse)
+	err := c.cc.Invoke(ctx, CalculatorService_GetTariffVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *calculatorServiceClient) CreateTariff(ctx context.Context, in *Tariff, opts ...grpc.CallOption) (*Tariff, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Tariff)
@@ -85,6 +140,16 @@ func (c *calculatorServiceClient) CreateTariff(ctx context.Context, in *Tariff,
 	return out, nil
 }
 
+func (c *calculatorServiceClient) UpdateTariff(ctx context.Context, in *UpdateTariffRequest, opts ...grpc.CallOption) (*Tariff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tariff)
+	err := c.cc.Invoke(ctx, CalculatorService_UpdateTariff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *calculatorServiceClient) DeleteTariff(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Empty)
@@ -95,15 +160,31 @@ func (c *calculatorServiceClient) DeleteTariff(ctx context.Context, in *TariffCo
 	return out, nil
 }
 
+func (c *calculatorServiceClient) GetTariffAudit(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*TariffAuditList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TariffAuditList)
+	err := c.cc.Invoke(ctx, CalculatorService_GetTariffAudit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CalculatorServiceServer is the server API for CalculatorService service.
 // All implementations must embed UnimplementedCalculatorServiceServer
 // for forward compatibility.
 type CalculatorServiceServer interface {
 	CalculateDeliveryCost(context.Context, *CalculateDeliveryCostRequest) (*CalculateDeliveryCostResponse, error)
 	CalculateByTariffCode(context.Context, *CalculateByTariffRequest) (*CalculateDeliveryCostResponse, error)
+	QuoteAllTariffs(context.Context, *CalculateDeliveryCostRequest) (*QuoteAllTariffsResponse, error)
+	VerifyQuote(context.Context, *VerifyQuoteRequest) (*CalculateDeliveryCostResponse, error)
+	CalculateBatch(grpc.BidiStreamingServer[BatchCalculateRequest, BatchCalculateResponse]) error
 	GetTariffList(context.Context, *TariffListRequest) (*TariffListResponse, error)
+	GetTariffVersions(context.Context, *TariffCodeRequest) (*TariffListResponse, error)
 	CreateTariff(context.Context, *Tariff) (*Tariff, error)
+	UpdateTariff(context.Context, *UpdateTariffRequest) (*Tariff, error)
 	DeleteTariff(context.Context, *TariffCodeRequest) (*Empty, error)
+	GetTariffAudit(context.Context, *TariffCodeRequest) (*TariffAuditList, error)
 	mustEmbedUnimplementedCalculatorServiceServer()
 }
 
@@ -120,15 +201,33 @@ func (UnimplementedCalculatorServiceServer) CalculateDeliveryCost(context.Contex
 func (UnimplementedCalculatorServiceServer) CalculateByTariffCode(context.Context, *CalculateByTariffRequest) (*CalculateDeliveryCostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateByTariffCode not implemented")
 }
+func (UnimplementedCalculatorServiceServer) QuoteAllTariffs(context.Context, *CalculateDeliveryCostRequest) (*QuoteAllTariffsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteAllTariffs not implemented")
+}
+func (UnimplementedCalculatorServiceServer) VerifyQuote(context.Context, *VerifyQuoteRequest) (*CalculateDeliveryCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyQuote not implemented")
+}
+func (UnimplementedCalculatorServiceServer) CalculateBatch(grpc.BidiStreamingServer[BatchCalculateRequest, BatchCalculateResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CalculateBatch not implemented")
+}
 func (UnimplementedCalculatorServiceServer) GetTariffList(context.Context, *TariffListRequest) (*TariffListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTariffList not implemented")
 }
+func (UnimplementedCalculatorServiceServer) GetTariffVersions(context.Context, *TariffCodeRequest) (*TariffListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTariffVersions not implemented")
+}
 func (UnimplementedCalculatorServiceServer) CreateTariff(context.Context, *Tariff) (*Tariff, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTariff not implemented")
 }
+func (UnimplementedCalculatorServiceServer) UpdateTariff(context.Context, *UpdateTariffRequest) (*Tariff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTariff not implemented")
+}
 func (UnimplementedCalculatorServiceServer) DeleteTariff(context.Context, *TariffCodeRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTariff not implemented")
 }
+func (UnimplementedCalculatorServiceServer) GetTariffAudit(context.Context, *TariffCodeRequest) (*TariffAuditList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTariffAudit not implemented")
+}
 func (UnimplementedCalculatorServiceServer) mustEmbedUnimplementedCalculatorServiceServer() {}
 func (UnimplementedCalculatorServiceServer) testEmbeddedByValue()                           {}
 
@@ -186,6 +285,49 @@ func _CalculatorService_CalculateByTariffCode_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalculatorService_QuoteAllTariffs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateDeliveryCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).QuoteAllTariffs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalculatorService_QuoteAllTariffs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).QuoteAllTariffs(ctx, req.(*CalculateDeliveryCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_VerifyQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).VerifyQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalculatorService_VerifyQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).VerifyQuote(ctx, req.(*VerifyQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CalculatorService_CalculateBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CalculatorServiceServer).CalculateBatch(&grpc.GenericServerStream[BatchCalculateRequest, BatchCalculateResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CalculatorService_CalculateBatchServer = grpc.BidiStreamingServer[BatchCalculateRequest, BatchCalculateResponse]
+
 func _CalculatorService_GetTariffList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TariffListRequest)
 	if err := dec(in); err != nil {
@@ -204,6 +346,24 @@ func _CalculatorService_GetTariffList_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalculatorService_GetTariffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).GetTariffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalculatorService_GetTariffVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).GetTariffVersions(ctx, req.(*TariffCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CalculatorService_CreateTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Tariff)
 	if err := dec(in); err != nil {
@@ -222,6 +382,24 @@ func _CalculatorService_CreateTariff_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalculatorService_UpdateTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTariffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).UpdateTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalculatorService_UpdateTariff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).UpdateTariff(ctx, req.(*UpdateTariffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CalculatorService_DeleteTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TariffCodeRequest)
 	if err := dec(in); err != nil {
@@ -240,6 +418,24 @@ func _CalculatorService_DeleteTariff_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CalculatorService_GetTariffAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CalculatorServiceServer).GetTariffAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CalculatorService_GetTariffAudit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CalculatorServiceServer).GetTariffAudit(ctx, req.(*TariffCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CalculatorService_ServiceDesc is the grpc.ServiceDesc for CalculatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -255,18 +451,1431 @@ var CalculatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateByTariffCode",
 			Handler:    _CalculatorService_CalculateByTariffCode_Handler,
 		},
+		{
+			MethodName: "QuoteAllTariffs",
+			Handler:    _CalculatorService_QuoteAllTariffs_Handler,
+		},
+		{
+			MethodName: "VerifyQuote",
+			Handler:    _CalculatorService_VerifyQuote_Handler,
+		},
 		{
 			MethodName: "GetTariffList",
 			Handler:    _CalculatorService_GetTariffList_Handler,
 		},
+		{
+			MethodName: "GetTariffVersions",
+			Handler:    _CalculatorService_GetTariffVersions_Handler,
+		},
 		{
 			MethodName: "CreateTariff",
 			Handler:    _CalculatorService_CreateTariff_Handler,
 		},
+		{
+			MethodName: "UpdateTariff",
+			Handler:    _CalculatorService_UpdateTariff_Handler,
+		},
 		{
 			MethodName: "DeleteTariff",
 			Handler:    _CalculatorService_DeleteTariff_Handler,
 		},
+		{
+			MethodName: "GetTariffAudit",
+			Handler:    _CalculatorService_GetTariffAudit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CalculateBatch",
+			Handler:       _CalculatorService_CalculateBatch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	HubNetworkService_CreateHub_FullMethodName     = "/calculator.HubNetworkService/CreateHub"
+	HubNetworkService_CreateHubLink_FullMethodName = "/calculator.HubNetworkService/CreateHubLink"
+	HubNetworkService_GetHubRoute_FullMethodName   = "/calculator.HubNetworkService/GetHubRoute"
+)
+
+// HubNetworkServiceClient is the client API for HubNetworkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HubNetworkServiceClient interface {
+	CreateHub(ctx context.Context, in *Hub, opts ...grpc.CallOption) (*Hub, error)
+	CreateHubLink(ctx context.Context, in *HubLink, opts ...grpc.CallOption) (*HubLink, error)
+	GetHubRoute(ctx context.Context, in *HubRouteRequest, opts ...grpc.CallOption) (*HubRouteResponse, error)
+}
+
+type hubNetworkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHubNetworkServiceClient(cc grpc.ClientConnInterface) HubNetworkServiceClient {
+	return &hubNetworkServiceClient{cc}
+}
+
+func (c *hubNetworkServiceClient) CreateHub(ctx context.Context, in *Hub, opts ...grpc.CallOption) (*Hub, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Hub)
+	err := c.cc.Invoke(ctx, HubNetworkService_CreateHub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubNetworkServiceClient) CreateHubLink(ctx context.Context, in *HubLink, opts ...grpc.CallOption) (*HubLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HubLink)
+	err := c.cc.Invoke(ctx, HubNetworkService_CreateHubLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hubNetworkServiceClient) GetHubRoute(ctx context.Context, in *HubRouteRequest, opts ...grpc.CallOption) (*HubRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HubRouteResponse)
+	err := c.cc.Invoke(ctx, HubNetworkService_GetHubRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HubNetworkServiceServer is the server API for HubNetworkService service.
+// All implementations must embed UnimplementedHubNetworkServiceServer
+// for forward compatibility.
+type HubNetworkServiceServer interface {
+	CreateHub(context.Context, *Hub) (*Hub, error)
+	CreateHubLink(context.Context, *HubLink) (*HubLink, error)
+	GetHubRoute(context.Context, *HubRouteRequest) (*HubRouteResponse, error)
+	mustEmbedUnimplementedHubNetworkServiceServer()
+}
+
+// UnimplementedHubNetworkServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHubNetworkServiceServer struct{}
+
+func (UnimplementedHubNetworkServiceServer) CreateHub(context.Context, *Hub) (*Hub, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHub not implemented")
+}
+func (UnimplementedHubNetworkServiceServer) CreateHubLink(context.Context, *HubLink) (*HubLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHubLink not implemented")
+}
+func (UnimplementedHubNetworkServiceServer) GetHubRoute(context.Context, *HubRouteRequest) (*HubRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHubRoute not implemented")
+}
+func (UnimplementedHubNetworkServiceServer) mustEmbedUnimplementedHubNetworkServiceServer() {}
+func (UnimplementedHubNetworkServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeHubNetworkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HubNetworkServiceServer will
+// result in compilation errors.
+type UnsafeHubNetworkServiceServer interface {
+	mustEmbedUnimplementedHubNetworkServiceServer()
+}
+
+func RegisterHubNetworkServiceServer(s grpc.ServiceRegistrar, srv HubNetworkServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHubNetworkServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HubNetworkService_ServiceDesc, srv)
+}
+
+func _HubNetworkService_CreateHub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hub)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubNetworkServiceServer).CreateHub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HubNetworkService_CreateHub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubNetworkServiceServer).CreateHub(ctx, req.(*Hub))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HubNetworkService_CreateHubLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HubLink)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubNetworkServiceServer).CreateHubLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HubNetworkService_CreateHubLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubNetworkServiceServer).CreateHubLink(ctx, req.(*HubLink))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HubNetworkService_GetHubRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HubRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HubNetworkServiceServer).GetHubRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HubNetworkService_GetHubRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HubNetworkServiceServer).GetHubRoute(ctx, req.(*HubRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HubNetworkService_ServiceDesc is the grpc.ServiceDesc for HubNetworkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HubNetworkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.HubNetworkService",
+	HandlerType: (*HubNetworkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateHub",
+			Handler:    _HubNetworkService_CreateHub_Handler,
+		},
+		{
+			MethodName: "CreateHubLink",
+			Handler:    _HubNetworkService_CreateHubLink_Handler,
+		},
+		{
+			MethodName: "GetHubRoute",
+			Handler:    _HubNetworkService_GetHubRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	RouteOptimizerService_OptimizeRoute_FullMethodName = "/calculator.RouteOptimizerService/OptimizeRoute"
+)
+
+// RouteOptimizerServiceClient is the client API for RouteOptimizerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RouteOptimizerServiceClient interface {
+	OptimizeRoute(ctx context.Context, in *OptimizeRouteRequest, opts ...grpc.CallOption) (*OptimizeRouteResponse, error)
+}
+
+type routeOptimizerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRouteOptimizerServiceClient(cc grpc.ClientConnInterface) RouteOptimizerServiceClient {
+	return &routeOptimizerServiceClient{cc}
+}
+
+func (c *routeOptimizerServiceClient) OptimizeRoute(ctx context.Context, in *OptimizeRouteRequest, opts ...grpc.CallOption) (*OptimizeRouteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OptimizeRouteResponse)
+	err := c.cc.Invoke(ctx, RouteOptimizerService_OptimizeRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouteOptimizerServiceServer is the server API for RouteOptimizerService service.
+// All implementations must embed UnimplementedRouteOptimizerServiceServer
+// for forward compatibility.
+type RouteOptimizerServiceServer interface {
+	OptimizeRoute(context.Context, *OptimizeRouteRequest) (*OptimizeRouteResponse, error)
+	mustEmbedUnimplementedRouteOptimizerServiceServer()
+}
+
+// UnimplementedRouteOptimizerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRouteOptimizerServiceServer struct{}
+
+func (UnimplementedRouteOptimizerServiceServer) OptimizeRoute(context.Context, *OptimizeRouteRequest) (*OptimizeRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizeRoute not implemented")
+}
+func (UnimplementedRouteOptimizerServiceServer) mustEmbedUnimplementedRouteOptimizerServiceServer() {}
+func (UnimplementedRouteOptimizerServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeRouteOptimizerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RouteOptimizerServiceServer will
+// result in compilation errors.
+type UnsafeRouteOptimizerServiceServer interface {
+	mustEmbedUnimplementedRouteOptimizerServiceServer()
+}
+
+func RegisterRouteOptimizerServiceServer(s grpc.ServiceRegistrar, srv RouteOptimizerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRouteOptimizerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RouteOptimizerService_ServiceDesc, srv)
+}
+
+func _RouteOptimizerService_OptimizeRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteOptimizerServiceServer).OptimizeRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteOptimizerService_OptimizeRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteOptimizerServiceServer).OptimizeRoute(ctx, req.(*OptimizeRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RouteOptimizerService_ServiceDesc is the grpc.ServiceDesc for RouteOptimizerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RouteOptimizerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.RouteOptimizerService",
+	HandlerType: (*RouteOptimizerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OptimizeRoute",
+			Handler:    _RouteOptimizerService_OptimizeRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	SurchargeRuleService_ListSurchargeRules_FullMethodName  = "/calculator.SurchargeRuleService/ListSurchargeRules"
+	SurchargeRuleService_CreateSurchargeRule_FullMethodName = "/calculator.SurchargeRuleService/CreateSurchargeRule"
+	SurchargeRuleService_UpdateSurchargeRule_FullMethodName = "/calculator.SurchargeRuleService/UpdateSurchargeRule"
+	SurchargeRuleService_DeleteSurchargeRule_FullMethodName = "/calculator.SurchargeRuleService/DeleteSurchargeRule"
+)
+
+// SurchargeRuleServiceClient is the client API for SurchargeRuleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SurchargeRuleServiceClient interface {
+	ListSurchargeRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SurchargeRuleList, error)
+	CreateSurchargeRule(ctx context.Context, in *SurchargeRule, opts ...grpc.CallOption) (*SurchargeRule, error)
+	UpdateSurchargeRule(ctx context.Context, in *SurchargeRule, opts ...grpc.CallOption) (*SurchargeRule, error)
+	DeleteSurchargeRule(ctx context.Context, in *SurchargeRuleID, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type surchargeRuleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSurchargeRuleServiceClient(cc grpc.ClientConnInterface) SurchargeRuleServiceClient {
+	return &surchargeRuleServiceClient{cc}
+}
+
+func (c *surchargeRuleServiceClient) ListSurchargeRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SurchargeRuleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SurchargeRuleList)
+	err := c.cc.Invoke(ctx, SurchargeRuleService_ListSurchargeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surchargeRuleServiceClient) CreateSurchargeRule(ctx context.Context, in *SurchargeRule, opts ...grpc.CallOption) (*SurchargeRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SurchargeRule)
+	err := c.cc.Invoke(ctx, SurchargeRuleService_CreateSurchargeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surchargeRuleServiceClient) UpdateSurchargeRule(ctx context.Context, in *SurchargeRule, opts ...grpc.CallOption) (*SurchargeRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SurchargeRule)
+	err := c.cc.Invoke(ctx, SurchargeRuleService_UpdateSurchargeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *surchargeRuleServiceClient) DeleteSurchargeRule(ctx context.Context, in *SurchargeRuleID, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, SurchargeRuleService_DeleteSurchargeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SurchargeRuleServiceServer is the server API for SurchargeRuleService service.
+// All implementations must embed UnimplementedSurchargeRuleServiceServer
+// for forward compatibility.
+type SurchargeRuleServiceServer interface {
+	ListSurchargeRules(context.Context, *Empty) (*SurchargeRuleList, error)
+	CreateSurchargeRule(context.Context, *SurchargeRule) (*SurchargeRule, error)
+	UpdateSurchargeRule(context.Context, *SurchargeRule) (*SurchargeRule, error)
+	DeleteSurchargeRule(context.Context, *SurchargeRuleID) (*Empty, error)
+	mustEmbedUnimplementedSurchargeRuleServiceServer()
+}
+
+// UnimplementedSurchargeRuleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSurchargeRuleServiceServer struct{}
+
+func (UnimplementedSurchargeRuleServiceServer) ListSurchargeRules(context.Context, *Empty) (*SurchargeRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSurchargeRules not implemented")
+}
+func (UnimplementedSurchargeRuleServiceServer) CreateSurchargeRule(context.Context, *SurchargeRule) (*SurchargeRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSurchargeRule not implemented")
+}
+func (UnimplementedSurchargeRuleServiceServer) UpdateSurchargeRule(context.Context, *SurchargeRule) (*SurchargeRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSurchargeRule not implemented")
+}
+func (UnimplementedSurchargeRuleServiceServer) DeleteSurchargeRule(context.Context, *SurchargeRuleID) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSurchargeRule not implemented")
+}
+func (UnimplementedSurchargeRuleServiceServer) mustEmbedUnimplementedSurchargeRuleServiceServer() {}
+func (UnimplementedSurchargeRuleServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeSurchargeRuleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SurchargeRuleServiceServer will
+// result in compilation errors.
+type UnsafeSurchargeRuleServiceServer interface {
+	mustEmbedUnimplementedSurchargeRuleServiceServer()
+}
+
+func RegisterSurchargeRuleServiceServer(s grpc.ServiceRegistrar, srv SurchargeRuleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSurchargeRuleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SurchargeRuleService_ServiceDesc, srv)
+}
+
+func _SurchargeRuleService_ListSurchargeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SurchargeRuleServiceServer).ListSurchargeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SurchargeRuleService_ListSurchargeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SurchargeRuleServiceServer).ListSurchargeRules(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SurchargeRuleService_CreateSurchargeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SurchargeRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SurchargeRuleServiceServer).CreateSurchargeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SurchargeRuleService_CreateSurchargeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SurchargeRuleServiceServer).CreateSurchargeRule(ctx, req.(*SurchargeRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SurchargeRuleService_UpdateSurchargeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SurchargeRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SurchargeRuleServiceServer).UpdateSurchargeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SurchargeRuleService_UpdateSurchargeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SurchargeRuleServiceServer).UpdateSurchargeRule(ctx, req.(*SurchargeRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SurchargeRuleService_DeleteSurchargeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SurchargeRuleID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SurchargeRuleServiceServer).DeleteSurchargeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SurchargeRuleService_DeleteSurchargeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SurchargeRuleServiceServer).DeleteSurchargeRule(ctx, req.(*SurchargeRuleID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SurchargeRuleService_ServiceDesc is the grpc.ServiceDesc for SurchargeRuleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SurchargeRuleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.SurchargeRuleService",
+	HandlerType: (*SurchargeRuleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSurchargeRules",
+			Handler:    _SurchargeRuleService_ListSurchargeRules_Handler,
+		},
+		{
+			MethodName: "CreateSurchargeRule",
+			Handler:    _SurchargeRuleService_CreateSurchargeRule_Handler,
+		},
+		{
+			MethodName: "UpdateSurchargeRule",
+			Handler:    _SurchargeRuleService_UpdateSurchargeRule_Handler,
+		},
+		{
+			MethodName: "DeleteSurchargeRule",
+			Handler:    _SurchargeRuleService_DeleteSurchargeRule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	ZoneMatrixService_GetZoneMatrix_FullMethodName  = "/calculator.ZoneMatrixService/GetZoneMatrix"
+	ZoneMatrixService_SetZoneRate_FullMethodName    = "/calculator.ZoneMatrixService/SetZoneRate"
+	ZoneMatrixService_DeleteZoneRate_FullMethodName = "/calculator.ZoneMatrixService/DeleteZoneRate"
+)
+
+// ZoneMatrixServiceClient is the client API for ZoneMatrixService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ZoneMatrixServiceClient interface {
+	GetZoneMatrix(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*ZoneMatrix, error)
+	SetZoneRate(ctx context.Context, in *ZoneRate, opts ...grpc.CallOption) (*ZoneRate, error)
+	DeleteZoneRate(ctx context.Context, in *ZoneRateKey, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type zoneMatrixServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewZoneMatrixServiceClient(cc grpc.ClientConnInterface) ZoneMatrixServiceClient {
+	return &zoneMatrixServiceClient{cc}
+}
+
+func (c *zoneMatrixServiceClient) GetZoneMatrix(ctx context.Context, in *TariffCodeRequest, opts ...grpc.CallOption) (*ZoneMatrix, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneMatrix)
+	err := c.cc.Invoke(ctx, ZoneMatrixService_GetZoneMatrix_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneMatrixServiceClient) SetZoneRate(ctx context.Context, in *ZoneRate, opts ...grpc.CallOption) (*ZoneRate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ZoneRate)
+	err := c.cc.Invoke(ctx, ZoneMatrixService_SetZoneRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zoneMatrixServiceClient) DeleteZoneRate(ctx context.Context, in *ZoneRateKey, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, ZoneMatrixService_DeleteZoneRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ZoneMatrixServiceServer is the server API for ZoneMatrixService service.
+// All implementations must embed UnimplementedZoneMatrixServiceServer
+// for forward compatibility.
+type ZoneMatrixServiceServer interface {
+	GetZoneMatrix(context.Context, *TariffCodeRequest) (*ZoneMatrix, error)
+	SetZoneRate(context.Context, *ZoneRate) (*ZoneRate, error)
+	DeleteZoneRate(context.Context, *ZoneRateKey) (*Empty, error)
+	mustEmbedUnimplementedZoneMatrixServiceServer()
+}
+
+// UnimplementedZoneMatrixServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedZoneMatrixServiceServer struct{}
+
+func (UnimplementedZoneMatrixServiceServer) GetZoneMatrix(context.Context, *TariffCodeRequest) (*ZoneMatrix, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetZoneMatrix not implemented")
+}
+func (UnimplementedZoneMatrixServiceServer) SetZoneRate(context.Context, *ZoneRate) (*ZoneRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetZoneRate not implemented")
+}
+func (UnimplementedZoneMatrixServiceServer) DeleteZoneRate(context.Context, *ZoneRateKey) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteZoneRate not implemented")
+}
+func (UnimplementedZoneMatrixServiceServer) mustEmbedUnimplementedZoneMatrixServiceServer() {}
+func (UnimplementedZoneMatrixServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeZoneMatrixServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ZoneMatrixServiceServer will
+// result in compilation errors.
+type UnsafeZoneMatrixServiceServer interface {
+	mustEmbedUnimplementedZoneMatrixServiceServer()
+}
+
+func RegisterZoneMatrixServiceServer(s grpc.ServiceRegistrar, srv ZoneMatrixServiceServer) {
+	// If the following call pancis, it indicates UnimplementedZoneMatrixServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ZoneMatrixService_ServiceDesc, srv)
+}
+
+func _ZoneMatrixService_GetZoneMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TariffCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneMatrixServiceServer).GetZoneMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneMatrixService_GetZoneMatrix_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneMatrixServiceServer).GetZoneMatrix(ctx, req.(*TariffCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneMatrixService_SetZoneRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneMatrixServiceServer).SetZoneRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneMatrixService_SetZoneRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneMatrixServiceServer).SetZoneRate(ctx, req.(*ZoneRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ZoneMatrixService_DeleteZoneRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZoneRateKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZoneMatrixServiceServer).DeleteZoneRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ZoneMatrixService_DeleteZoneRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZoneMatrixServiceServer).DeleteZoneRate(ctx, req.(*ZoneRateKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ZoneMatrixService_ServiceDesc is the grpc.ServiceDesc for ZoneMatrixService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ZoneMatrixService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.ZoneMatrixService",
+	HandlerType: (*ZoneMatrixServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetZoneMatrix",
+			Handler:    _ZoneMatrixService_GetZoneMatrix_Handler,
+		},
+		{
+			MethodName: "SetZoneRate",
+			Handler:    _ZoneMatrixService_SetZoneRate_Handler,
+		},
+		{
+			MethodName: "DeleteZoneRate",
+			Handler:    _ZoneMatrixService_DeleteZoneRate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	ExchangeRateService_ListExchangeRates_FullMethodName = "/calculator.ExchangeRateService/ListExchangeRates"
+	ExchangeRateService_SetExchangeRates_FullMethodName  = "/calculator.ExchangeRateService/SetExchangeRates"
+)
+
+// ExchangeRateServiceClient is the client API for ExchangeRateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ExchangeRateServiceClient interface {
+	ListExchangeRates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ExchangeRateList, error)
+	SetExchangeRates(ctx context.Context, in *ExchangeRateList, opts ...grpc.CallOption) (*ExchangeRateList, error)
+}
+
+type exchangeRateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExchangeRateServiceClient(cc grpc.ClientConnInterface) ExchangeRateServiceClient {
+	return &exchangeRateServiceClient{cc}
+}
+
+func (c *exchangeRateServiceClient) ListExchangeRates(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ExchangeRateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateList)
+	err := c.cc.Invoke(ctx, ExchangeRateService_ListExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exchangeRateServiceClient) SetExchangeRates(ctx context.Context, in *ExchangeRateList, opts ...grpc.CallOption) (*ExchangeRateList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExchangeRateList)
+	err := c.cc.Invoke(ctx, ExchangeRateService_SetExchangeRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExchangeRateServiceServer is the server API for ExchangeRateService service.
+// All implementations must embed UnimplementedExchangeRateServiceServer
+// for forward compatibility.
+type ExchangeRateServiceServer interface {
+	ListExchangeRates(context.Context, *Empty) (*ExchangeRateList, error)
+	SetExchangeRates(context.Context, *ExchangeRateList) (*ExchangeRateList, error)
+	mustEmbedUnimplementedExchangeRateServiceServer()
+}
+
+// UnimplementedExchangeRateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExchangeRateServiceServer struct{}
+
+func (UnimplementedExchangeRateServiceServer) ListExchangeRates(context.Context, *Empty) (*ExchangeRateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) SetExchangeRates(context.Context, *ExchangeRateList) (*ExchangeRateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRates not implemented")
+}
+func (UnimplementedExchangeRateServiceServer) mustEmbedUnimplementedExchangeRateServiceServer() {}
+func (UnimplementedExchangeRateServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeExchangeRateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExchangeRateServiceServer will
+// result in compilation errors.
+type UnsafeExchangeRateServiceServer interface {
+	mustEmbedUnimplementedExchangeRateServiceServer()
+}
+
+func RegisterExchangeRateServiceServer(s grpc.ServiceRegistrar, srv ExchangeRateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExchangeRateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExchangeRateService_ServiceDesc, srv)
+}
+
+func _ExchangeRateService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).ListExchangeRates(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExchangeRateService_SetExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRateList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExchangeRateServiceServer).SetExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExchangeRateService_SetExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExchangeRateServiceServer).SetExchangeRates(ctx, req.(*ExchangeRateList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExchangeRateService_ServiceDesc is the grpc.ServiceDesc for ExchangeRateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExchangeRateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.ExchangeRateService",
+	HandlerType: (*ExchangeRateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _ExchangeRateService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "SetExchangeRates",
+			Handler:    _ExchangeRateService_SetExchangeRates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	FuelSurchargeService_ListFuelIndex_FullMethodName = "/calculator.FuelSurchargeService/ListFuelIndex"
+	FuelSurchargeService_SetFuelIndex_FullMethodName  = "/calculator.FuelSurchargeService/SetFuelIndex"
+)
+
+// FuelSurchargeServiceClient is the client API for FuelSurchargeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FuelSurchargeServiceClient interface {
+	ListFuelIndex(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FuelIndexList, error)
+	SetFuelIndex(ctx context.Context, in *FuelIndexList, opts ...grpc.CallOption) (*FuelIndexList, error)
+}
+
+type fuelSurchargeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFuelSurchargeServiceClient(cc grpc.ClientConnInterface) FuelSurchargeServiceClient {
+	return &fuelSurchargeServiceClient{cc}
+}
+
+func (c *fuelSurchargeServiceClient) ListFuelIndex(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FuelIndexList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelIndexList)
+	err := c.cc.Invoke(ctx, FuelSurchargeService_ListFuelIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fuelSurchargeServiceClient) SetFuelIndex(ctx context.Context, in *FuelIndexList, opts ...grpc.CallOption) (*FuelIndexList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FuelIndexList)
+	err := c.cc.Invoke(ctx, FuelSurchargeService_SetFuelIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FuelSurchargeServiceServer is the server API for FuelSurchargeService service.
+// All implementations must embed UnimplementedFuelSurchargeServiceServer
+// for forward compatibility.
+type FuelSurchargeServiceServer interface {
+	ListFuelIndex(context.Context, *Empty) (*FuelIndexList, error)
+	SetFuelIndex(context.Context, *FuelIndexList) (*FuelIndexList, error)
+	mustEmbedUnimplementedFuelSurchargeServiceServer()
+}
+
+// UnimplementedFuelSurchargeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedFuelSurchargeServiceServer struct{}
+
+func (UnimplementedFuelSurchargeServiceServer) ListFuelIndex(context.Context, *Empty) (*FuelIndexList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFuelIndex not implemented")
+}
+func (UnimplementedFuelSurchargeServiceServer) SetFuelIndex(context.Context, *FuelIndexList) (*FuelIndexList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFuelIndex not implemented")
+}
+func (UnimplementedFuelSurchargeServiceServer) mustEmbedUnimplementedFuelSurchargeServiceServer() {}
+func (UnimplementedFuelSurchargeServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeFuelSurchargeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FuelSurchargeServiceServer will
+// result in compilation errors.
+type UnsafeFuelSurchargeServiceServer interface {
+	mustEmbedUnimplementedFuelSurchargeServiceServer()
+}
+
+func RegisterFuelSurchargeServiceServer(s grpc.ServiceRegistrar, srv FuelSurchargeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedFuelSurchargeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&FuelSurchargeService_ServiceDesc, srv)
+}
+
+func _FuelSurchargeService_ListFuelIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelSurchargeServiceServer).ListFuelIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelSurchargeService_ListFuelIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelSurchargeServiceServer).ListFuelIndex(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FuelSurchargeService_SetFuelIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuelIndexList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FuelSurchargeServiceServer).SetFuelIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FuelSurchargeService_SetFuelIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FuelSurchargeServiceServer).SetFuelIndex(ctx, req.(*FuelIndexList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FuelSurchargeService_ServiceDesc is the grpc.ServiceDesc for FuelSurchargeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FuelSurchargeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.FuelSurchargeService",
+	HandlerType: (*FuelSurchargeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListFuelIndex",
+			Handler:    _FuelSurchargeService_ListFuelIndex_Handler,
+		},
+		{
+			MethodName: "SetFuelIndex",
+			Handler:    _FuelSurchargeService_SetFuelIndex_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	PromotionService_ListPromotions_FullMethodName     = "/calculator.PromotionService/ListPromotions"
+	PromotionService_CreatePromotion_FullMethodName    = "/calculator.PromotionService/CreatePromotion"
+	PromotionService_SetPromotionActive_FullMethodName = "/calculator.PromotionService/SetPromotionActive"
+	PromotionService_RedeemPromo_FullMethodName        = "/calculator.PromotionService/RedeemPromo"
+	PromotionService_ReleasePromo_FullMethodName       = "/calculator.PromotionService/ReleasePromo"
+)
+
+// PromotionServiceClient is the client API for PromotionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PromotionServiceClient interface {
+	ListPromotions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PromotionList, error)
+	CreatePromotion(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*Promotion, error)
+	SetPromotionActive(ctx context.Context, in *SetPromotionActiveRequest, opts ...grpc.CallOption) (*Empty, error)
+	RedeemPromo(ctx context.Context, in *RedeemPromoRequest, opts ...grpc.CallOption) (*Empty, error)
+	ReleasePromo(ctx context.Context, in *ReleasePromoRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type promotionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPromotionServiceClient(cc grpc.ClientConnInterface) PromotionServiceClient {
+	return &promotionServiceClient{cc}
+}
+
+func (c *promotionServiceClient) ListPromotions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PromotionList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromotionList)
+	err := c.cc.Invoke(ctx, PromotionService_ListPromotions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) CreatePromotion(ctx context.Context, in *Promotion, opts ...grpc.CallOption) (*Promotion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, PromotionService_CreatePromotion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) SetPromotionActive(ctx context.Context, in *SetPromotionActiveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PromotionService_SetPromotionActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) RedeemPromo(ctx context.Context, in *RedeemPromoRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PromotionService_RedeemPromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promotionServiceClient) ReleasePromo(ctx context.Context, in *ReleasePromoRequest, opts ...grpc.CallOption) (*Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, PromotionService_ReleasePromo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PromotionServiceServer is the server API for PromotionService service.
+// All implementations must embed UnimplementedPromotionServiceServer
+// for forward compatibility.
+type PromotionServiceServer interface {
+	ListPromotions(context.Context, *Empty) (*PromotionList, error)
+	CreatePromotion(context.Context, *Promotion) (*Promotion, error)
+	SetPromotionActive(context.Context, *SetPromotionActiveRequest) (*Empty, error)
+	RedeemPromo(context.Context, *RedeemPromoRequest) (*Empty, error)
+	ReleasePromo(context.Context, *ReleasePromoRequest) (*Empty, error)
+	mustEmbedUnimplementedPromotionServiceServer()
+}
+
+// UnimplementedPromotionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPromotionServiceServer struct{}
+
+func (UnimplementedPromotionServiceServer) ListPromotions(context.Context, *Empty) (*PromotionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPromotions not implemented")
+}
+func (UnimplementedPromotionServiceServer) CreatePromotion(context.Context, *Promotion) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedPromotionServiceServer) SetPromotionActive(context.Context, *SetPromotionActiveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPromotionActive not implemented")
+}
+func (UnimplementedPromotionServiceServer) RedeemPromo(context.Context, *RedeemPromoRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemPromo not implemented")
+}
+func (UnimplementedPromotionServiceServer) ReleasePromo(context.Context, *ReleasePromoRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePromo not implemented")
+}
+func (UnimplementedPromotionServiceServer) mustEmbedUnimplementedPromotionServiceServer() {}
+func (UnimplementedPromotionServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePromotionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PromotionServiceServer will
+// result in compilation errors.
+type UnsafePromotionServiceServer interface {
+	mustEmbedUnimplementedPromotionServiceServer()
+}
+
+func RegisterPromotionServiceServer(s grpc.ServiceRegistrar, srv PromotionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPromotionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PromotionService_ServiceDesc, srv)
+}
+
+func _PromotionService_ListPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).ListPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_ListPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).ListPromotions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Promotion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).CreatePromotion(ctx, req.(*Promotion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_SetPromotionActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPromotionActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).SetPromotionActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_SetPromotionActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).SetPromotionActive(ctx, req.(*SetPromotionActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_RedeemPromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemPromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).RedeemPromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_RedeemPromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).RedeemPromo(ctx, req.(*RedeemPromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromotionService_ReleasePromo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePromoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromotionServiceServer).ReleasePromo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromotionService_ReleasePromo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromotionServiceServer).ReleasePromo(ctx, req.(*ReleasePromoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PromotionService_ServiceDesc is the grpc.ServiceDesc for PromotionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PromotionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.PromotionService",
+	HandlerType: (*PromotionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPromotions",
+			Handler:    _PromotionService_ListPromotions_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _PromotionService_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "SetPromotionActive",
+			Handler:    _PromotionService_SetPromotionActive_Handler,
+		},
+		{
+			MethodName: "RedeemPromo",
+			Handler:    _PromotionService_RedeemPromo_Handler,
+		},
+		{
+			MethodName: "ReleasePromo",
+			Handler:    _PromotionService_ReleasePromo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "calculator/calculator.proto",
+}
+
+const (
+	CityService_SearchCities_FullMethodName   = "/calculator.CityService/SearchCities"
+	CityService_NearestCities_FullMethodName  = "/calculator.CityService/NearestCities"
+	CityService_SetCityAliases_FullMethodName = "/calculator.CityService/SetCityAliases"
+)
+
+// CityServiceClient is the client API for CityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CityServiceClient interface {
+	SearchCities(ctx context.Context, in *CitySearchRequest, opts ...grpc.CallOption) (*CityList, error)
+	NearestCities(ctx context.Context, in *NearestCitiesRequest, opts ...grpc.CallOption) (*CityList, error)
+	SetCityAliases(ctx context.Context, in *CityAliasesRequest, opts ...grpc.CallOption) (*City, error)
+}
+
+type cityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCityServiceClient(cc grpc.ClientConnInterface) CityServiceClient {
+	return &cityServiceClient{cc}
+}
+
+func (c *cityServiceClient) SearchCities(ctx context.Context, in *CitySearchRequest, opts ...grpc.CallOption) (*CityList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CityList)
+	err := c.cc.Invoke(ctx, CityService_SearchCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityServiceClient) NearestCities(ctx context.Context, in *NearestCitiesRequest, opts ...grpc.CallOption) (*CityList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CityList)
+	err := c.cc.Invoke(ctx, CityService_NearestCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cityServiceClient) SetCityAliases(ctx context.Context, in *CityAliasesRequest, opts ...grpc.CallOption) (*City, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(City)
+	err := c.cc.Invoke(ctx, CityService_SetCityAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CityServiceServer is the server API for CityService service.
+// All implementations must embed UnimplementedCityServiceServer
+// for forward compatibility.
+type CityServiceServer interface {
+	SearchCities(context.Context, *CitySearchRequest) (*CityList, error)
+	NearestCities(context.Context, *NearestCitiesRequest) (*CityList, error)
+	SetCityAliases(context.Context, *CityAliasesRequest) (*City, error)
+	mustEmbedUnimplementedCityServiceServer()
+}
+
+// UnimplementedCityServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCityServiceServer struct{}
+
+func (UnimplementedCityServiceServer) SearchCities(context.Context, *CitySearchRequest) (*CityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCities not implemented")
+}
+func (UnimplementedCityServiceServer) NearestCities(context.Context, *NearestCitiesRequest) (*CityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearestCities not implemented")
+}
+func (UnimplementedCityServiceServer) SetCityAliases(context.Context, *CityAliasesRequest) (*City, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCityAliases not implemented")
+}
+func (UnimplementedCityServiceServer) mustEmbedUnimplementedCityServiceServer() {}
+func (UnimplementedCityServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeCityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CityServiceServer will
+// result in compilation errors.
+type UnsafeCityServiceServer interface {
+	mustEmbedUnimplementedCityServiceServer()
+}
+
+func RegisterCityServiceServer(s grpc.ServiceRegistrar, srv CityServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCityServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CityService_ServiceDesc, srv)
+}
+
+func _CityService_SearchCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CitySearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServiceServer).SearchCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CityService_SearchCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServiceServer).SearchCities(ctx, req.(*CitySearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityService_NearestCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServiceServer).NearestCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CityService_NearestCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServiceServer).NearestCities(ctx, req.(*NearestCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CityService_SetCityAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CityServiceServer).SetCityAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CityService_SetCityAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CityServiceServer).SetCityAliases(ctx, req.(*CityAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CityService_ServiceDesc is the grpc.ServiceDesc for CityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "calculator.CityService",
+	HandlerType: (*CityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchCities",
+			Handler:    _CityService_SearchCities_Handler,
+		},
+		{
+			MethodName: "NearestCities",
+			Handler:    _CityService_NearestCities_Handler,
+		},
+		{
+			MethodName: "SetCityAliases",
+			Handler:    _CityService_SetCityAliases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "calculator/calculator.proto",
@@ -23,26 +23,40 @@ const (
 )
 
 type Package struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PackageId      string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
-	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Weight         float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	Length         int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
-	Width          int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
-	Height         int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
-	From           string                 `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
-	To             string                 `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
-	Address        string                 `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
-	PaymentStatus  string                 `protobuf:"bytes,10,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
-	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	Cost           float64                `protobuf:"fixed64,12,opt,name=cost,proto3" json:"cost,omitempty"`
-	EstimatedHours int32                  `protobuf:"varint,13,opt,name=estimated_hours,json=estimatedHours,proto3" json:"estimated_hours,omitempty"`
-	RemainingHours int32                  `protobuf:"varint,14,opt,name=remaining_hours,json=remainingHours,proto3" json:"remaining_hours,omitempty"`
-	Currency       string                 `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	TariffCode     string                 `protobuf:"bytes,17,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PackageId         string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	UserId            string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Weight            float64                `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Length            int32                  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+	Width             int32                  `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height            int32                  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	From              string                 `protobuf:"bytes,7,opt,name=from,proto3" json:"from,omitempty"`
+	To                string                 `protobuf:"bytes,8,opt,name=to,proto3" json:"to,omitempty"`
+	Address           string                 `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	PaymentStatus     string                 `protobuf:"bytes,10,opt,name=payment_status,json=paymentStatus,proto3" json:"payment_status,omitempty"`
+	Status            string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Cost              float64                `protobuf:"fixed64,12,opt,name=cost,proto3" json:"cost,omitempty"`
+	EstimatedHours    int32                  `protobuf:"varint,13,opt,name=estimated_hours,json=estimatedHours,proto3" json:"estimated_hours,omitempty"`
+	RemainingHours    int32                  `protobuf:"varint,14,opt,name=remaining_hours,json=remainingHours,proto3" json:"remaining_hours,omitempty"`
+	Currency          string                 `protobuf:"bytes,15,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TariffCode        string                 `protobuf:"bytes,17,opt,name=tariff_code,json=tariffCode,proto3" json:"tariff_code,omitempty"`
+	Pickup            bool                   `protobuf:"varint,18,opt,name=pickup,proto3" json:"pickup,omitempty"`
+	CourierId         string                 `protobuf:"bytes,19,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Route             []*RouteLeg            `protobuf:"bytes,20,rep,name=route,proto3" json:"route,omitempty"`
+	TariffVersion     int32                  `protobuf:"varint,21,opt,name=tariff_version,json=tariffVersion,proto3" json:"tariff_version,omitempty"`
+	QuoteId           string                 `protobuf:"bytes,22,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	OriginalCost      float64                `protobuf:"fixed64,23,opt,name=original_cost,json=originalCost,proto3" json:"original_cost,omitempty"`
+	OriginalCurrency  string                 `protobuf:"bytes,24,opt,name=original_currency,json=originalCurrency,proto3" json:"original_currency,omitempty"`
+	ExchangeRate      float64                `protobuf:"fixed64,25,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	PromoCode         string                 `protobuf:"bytes,26,opt,name=promo_code,json=promoCode,proto3" json:"promo_code,omitempty"`
+	Discount          float64                `protobuf:"fixed64,27,opt,name=discount,proto3" json:"discount,omitempty"`
+	DeliveryFrom      *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=delivery_from,json=deliveryFrom,proto3" json:"delivery_from,omitempty"`
+	DeliveryTo        *timestamppb.Timestamp `protobuf:"bytes,29,opt,name=delivery_to,json=deliveryTo,proto3" json:"delivery_to,omitempty"`
+	FuelSurchargeRate float64                `protobuf:"fixed64,30,opt,name=fuel_surcharge_rate,json=fuelSurchargeRate,proto3" json:"fuel_surcharge_rate,omitempty"`
+	Category          string                 `protobuf:"bytes,31,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Package) Reset() {
@@ -194,6 +208,180 @@ func (x *Package) GetTariffCode() string {
 	return ""
 }
 
+func (x *Package) GetPickup() bool {
+	if x != nil {
+		return x.Pickup
+	}
+	return false
+}
+
+func (x *Package) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *Package) GetRoute() []*RouteLeg {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *Package) GetTariffVersion() int32 {
+	if x != nil {
+		return x.TariffVersion
+	}
+	return 0
+}
+
+func (x *Package) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *Package) GetOriginalCost() float64 {
+	if x != nil {
+		return x.OriginalCost
+	}
+	return 0
+}
+
+func (x *Package) GetOriginalCurrency() string {
+	if x != nil {
+		return x.OriginalCurrency
+	}
+	return ""
+}
+
+func (x *Package) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *Package) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Package) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *Package) GetDeliveryFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryFrom
+	}
+	return nil
+}
+
+func (x *Package) GetDeliveryTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryTo
+	}
+	return nil
+}
+
+func (x *Package) GetFuelSurchargeRate() float64 {
+	if x != nil {
+		return x.FuelSurchargeRate
+	}
+	return 0
+}
+
+func (x *Package) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type RouteLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromHub       string                 `protobuf:"bytes,1,opt,name=from_hub,json=fromHub,proto3" json:"from_hub,omitempty"`
+	ToHub         string                 `protobuf:"bytes,2,opt,name=to_hub,json=toHub,proto3" json:"to_hub,omitempty"`
+	DistanceKm    float64                `protobuf:"fixed64,3,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	TransitHours  float64                `protobuf:"fixed64,4,opt,name=transit_hours,json=transitHours,proto3" json:"transit_hours,omitempty"`
+	Cost          float64                `protobuf:"fixed64,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteLeg) Reset() {
+	*x = RouteLeg{}
+	mi := &file_database_database_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteLeg) ProtoMessage() {}
+
+func (x *RouteLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteLeg.ProtoReflect.Descriptor instead.
+func (*RouteLeg) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RouteLeg) GetFromHub() string {
+	if x != nil {
+		return x.FromHub
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetToHub() string {
+	if x != nil {
+		return x.ToHub
+	}
+	return ""
+}
+
+func (x *RouteLeg) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetTransitHours() float64 {
+	if x != nil {
+		return x.TransitHours
+	}
+	return 0
+}
+
+func (x *RouteLeg) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
 type PackageFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -207,7 +395,7 @@ type PackageFilter struct {
 
 func (x *PackageFilter) Reset() {
 	*x = PackageFilter{}
-	mi := &file_database_database_proto_msgTypes[1]
+	mi := &file_database_database_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -219,7 +407,7 @@ func (x *PackageFilter) String() string {
 func (*PackageFilter) ProtoMessage() {}
 
 func (x *PackageFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_database_database_proto_msgTypes[1]
+	mi := &file_database_database_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -232,7 +420,7 @@ func (x *PackageFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageFilter.ProtoReflect.Descriptor instead.
 func (*PackageFilter) Descriptor() ([]byte, []int) {
-	return file_database_database_proto_rawDescGZIP(), []int{1}
+	return file_database_database_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PackageFilter) GetUserId() string {
@@ -280,7 +468,7 @@ type PackageUpdate struct {
 
 func (x *PackageUpdate) Reset() {
 	*x = PackageUpdate{}
-	mi := &file_database_database_proto_msgTypes[2]
+	mi := &file_database_database_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +480,7 @@ func (x *PackageUpdate) String() string {
 func (*PackageUpdate) ProtoMessage() {}
 
 func (x *PackageUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_database_database_proto_msgTypes[2]
+	mi := &file_database_database_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +493,7 @@ func (x *PackageUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageUpdate.ProtoReflect.Descriptor instead.
 func (*PackageUpdate) Descriptor() ([]byte, []int) {
-	return file_database_database_proto_rawDescGZIP(), []int{2}
+	return file_database_database_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PackageUpdate) GetStatus() string {
@@ -331,7 +519,7 @@ type PackageID struct {
 
 func (x *PackageID) Reset() {
 	*x = PackageID{}
-	mi := &file_database_database_proto_msgTypes[3]
+	mi := &file_database_database_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -343,7 +531,7 @@ func (x *PackageID) String() string {
 func (*PackageID) ProtoMessage() {}
 
 func (x *PackageID) ProtoReflect() protoreflect.Message {
-	mi := &file_database_database_proto_msgTypes[3]
+	mi := &file_database_database_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +544,7 @@ func (x *PackageID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageID.ProtoReflect.Descriptor instead.
 func (*PackageID) Descriptor() ([]byte, []int) {
-	return file_database_database_proto_rawDescGZIP(), []int{3}
+	return file_database_database_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PackageID) GetPackageId() string {
@@ -375,7 +563,7 @@ type PackageStatus struct {
 
 func (x *PackageStatus) Reset() {
 	*x = PackageStatus{}
-	mi := &file_database_database_proto_msgTypes[4]
+	mi := &file_database_database_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -387,7 +575,7 @@ func (x *PackageStatus) String() string {
 func (*PackageStatus) ProtoMessage() {}
 
 func (x *PackageStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_database_database_proto_msgTypes[4]
+	mi := &file_database_database_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +588,7 @@ func (x *PackageStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageStatus.ProtoReflect.Descriptor instead.
 func (*PackageStatus) Descriptor() ([]byte, []int) {
-	return file_database_database_proto_rawDescGZIP(), []int{4}
+	return file_database_database_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PackageStatus) GetStatus() string {
@@ -418,7 +606,7 @@ type Empty struct {
 
 func (x *Empty) Reset() {
 	*x = Empty{}
-	mi := &file_database_database_proto_msgTypes[5]
+	mi := &file_database_database_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -430,7 +618,7 @@ func (x *Empty) String() string {
 func (*Empty) ProtoMessage() {}
 
 func (x *Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_database_database_proto_msgTypes[5]
+	mi := &file_database_database_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,7 +631,7 @@ func (x *Empty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Empty.ProtoReflect.Descriptor instead.
 func (*Empty) Descriptor() ([]byte, []int) {
-	return file_database_database_proto_rawDescGZIP(), []int{5}
+	return file_database_database_proto_rawDescGZIP(), []int{6}
 }
 
 type PackageList struct {
@@ -455,7 +643,7 @@ type PackageList struct {
 
 func (x *PackageList) Reset() {
 	*x = PackageList{}
-	mi := &file_database_database_proto_msgTypes[6]
+	mi := &file_database_database_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -467,7 +655,7 @@ func (x *PackageList) String() string {
 func (*PackageList) ProtoMessage() {}
 
 func (x *PackageList) ProtoReflect() protoreflect.Message {
-	mi := &file_database_database_proto_msgTypes[6]
+	mi := &file_database_database_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +668,7 @@ func (x *PackageList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PackageList.ProtoReflect.Descriptor instead.
 func (*PackageList) Descriptor() ([]byte, []int) {
-	return file_database_database_proto_rawDescGZIP(), []int{6}
+	return file_database_database_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PackageList) GetPackages() []*Package {
@@ -490,64 +678,1003 @@ func (x *PackageList) GetPackages() []*Package {
 	return nil
 }
 
-var File_database_database_proto protoreflect.FileDescriptor
+type PickupSlot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SlotId        string                 `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Available     int32                  `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_database_database_proto_rawDesc = "" +
-	"\n" +
-	"\x17database/database.proto\x12\bdelivery\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x03\n" +
-	"\aPackage\x12\x1d\n" +
-	"\n" +
-	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x16\n" +
-	"\x06length\x18\x04 \x01(\x05R\x06length\x12\x14\n" +
-	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
-	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x12\n" +
-	"\x04from\x18\a \x01(\tR\x04from\x12\x0e\n" +
-	"\x02to\x18\b \x01(\tR\x02to\x12\x18\n" +
-	"\aaddress\x18\t \x01(\tR\aaddress\x12%\n" +
-	"\x0epayment_status\x18\n" +
-	" \x01(\tR\rpaymentStatus\x12\x16\n" +
-	"\x06status\x18\v \x01(\tR\x06status\x12\x12\n" +
-	"\x04cost\x18\f \x01(\x01R\x04cost\x12'\n" +
-	"\x0festimated_hours\x18\r \x01(\x05R\x0eestimatedHours\x12'\n" +
-	"\x0fremaining_hours\x18\x0e \x01(\x05R\x0eremainingHours\x12\x1a\n" +
-	"\bcurrency\x18\x0f \x01(\tR\bcurrency\x129\n" +
-	"\n" +
-	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
-	"\vtariff_code\x18\x11 \x01(\tR\n" +
-	"tariffCode\"\xaf\x01\n" +
-	"\rPackageFilter\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\x12?\n" +
-	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x05 \x01(\x03R\x06offset\"N\n" +
-	"\rPackageUpdate\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\x12%\n" +
-	"\x0epayment_status\x18\x02 \x01(\tR\rpaymentStatus\"*\n" +
-	"\tPackageID\x12\x1d\n" +
-	"\n" +
-	"package_id\x18\x01 \x01(\tR\tpackageId\"'\n" +
-	"\rPackageStatus\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status\"\a\n" +
-	"\x05Empty\"<\n" +
-	"\vPackageList\x12-\n" +
-	"\bpackages\x18\x01 \x03(\v2\x11.delivery.PackageR\bpackages2\xe2\x05\n" +
-	"\x0ePackageService\x124\n" +
-	"\n" +
-	"GetPackage\x12\x13.delivery.PackageID\x1a\x11.delivery.Package\x12@\n" +
-	"\x0eGetAllPackages\x12\x17.delivery.PackageFilter\x1a\x15.delivery.PackageList\x12<\n" +
-	"\x12GetExpiredPackages\x12\x0f.delivery.Empty\x1a\x15.delivery.PackageList\x12;\n" +
-	"\x11MarkAsExpiredByID\x12\x13.delivery.PackageID\x1a\x11.delivery.Package\x12A\n" +
-	"\x0fGetUserPackages\x12\x17.delivery.PackageFilter\x1a\x15.delivery.PackageList\x125\n" +
-	"\rCreatePackage\x12\x11.delivery.Package\x1a\x11.delivery.Package\x12=\n" +
-	"\x15CreatePackageWithCalc\x12\x11.delivery.Package\x1a\x11.delivery.Package\x125\n" +
-	"\rUpdatePackage\x12\x11.delivery.Package\x1a\x11.delivery.Package\x125\n" +
-	"\rDeletePackage\x12\x13.delivery.PackageID\x1a\x0f.delivery.Empty\x127\n" +
-	"\rCancelPackage\x12\x13.delivery.PackageID\x1a\x11.delivery.Package\x12@\n" +
-	"\x10GetPackageStatus\x12\x13.delivery.PackageID\x1a\x17.delivery.PackageStatus\x12;\n" +
-	"\x17TransferExpiredPackages\x12\x0f.delivery.Empty\x1a\x0f.delivery.EmptyBHZFgithub.com/maksroxx/DeliveryService/proto/database/database;databasepbb\x06proto3"
+func (x *PickupSlot) Reset() {
+	*x = PickupSlot{}
+	mi := &file_database_database_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlot) ProtoMessage() {}
+
+func (x *PickupSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlot.ProtoReflect.Descriptor instead.
+func (*PickupSlot) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PickupSlot) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *PickupSlot) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *PickupSlot) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type PickupSlotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupSlotsRequest) Reset() {
+	*x = PickupSlotsRequest{}
+	mi := &file_database_database_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlotsRequest) ProtoMessage() {}
+
+func (x *PickupSlotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlotsRequest.ProtoReflect.Descriptor instead.
+func (*PickupSlotsRequest) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PickupSlotsRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PickupSlotsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type PickupSlotList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         []*PickupSlot          `protobuf:"bytes,1,rep,name=slots,proto3" json:"slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupSlotList) Reset() {
+	*x = PickupSlotList{}
+	mi := &file_database_database_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupSlotList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupSlotList) ProtoMessage() {}
+
+func (x *PickupSlotList) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupSlotList.ProtoReflect.Descriptor instead.
+func (*PickupSlotList) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PickupSlotList) GetSlots() []*PickupSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+type BookPickupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	City          string                 `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BookPickupRequest) Reset() {
+	*x = BookPickupRequest{}
+	mi := &file_database_database_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BookPickupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookPickupRequest) ProtoMessage() {}
+
+func (x *BookPickupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookPickupRequest.ProtoReflect.Descriptor instead.
+func (*BookPickupRequest) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BookPickupRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *BookPickupRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *BookPickupRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BookPickupRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+type ReschedulePickupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReschedulePickupRequest) Reset() {
+	*x = ReschedulePickupRequest{}
+	mi := &file_database_database_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReschedulePickupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReschedulePickupRequest) ProtoMessage() {}
+
+func (x *ReschedulePickupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReschedulePickupRequest.ProtoReflect.Descriptor instead.
+func (*ReschedulePickupRequest) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReschedulePickupRequest) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *ReschedulePickupRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ReschedulePickupRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+type PickupBookingID struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupBookingID) Reset() {
+	*x = PickupBookingID{}
+	mi := &file_database_database_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupBookingID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupBookingID) ProtoMessage() {}
+
+func (x *PickupBookingID) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupBookingID.ProtoReflect.Descriptor instead.
+func (*PickupBookingID) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PickupBookingID) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+type PickupBooking struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BookingId     string                 `protobuf:"bytes,1,opt,name=booking_id,json=bookingId,proto3" json:"booking_id,omitempty"`
+	PackageId     string                 `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	SlotId        string                 `protobuf:"bytes,3,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Date          string                 `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	Start         string                 `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupBooking) Reset() {
+	*x = PickupBooking{}
+	mi := &file_database_database_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupBooking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupBooking) ProtoMessage() {}
+
+func (x *PickupBooking) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupBooking.ProtoReflect.Descriptor instead.
+func (*PickupBooking) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PickupBooking) GetBookingId() string {
+	if x != nil {
+		return x.BookingId
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PickupBooking) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Courier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourierId     string                 `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	City          string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	VehicleType   string                 `protobuf:"bytes,5,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	MaxWeight     float64                `protobuf:"fixed64,6,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	MaxPackages   int32                  `protobuf:"varint,7,opt,name=max_packages,json=maxPackages,proto3" json:"max_packages,omitempty"`
+	LoadWeight    float64                `protobuf:"fixed64,8,opt,name=load_weight,json=loadWeight,proto3" json:"load_weight,omitempty"`
+	LoadPackages  int32                  `protobuf:"varint,9,opt,name=load_packages,json=loadPackages,proto3" json:"load_packages,omitempty"`
+	OnShift       bool                   `protobuf:"varint,10,opt,name=on_shift,json=onShift,proto3" json:"on_shift,omitempty"`
+	ShiftEndsAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=shift_ends_at,json=shiftEndsAt,proto3" json:"shift_ends_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Courier) Reset() {
+	*x = Courier{}
+	mi := &file_database_database_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Courier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Courier) ProtoMessage() {}
+
+func (x *Courier) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Courier.ProtoReflect.Descriptor instead.
+func (*Courier) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Courier) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *Courier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Courier) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Courier) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Courier) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *Courier) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *Courier) GetMaxPackages() int32 {
+	if x != nil {
+		return x.MaxPackages
+	}
+	return 0
+}
+
+func (x *Courier) GetLoadWeight() float64 {
+	if x != nil {
+		return x.LoadWeight
+	}
+	return 0
+}
+
+func (x *Courier) GetLoadPackages() int32 {
+	if x != nil {
+		return x.LoadPackages
+	}
+	return 0
+}
+
+func (x *Courier) GetOnShift() bool {
+	if x != nil {
+		return x.OnShift
+	}
+	return false
+}
+
+func (x *Courier) GetShiftEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShiftEndsAt
+	}
+	return nil
+}
+
+type CourierProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	City          string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	VehicleType   string                 `protobuf:"bytes,4,opt,name=vehicle_type,json=vehicleType,proto3" json:"vehicle_type,omitempty"`
+	MaxWeight     float64                `protobuf:"fixed64,5,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	MaxPackages   int32                  `protobuf:"varint,6,opt,name=max_packages,json=maxPackages,proto3" json:"max_packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CourierProfile) Reset() {
+	*x = CourierProfile{}
+	mi := &file_database_database_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourierProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourierProfile) ProtoMessage() {}
+
+func (x *CourierProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourierProfile.ProtoReflect.Descriptor instead.
+func (*CourierProfile) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CourierProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CourierProfile) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CourierProfile) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CourierProfile) GetVehicleType() string {
+	if x != nil {
+		return x.VehicleType
+	}
+	return ""
+}
+
+func (x *CourierProfile) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *CourierProfile) GetMaxPackages() int32 {
+	if x != nil {
+		return x.MaxPackages
+	}
+	return 0
+}
+
+type StartShiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hours         int32                  `protobuf:"varint,1,opt,name=hours,proto3" json:"hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartShiftRequest) Reset() {
+	*x = StartShiftRequest{}
+	mi := &file_database_database_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartShiftRequest) ProtoMessage() {}
+
+func (x *StartShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartShiftRequest.ProtoReflect.Descriptor instead.
+func (*StartShiftRequest) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *StartShiftRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+type AssignPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	CourierId     string                 `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignPackageRequest) Reset() {
+	*x = AssignPackageRequest{}
+	mi := &file_database_database_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignPackageRequest) ProtoMessage() {}
+
+func (x *AssignPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignPackageRequest.ProtoReflect.Descriptor instead.
+func (*AssignPackageRequest) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AssignPackageRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *AssignPackageRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type DeliveryStatusUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     string                 `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryStatusUpdate) Reset() {
+	*x = DeliveryStatusUpdate{}
+	mi := &file_database_database_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryStatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryStatusUpdate) ProtoMessage() {}
+
+func (x *DeliveryStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_database_database_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryStatusUpdate.ProtoReflect.Descriptor instead.
+func (*DeliveryStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_database_database_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeliveryStatusUpdate) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *DeliveryStatusUpdate) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_database_database_proto protoreflect.FileDescriptor
+
+const file_database_database_proto_rawDesc = "" +
+	"\n" +
+	"\x17database/database.proto\x12\bdelivery\x1a\x1fgoogle/protobuf/timestamp.proto\"\x99\b\n" +
+	"\aPackage\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06weight\x18\x03 \x01(\x01R\x06weight\x12\x16\n" +
+	"\x06length\x18\x04 \x01(\x05R\x06length\x12\x14\n" +
+	"\x05width\x18\x05 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x06 \x01(\x05R\x06height\x12\x12\n" +
+	"\x04from\x18\a \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\b \x01(\tR\x02to\x12\x18\n" +
+	"\aaddress\x18\t \x01(\tR\aaddress\x12%\n" +
+	"\x0epayment_status\x18\n" +
+	" \x01(\tR\rpaymentStatus\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x12\n" +
+	"\x04cost\x18\f \x01(\x01R\x04cost\x12'\n" +
+	"\x0festimated_hours\x18\r \x01(\x05R\x0eestimatedHours\x12'\n" +
+	"\x0fremaining_hours\x18\x0e \x01(\x05R\x0eremainingHours\x12\x1a\n" +
+	"\bcurrency\x18\x0f \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vtariff_code\x18\x11 \x01(\tR\n" +
+	"tariffCode\x12\x16\n" +
+	"\x06pickup\x18\x12 \x01(\bR\x06pickup\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x13 \x01(\tR\tcourierId\x12(\n" +
+	"\x05route\x18\x14 \x03(\v2\x12.delivery.RouteLegR\x05route\x12%\n" +
+	"\x0etariff_version\x18\x15 \x01(\x05R\rtariffVersion\x12\x19\n" +
+	"\bquote_id\x18\x16 \x01(\tR\aquoteId\x12#\n" +
+	"\roriginal_cost\x18\x17 \x01(\x01R\foriginalCost\x12+\n" +
+	"\x11original_currency\x18\x18 \x01(\tR\x10originalCurrency\x12#\n" +
+	"\rexchange_rate\x18\x19 \x01(\x01R\fexchangeRate\x12\x1d\n" +
+	"\n" +
+	"promo_code\x18\x1a \x01(\tR\tpromoCode\x12\x1a\n" +
+	"\bdiscount\x18\x1b \x01(\x01R\bdiscount\x12?\n" +
+	"\rdelivery_from\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampR\fdeliveryFrom\x12;\n" +
+	"\vdelivery_to\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"deliveryTo\x12.\n" +
+	"\x13fuel_surcharge_rate\x18\x1e \x01(\x01R\x11fuelSurchargeRate\x12\x1a\n" +
+	"\bcategory\x18\x1f \x01(\tR\bcategory\"\x96\x01\n" +
+	"\bRouteLeg\x12\x19\n" +
+	"\bfrom_hub\x18\x01 \x01(\tR\afromHub\x12\x15\n" +
+	"\x06to_hub\x18\x02 \x01(\tR\x05toHub\x12\x1f\n" +
+	"\vdistance_km\x18\x03 \x01(\x01R\n" +
+	"distanceKm\x12#\n" +
+	"\rtransit_hours\x18\x04 \x01(\x01R\ftransitHours\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\x01R\x04cost\"\xaf\x01\n" +
+	"\rPackageFilter\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12?\n" +
+	"\rcreated_after\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\fcreatedAfter\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x03R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\"N\n" +
+	"\rPackageUpdate\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12%\n" +
+	"\x0epayment_status\x18\x02 \x01(\tR\rpaymentStatus\"*\n" +
+	"\tPackageID\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\"'\n" +
+	"\rPackageStatus\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\a\n" +
+	"\x05Empty\"<\n" +
+	"\vPackageList\x12-\n" +
+	"\bpackages\x18\x01 \x03(\v2\x11.delivery.PackageR\bpackages\"\xaf\x01\n" +
+	"\n" +
+	"PickupSlot\x12\x17\n" +
+	"\aslot_id\x18\x01 \x01(\tR\x06slotId\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\tR\x03end\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x1c\n" +
+	"\tavailable\x18\a \x01(\x05R\tavailable\"<\n" +
+	"\x12PickupSlotsRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"<\n" +
+	"\x0ePickupSlotList\x12*\n" +
+	"\x05slots\x18\x01 \x03(\v2\x14.delivery.PickupSlotR\x05slots\"p\n" +
+	"\x11BookPickupRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x12\n" +
+	"\x04city\x18\x02 \x01(\tR\x04city\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\tR\x05start\"b\n" +
+	"\x17ReschedulePickupRequest\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\"0\n" +
+	"\x0fPickupBookingID\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\"\x89\x02\n" +
+	"\rPickupBooking\x12\x1d\n" +
+	"\n" +
+	"booking_id\x18\x01 \x01(\tR\tbookingId\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\tR\tpackageId\x12\x17\n" +
+	"\aslot_id\x18\x03 \x01(\tR\x06slotId\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x14\n" +
+	"\x05start\x18\x06 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\a \x01(\tR\x03end\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xec\x02\n" +
+	"\aCourier\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x01 \x01(\tR\tcourierId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12!\n" +
+	"\fvehicle_type\x18\x05 \x01(\tR\vvehicleType\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x06 \x01(\x01R\tmaxWeight\x12!\n" +
+	"\fmax_packages\x18\a \x01(\x05R\vmaxPackages\x12\x1f\n" +
+	"\vload_weight\x18\b \x01(\x01R\n" +
+	"loadWeight\x12#\n" +
+	"\rload_packages\x18\t \x01(\x05R\floadPackages\x12\x19\n" +
+	"\bon_shift\x18\n" +
+	" \x01(\bR\aonShift\x12>\n" +
+	"\rshift_ends_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vshiftEndsAt\"\xb3\x01\n" +
+	"\x0eCourierProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12!\n" +
+	"\fvehicle_type\x18\x04 \x01(\tR\vvehicleType\x12\x1d\n" +
+	"\n" +
+	"max_weight\x18\x05 \x01(\x01R\tmaxWeight\x12!\n" +
+	"\fmax_packages\x18\x06 \x01(\x05R\vmaxPackages\")\n" +
+	"\x11StartShiftRequest\x12\x14\n" +
+	"\x05hours\x18\x01 \x01(\x05R\x05hours\"T\n" +
+	"\x14AssignPackageRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x1d\n" +
+	"\n" +
+	"courier_id\x18\x02 \x01(\tR\tcourierId\"M\n" +
+	"\x14DeliveryStatusUpdate\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tR\tpackageId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status2\xe2\x05\n" +
+	"\x0ePackageService\x124\n" +
+	"\n" +
+	"GetPackage\x12\x13.delivery.PackageID\x1a\x11.delivery.Package\x12@\n" +
+	"\x0eGetAllPackages\x12\x17.delivery.PackageFilter\x1a\x15.delivery.PackageList\x12<\n" +
+	"\x12GetExpiredPackages\x12\x0f.delivery.Empty\x1a\x15.delivery.PackageList\x12;\n" +
+	"\x11MarkAsExpiredByID\x12\x13.delivery.PackageID\x1a\x11.delivery.Package\x12A\n" +
+	"\x0fGetUserPackages\x12\x17.delivery.PackageFilter\x1a\x15.delivery.PackageList\x125\n" +
+	"\rCreatePackage\x12\x11.delivery.Package\x1a\x11.delivery.Package\x12=\n" +
+	"\x15CreatePackageWithCalc\x12\x11.delivery.Package\x1a\x11.delivery.Package\x125\n" +
+	"\rUpdatePackage\x12\x11.delivery.Package\x1a\x11.delivery.Package\x125\n" +
+	"\rDeletePackage\x12\x13.delivery.PackageID\x1a\x0f.delivery.Empty\x127\n" +
+	"\rCancelPackage\x12\x13.delivery.PackageID\x1a\x11.delivery.Package\x12@\n" +
+	"\x10GetPackageStatus\x12\x13.delivery.PackageID\x1a\x17.delivery.PackageStatus\x12;\n" +
+	"\x17TransferExpiredPackages\x12\x0f.delivery.Empty\x1a\x0f.delivery.Empty2\xb1\x02\n" +
+	"\rPickupService\x12H\n" +
+	"\x0eGetPickupSlots\x12\x1c.delivery.PickupSlotsRequest\x1a\x18.delivery.PickupSlotList\x12B\n" +
+	"\n" +
+	"BookPickup\x12\x1b.delivery.BookPickupRequest\x1a\x17.delivery.PickupBooking\x12N\n" +
+	"\x10ReschedulePickup\x12!.delivery.ReschedulePickupRequest\x1a\x17.delivery.PickupBooking\x12B\n" +
+	"\fCancelPickup\x12\x19.delivery.PickupBookingID\x1a\x17.delivery.PickupBooking2\xd0\x03\n" +
+	"\x0eCourierService\x12A\n" +
+	"\x12SaveCourierProfile\x12\x18.delivery.CourierProfile\x1a\x11.delivery.Courier\x127\n" +
+	"\x11GetCourierProfile\x12\x0f.delivery.Empty\x1a\x11.delivery.Courier\x12<\n" +
+	"\n" +
+	"StartShift\x12\x1b.delivery.StartShiftRequest\x1a\x11.delivery.Courier\x12.\n" +
+	"\bEndShift\x12\x0f.delivery.Empty\x1a\x11.delivery.Courier\x12B\n" +
+	"\rAssignPackage\x12\x1e.delivery.AssignPackageRequest\x1a\x11.delivery.Package\x12E\n" +
+	"\x13GetAssignedPackages\x12\x17.delivery.PackageFilter\x1a\x15.delivery.PackageList\x12I\n" +
+	"\x14UpdateDeliveryStatus\x12\x1e.delivery.DeliveryStatusUpdate\x1a\x11.delivery.PackageBHZFgithub.com/maksroxx/DeliveryService/proto/database/database;databasepbb\x06proto3"
 
 var (
 	file_database_database_proto_rawDescOnce sync.Once
@@ -561,50 +1688,91 @@ func file_database_database_proto_rawDescGZIP() []byte {
 	return file_database_database_proto_rawDescData
 }
 
-var file_database_database_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_database_database_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_database_database_proto_goTypes = []any{
-	(*Package)(nil),               // 0: delivery.Package
-	(*PackageFilter)(nil),         // 1: delivery.PackageFilter
-	(*PackageUpdate)(nil),         // 2: delivery.PackageUpdate
-	(*PackageID)(nil),             // 3: delivery.PackageID
-	(*PackageStatus)(nil),         // 4: delivery.PackageStatus
-	(*Empty)(nil),                 // 5: delivery.Empty
-	(*PackageList)(nil),           // 6: delivery.PackageList
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*Package)(nil),                 // 0: delivery.Package
+	(*RouteLeg)(nil),                // 1: delivery.RouteLeg
+	(*PackageFilter)(nil),           // 2: delivery.PackageFilter
+	(*PackageUpdate)(nil),           // 3: delivery.PackageUpdate
+	(*PackageID)(nil),               // 4: delivery.PackageID
+	(*PackageStatus)(nil),           // 5: delivery.PackageStatus
+	(*Empty)(nil),                   // 6: delivery.Empty
+	(*PackageList)(nil),             // 7: delivery.PackageList
+	(*PickupSlot)(nil),              // 8: delivery.PickupSlot
+	(*PickupSlotsRequest)(nil),      // 9: delivery.PickupSlotsRequest
+	(*PickupSlotList)(nil),          // 10: delivery.PickupSlotList
+	(*BookPickupRequest)(nil),       // 11: delivery.BookPickupRequest
+	(*ReschedulePickupRequest)(nil), // 12: delivery.ReschedulePickupRequest
+	(*PickupBookingID)(nil),         // 13: delivery.PickupBookingID
+	(*PickupBooking)(nil),           // 14: delivery.PickupBooking
+	(*Courier)(nil),                 // 15: delivery.Courier
+	(*CourierProfile)(nil),          // 16: delivery.CourierProfile
+	(*StartShiftRequest)(nil),       // 17: delivery.StartShiftRequest
+	(*AssignPackageRequest)(nil),    // 18: delivery.AssignPackageRequest
+	(*DeliveryStatusUpdate)(nil),    // 19: delivery.DeliveryStatusUpdate
+	(*timestamppb.Timestamp)(nil),   // 20: google.protobuf.Timestamp
 }
 var file_database_database_proto_depIdxs = []int32{
-	7,  // 0: delivery.Package.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 1: delivery.PackageFilter.created_after:type_name -> google.protobuf.Timestamp
-	0,  // 2: delivery.PackageList.packages:type_name -> delivery.Package
-	3,  // 3: delivery.PackageService.GetPackage:input_type -> delivery.PackageID
-	1,  // 4: delivery.PackageService.GetAllPackages:input_type -> delivery.PackageFilter
-	5,  // 5: delivery.PackageService.GetExpiredPackages:input_type -> delivery.Empty
-	3,  // 6: delivery.PackageService.MarkAsExpiredByID:input_type -> delivery.PackageID
-	1,  // 7: delivery.PackageService.GetUserPackages:input_type -> delivery.PackageFilter
-	0,  // 8: delivery.PackageService.CreatePackage:input_type -> delivery.Package
-	0,  // 9: delivery.PackageService.CreatePackageWithCalc:input_type -> delivery.Package
-	0,  // 10: delivery.PackageService.UpdatePackage:input_type -> delivery.Package
-	3,  // 11: delivery.PackageService.DeletePackage:input_type -> delivery.PackageID
-	3,  // 12: delivery.PackageService.CancelPackage:input_type -> delivery.PackageID
-	3,  // 13: delivery.PackageService.GetPackageStatus:input_type -> delivery.PackageID
-	5,  // 14: delivery.PackageService.TransferExpiredPackages:input_type -> delivery.Empty
-	0,  // 15: delivery.PackageService.GetPackage:output_type -> delivery.Package
-	6,  // 16: delivery.PackageService.GetAllPackages:output_type -> delivery.PackageList
-	6,  // 17: delivery.PackageService.GetExpiredPackages:output_type -> delivery.PackageList
-	0,  // 18: delivery.PackageService.MarkAsExpiredByID:output_type -> delivery.Package
-	6,  // 19: delivery.PackageService.GetUserPackages:output_type -> delivery.PackageList
-	0,  // 20: delivery.PackageService.CreatePackage:output_type -> delivery.Package
-	0,  // 21: delivery.PackageService.CreatePackageWithCalc:output_type -> delivery.Package
-	0,  // 22: delivery.PackageService.UpdatePackage:output_type -> delivery.Package
-	5,  // 23: delivery.PackageService.DeletePackage:output_type -> delivery.Empty
-	0,  // 24: delivery.PackageService.CancelPackage:output_type -> delivery.Package
-	4,  // 25: delivery.PackageService.GetPackageStatus:output_type -> delivery.PackageStatus
-	5,  // 26: delivery.PackageService.TransferExpiredPackages:output_type -> delivery.Empty
-	15, // [15:27] is the sub-list for method output_type
-	3,  // [3:15] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	20, // 0: delivery.Package.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: delivery.Package.route:type_name -> delivery.RouteLeg
+	20, // 2: delivery.Package.delivery_from:type_name -> google.protobuf.Timestamp
+	20, // 3: delivery.Package.delivery_to:type_name -> google.protobuf.Timestamp
+	20, // 4: delivery.PackageFilter.created_after:type_name -> google.protobuf.Timestamp
+	0,  // 5: delivery.PackageList.packages:type_name -> delivery.Package
+	8,  // 6: delivery.PickupSlotList.slots:type_name -> delivery.PickupSlot
+	20, // 7: delivery.PickupBooking.created_at:type_name -> google.protobuf.Timestamp
+	20, // 8: delivery.Courier.shift_ends_at:type_name -> google.protobuf.Timestamp
+	4,  // 9: delivery.PackageService.GetPackage:input_type -> delivery.PackageID
+	2,  // 10: delivery.PackageService.GetAllPackages:input_type -> delivery.PackageFilter
+	6,  // 11: delivery.PackageService.GetExpiredPackages:input_type -> delivery.Empty
+	4,  // 12: delivery.PackageService.MarkAsExpiredByID:input_type -> delivery.PackageID
+	2,  // 13: delivery.PackageService.GetUserPackages:input_type -> delivery.PackageFilter
+	0,  // 14: delivery.PackageService.CreatePackage:input_type -> delivery.Package
+	0,  // 15: delivery.PackageService.CreatePackageWithCalc:input_type -> delivery.Package
+	0,  // 16: delivery.PackageService.UpdatePackage:input_type -> delivery.Package
+	4,  // 17: delivery.PackageService.DeletePackage:input_type -> delivery.PackageID
+	4,  // 18: delivery.PackageService.CancelPackage:input_type -> delivery.PackageID
+	4,  // 19: delivery.PackageService.GetPackageStatus:input_type -> delivery.PackageID
+	6,  // 20: delivery.PackageService.TransferExpiredPackages:input_type -> delivery.Empty
+	9,  // 21: delivery.PickupService.GetPickupSlots:input_type -> delivery.PickupSlotsRequest
+	11, // 22: delivery.PickupService.BookPickup:input_type -> delivery.BookPickupRequest
+	12, // 23: delivery.PickupService.ReschedulePickup:input_type -> delivery.ReschedulePickupRequest
+	13, // 24: delivery.PickupService.CancelPickup:input_type -> delivery.PickupBookingID
+	16, // 25: delivery.CourierService.SaveCourierProfile:input_type -> delivery.CourierProfile
+	6,  // 26: delivery.CourierService.GetCourierProfile:input_type -> delivery.Empty
+	17, // 27: delivery.CourierService.StartShift:input_type -> delivery.StartShiftRequest
+	6,  // 28: delivery.CourierService.EndShift:input_type -> delivery.Empty
+	18, // 29: delivery.CourierService.AssignPackage:input_type -> delivery.AssignPackageRequest
+	2,  // 30: delivery.CourierService.GetAssignedPackages:input_type -> delivery.PackageFilter
+	19, // 31: delivery.CourierService.UpdateDeliveryStatus:input_type -> delivery.DeliveryStatusUpdate
+	0,  // 32: delivery.PackageService.GetPackage:output_type -> delivery.Package
+	7,  // 33: delivery.PackageService.GetAllPackages:output_type -> delivery.PackageList
+	7,  // 34: delivery.PackageService.GetExpiredPackages:output_type -> delivery.PackageList
+	0,  // 35: delivery.PackageService.MarkAsExpiredByID:output_type -> delivery.Package
+	7,  // 36: delivery.PackageService.GetUserPackages:output_type -> delivery.PackageList
+	0,  // 37: delivery.PackageService.CreatePackage:output_type -> delivery.Package
+	0,  // 38: delivery.PackageService.CreatePackageWithCalc:output_type -> delivery.Package
+	0,  // 39: delivery.PackageService.UpdatePackage:output_type -> delivery.Package
+	6,  // 40: delivery.PackageService.DeletePackage:output_type -> delivery.Empty
+	0,  // 41: delivery.PackageService.CancelPackage:output_type -> delivery.Package
+	5,  // 42: delivery.PackageService.GetPackageStatus:output_type -> delivery.PackageStatus
+	6,  // 43: delivery.PackageService.TransferExpiredPackages:output_type -> delivery.Empty
+	10, // 44: delivery.PickupService.GetPickupSlots:output_type -> delivery.PickupSlotList
+	14, // 45: delivery.PickupService.BookPickup:output_type -> delivery.PickupBooking
+	14, // 46: delivery.PickupService.ReschedulePickup:output_type -> delivery.PickupBooking
+	14, // 47: delivery.PickupService.CancelPickup:output_type -> delivery.PickupBooking
+	15, // 48: delivery.CourierService.SaveCourierProfile:output_type -> delivery.Courier
+	15, // 49: delivery.CourierService.GetCourierProfile:output_type -> delivery.Courier
+	15, // 50: delivery.CourierService.StartShift:output_type -> delivery.Courier
+	15, // 51: delivery.CourierService.EndShift:output_type -> delivery.Courier
+	0,  // 52: delivery.CourierService.AssignPackage:output_type -> delivery.Package
+	7,  // 53: delivery.CourierService.GetAssignedPackages:output_type -> delivery.PackageList
+	0,  // 54: delivery.CourierService.UpdateDeliveryStatus:output_type -> delivery.Package
+	32, // [32:55] is the sub-list for method output_type
+	9,  // [9:32] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_database_database_proto_init() }
@@ -618,9 +1786,9 @@ func file_database_database_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_database_database_proto_rawDesc), len(file_database_database_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   20,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_database_database_proto_goTypes,
 		DependencyIndexes: file_database_database_proto_depIdxs,
@@ -537,3 +537,549 @@ var PackageService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "database/database.proto",
 }
+
+const (
+	PickupService_GetPickupSlots_FullMethodName   = "/delivery.PickupService/GetPickupSlots"
+	PickupService_BookPickup_FullMethodName       = "/delivery.PickupService/BookPickup"
+	PickupService_ReschedulePickup_FullMethodName = "/delivery.PickupService/ReschedulePickup"
+	PickupService_CancelPickup_FullMethodName     = "/delivery.PickupService/CancelPickup"
+)
+
+// PickupServiceClient is the client API for PickupService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PickupServiceClient interface {
+	GetPickupSlots(ctx context.Context, in *PickupSlotsRequest, opts ...grpc.CallOption) (*PickupSlotList, error)
+	BookPickup(ctx context.Context, in *BookPickupRequest, opts ...grpc.CallOption) (*PickupBooking, error)
+	ReschedulePickup(ctx context.Context, in *ReschedulePickupRequest, opts ...grpc.CallOption) (*PickupBooking, error)
+	CancelPickup(ctx context.Context, in *PickupBookingID, opts ...grpc.CallOption) (*PickupBooking, error)
+}
+
+type pickupServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPickupServiceClient(cc grpc.ClientConnInterface) PickupServiceClient {
+	return &pickupServiceClient{cc}
+}
+
+func (c *pickupServiceClient) GetPickupSlots(ctx context.Context, in *PickupSlotsRequest, opts ...grpc.CallOption) (*PickupSlotList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupSlotList)
+	err := c.cc.Invoke(ctx, PickupService_GetPickupSlots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) BookPickup(ctx context.Context, in *BookPickupRequest, opts ...grpc.CallOption) (*PickupBooking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupBooking)
+	err := c.cc.Invoke(ctx, PickupService_BookPickup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) ReschedulePickup(ctx context.Context, in *ReschedulePickupRequest, opts ...grpc.CallOption) (*PickupBooking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupBooking)
+	err := c.cc.Invoke(ctx, PickupService_ReschedulePickup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pickupServiceClient) CancelPickup(ctx context.Context, in *PickupBookingID, opts ...grpc.CallOption) (*PickupBooking, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupBooking)
+	err := c.cc.Invoke(ctx, PickupService_CancelPickup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PickupServiceServer is the server API for PickupService service.
+// All implementations must embed UnimplementedPickupServiceServer
+// for forward compatibility.
+type PickupServiceServer interface {
+	GetPickupSlots(context.Context, *PickupSlotsRequest) (*PickupSlotList, error)
+	BookPickup(context.Context, *BookPickupRequest) (*PickupBooking, error)
+	ReschedulePickup(context.Context, *ReschedulePickupRequest) (*PickupBooking, error)
+	CancelPickup(context.Context, *PickupBookingID) (*PickupBooking, error)
+	mustEmbedUnimplementedPickupServiceServer()
+}
+
+// UnimplementedPickupServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPickupServiceServer struct{}
+
+func (UnimplementedPickupServiceServer) GetPickupSlots(context.Context, *PickupSlotsRequest) (*PickupSlotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupSlots not implemented")
+}
+func (UnimplementedPickupServiceServer) BookPickup(context.Context, *BookPickupRequest) (*PickupBooking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BookPickup not implemented")
+}
+func (UnimplementedPickupServiceServer) ReschedulePickup(context.Context, *ReschedulePickupRequest) (*PickupBooking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReschedulePickup not implemented")
+}
+func (UnimplementedPickupServiceServer) CancelPickup(context.Context, *PickupBookingID) (*PickupBooking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPickup not implemented")
+}
+func (UnimplementedPickupServiceServer) mustEmbedUnimplementedPickupServiceServer() {}
+func (UnimplementedPickupServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePickupServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PickupServiceServer will
+// result in compilation errors.
+type UnsafePickupServiceServer interface {
+	mustEmbedUnimplementedPickupServiceServer()
+}
+
+func RegisterPickupServiceServer(s grpc.ServiceRegistrar, srv PickupServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPickupServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PickupService_ServiceDesc, srv)
+}
+
+func _PickupService_GetPickupSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).GetPickupSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_GetPickupSlots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).GetPickupSlots(ctx, req.(*PickupSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_BookPickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookPickupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).BookPickup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_BookPickup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).BookPickup(ctx, req.(*BookPickupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_ReschedulePickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReschedulePickupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).ReschedulePickup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_ReschedulePickup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).ReschedulePickup(ctx, req.(*ReschedulePickupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PickupService_CancelPickup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupBookingID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PickupServiceServer).CancelPickup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PickupService_CancelPickup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PickupServiceServer).CancelPickup(ctx, req.(*PickupBookingID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PickupService_ServiceDesc is the grpc.ServiceDesc for PickupService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PickupService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery.PickupService",
+	HandlerType: (*PickupServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPickupSlots",
+			Handler:    _PickupService_GetPickupSlots_Handler,
+		},
+		{
+			MethodName: "BookPickup",
+			Handler:    _PickupService_BookPickup_Handler,
+		},
+		{
+			MethodName: "ReschedulePickup",
+			Handler:    _PickupService_ReschedulePickup_Handler,
+		},
+		{
+			MethodName: "CancelPickup",
+			Handler:    _PickupService_CancelPickup_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "database/database.proto",
+}
+
+const (
+	CourierService_SaveCourierProfile_FullMethodName   = "/delivery.CourierService/SaveCourierProfile"
+	CourierService_GetCourierProfile_FullMethodName    = "/delivery.CourierService/GetCourierProfile"
+	CourierService_StartShift_FullMethodName           = "/delivery.CourierService/StartShift"
+	CourierService_EndShift_FullMethodName             = "/delivery.CourierService/EndShift"
+	CourierService_AssignPackage_FullMethodName        = "/delivery.CourierService/AssignPackage"
+	CourierService_GetAssignedPackages_FullMethodName  = "/delivery.CourierService/GetAssignedPackages"
+	CourierService_UpdateDeliveryStatus_FullMethodName = "/delivery.CourierService/UpdateDeliveryStatus"
+)
+
+// CourierServiceClient is the client API for CourierService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CourierServiceClient interface {
+	SaveCourierProfile(ctx context.Context, in *CourierProfile, opts ...grpc.CallOption) (*Courier, error)
+	GetCourierProfile(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Courier, error)
+	StartShift(ctx context.Context, in *StartShiftRequest, opts ...grpc.CallOption) (*Courier, error)
+	EndShift(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Courier, error)
+	AssignPackage(ctx context.Context, in *AssignPackageRequest, opts ...grpc.CallOption) (*Package, error)
+	GetAssignedPackages(ctx context.Context, in *PackageFilter, opts ...grpc.CallOption) (*PackageList, error)
+	UpdateDeliveryStatus(ctx context.Context, in *DeliveryStatusUpdate, opts ...grpc.CallOption) (*Package, error)
+}
+
+type courierServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourierServiceClient(cc grpc.ClientConnInterface) CourierServiceClient {
+	return &courierServiceClient{cc}
+}
+
+func (c *courierServiceClient) SaveCourierProfile(ctx context.Context, in *CourierProfile, opts ...grpc.CallOption) (*Courier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courier)
+	err := c.cc.Invoke(ctx, CourierService_SaveCourierProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) GetCourierProfile(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Courier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courier)
+	err := c.cc.Invoke(ctx, CourierService_GetCourierProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) StartShift(ctx context.Context, in *StartShiftRequest, opts ...grpc.CallOption) (*Courier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courier)
+	err := c.cc.Invoke(ctx, CourierService_StartShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) EndShift(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Courier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Courier)
+	err := c.cc.Invoke(ctx, CourierService_EndShift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) AssignPackage(ctx context.Context, in *AssignPackageRequest, opts ...grpc.CallOption) (*Package, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Package)
+	err := c.cc.Invoke(ctx, CourierService_AssignPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) GetAssignedPackages(ctx context.Context, in *PackageFilter, opts ...grpc.CallOption) (*PackageList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PackageList)
+	err := c.cc.Invoke(ctx, CourierService_GetAssignedPackages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courierServiceClient) UpdateDeliveryStatus(ctx context.Context, in *DeliveryStatusUpdate, opts ...grpc.CallOption) (*Package, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Package)
+	err := c.cc.Invoke(ctx, CourierService_UpdateDeliveryStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourierServiceServer is the server API for CourierService service.
+// All implementations must embed UnimplementedCourierServiceServer
+// for forward compatibility.
+type CourierServiceServer interface {
+	SaveCourierProfile(context.Context, *CourierProfile) (*Courier, error)
+	GetCourierProfile(context.Context, *Empty) (*Courier, error)
+	StartShift(context.Context, *StartShiftRequest) (*Courier, error)
+	EndShift(context.Context, *Empty) (*Courier, error)
+	AssignPackage(context.Context, *AssignPackageRequest) (*Package, error)
+	GetAssignedPackages(context.Context, *PackageFilter) (*PackageList, error)
+	UpdateDeliveryStatus(context.Context, *DeliveryStatusUpdate) (*Package, error)
+	mustEmbedUnimplementedCourierServiceServer()
+}
+
+// UnimplementedCourierServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourierServiceServer struct{}
+
+func (UnimplementedCourierServiceServer) SaveCourierProfile(context.Context, *CourierProfile) (*Courier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCourierProfile not implemented")
+}
+func (UnimplementedCourierServiceServer) GetCourierProfile(context.Context, *Empty) (*Courier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourierProfile not implemented")
+}
+func (UnimplementedCourierServiceServer) StartShift(context.Context, *StartShiftRequest) (*Courier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShift not implemented")
+}
+func (UnimplementedCourierServiceServer) EndShift(context.Context, *Empty) (*Courier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EndShift not implemented")
+}
+func (UnimplementedCourierServiceServer) AssignPackage(context.Context, *AssignPackageRequest) (*Package, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignPackage not implemented")
+}
+func (UnimplementedCourierServiceServer) GetAssignedPackages(context.Context, *PackageFilter) (*PackageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignedPackages not implemented")
+}
+func (UnimplementedCourierServiceServer) UpdateDeliveryStatus(context.Context, *DeliveryStatusUpdate) (*Package, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeliveryStatus not implemented")
+}
+func (UnimplementedCourierServiceServer) mustEmbedUnimplementedCourierServiceServer() {}
+func (UnimplementedCourierServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCourierServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourierServiceServer will
+// result in compilation errors.
+type UnsafeCourierServiceServer interface {
+	mustEmbedUnimplementedCourierServiceServer()
+}
+
+func RegisterCourierServiceServer(s grpc.ServiceRegistrar, srv CourierServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCourierServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourierService_ServiceDesc, srv)
+}
+
+func _CourierService_SaveCourierProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourierProfile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).SaveCourierProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_SaveCourierProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).SaveCourierProfile(ctx, req.(*CourierProfile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_GetCourierProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).GetCourierProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_GetCourierProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).GetCourierProfile(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_StartShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).StartShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_StartShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).StartShift(ctx, req.(*StartShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_EndShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).EndShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_EndShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).EndShift(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_AssignPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).AssignPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_AssignPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).AssignPackage(ctx, req.(*AssignPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_GetAssignedPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PackageFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).GetAssignedPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_GetAssignedPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).GetAssignedPackages(ctx, req.(*PackageFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourierService_UpdateDeliveryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveryStatusUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourierServiceServer).UpdateDeliveryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourierService_UpdateDeliveryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourierServiceServer).UpdateDeliveryStatus(ctx, req.(*DeliveryStatusUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourierService_ServiceDesc is the grpc.ServiceDesc for CourierService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourierService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delivery.CourierService",
+	HandlerType: (*CourierServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SaveCourierProfile",
+			Handler:    _CourierService_SaveCourierProfile_Handler,
+		},
+		{
+			MethodName: "GetCourierProfile",
+			Handler:    _CourierService_GetCourierProfile_Handler,
+		},
+		{
+			MethodName: "StartShift",
+			Handler:    _CourierService_StartShift_Handler,
+		},
+		{
+			MethodName: "EndShift",
+			Handler:    _CourierService_EndShift_Handler,
+		},
+		{
+			MethodName: "AssignPackage",
+			Handler:    _CourierService_AssignPackage_Handler,
+		},
+		{
+			MethodName: "GetAssignedPackages",
+			Handler:    _CourierService_GetAssignedPackages_Handler,
+		},
+		{
+			MethodName: "UpdateDeliveryStatus",
+			Handler:    _CourierService_UpdateDeliveryStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "database/database.proto",
+}
@@ -77,7 +77,8 @@ func sendRequest(i int) {
 		i+1, resp.Status, pkg.From, pkg.To, pkg.Weight)
 }
 
-func main() {
+// runLoad fires 50 random /calculate requests at the gateway, 10 at a time.
+func runLoad() {
 	rand.New(rand.NewSource(time.Now().UnixNano()))
 
 	start := time.Now()
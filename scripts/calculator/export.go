@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+)
+
+const exportPageSize = 500
+
+// runExport pages through GET /packages of the database service and writes
+// the packages as one JSON array, exactly as the service returned them.
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	base := fs.String("url", "http://localhost:8333", "database service HTTP address")
+	since := fs.String("since", "", "only packages created on or after this date (YYYY-MM-DD or RFC 3339)")
+	out := fs.String("out", "packages.json", "file to write")
+	fs.Parse(args)
+
+	query := url.Values{"limit": {strconv.Itoa(exportPageSize)}}
+	if *since != "" {
+		at, err := parseSince(*since)
+		if err != nil {
+			log.Fatalf("Invalid -since: %v", err)
+		}
+		query.Set("created_after", at.Format(time.RFC3339))
+	}
+
+	packages := []json.RawMessage{}
+	for offset := 0; ; offset += exportPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+		page, err := fetchPackages(*base + "/packages?" + query.Encode())
+		if err != nil {
+			log.Fatalf("Failed to export packages: %v", err)
+		}
+		packages = append(packages, page...)
+		if len(page) < exportPageSize {
+			break
+		}
+	}
+
+	data, err := json.MarshalIndent(packages, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatalf("Failed to write %s: %v", *out, err)
+	}
+	fmt.Printf("%d packages written to %s\n", len(packages), *out)
+}
+
+func fetchPackages(u string) ([]json.RawMessage, error) {
+	resp, err := client.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", u, resp.Status)
+	}
+	var page []json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func parseSince(s string) (time.Time, error) {
+	if at, err := time.Parse(time.DateOnly, s); err == nil {
+		return at, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
@@ -0,0 +1,45 @@
+// Command calculator drives the calculator from outside.
+//
+//	calculator [load]
+//	calculator export   [-url http://localhost:8333] [-since 2026-01-01] [-out packages.json]
+//	calculator simulate -packages packages.json -catalog catalog.yaml
+//	                    [-surcharges rules.json] [-promotions promotions.json]
+//	                    [-fuel percent] [-out dir]
+//
+// load sends random requests to the gateway. export saves the packages
+// stored by the database service to a file, and simulate re-prices that
+// file offline with a candidate catalog, surcharge rules or promotions and
+// reports the revenue change per route and per tariff.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const usage = `usage: calculator <command> [flags]
+
+commands:
+  load      send random /calculate requests to the gateway (default)
+  export    save the packages stored by the database service to a file
+  simulate  re-price exported packages with a candidate scenario
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		runLoad()
+		return
+	}
+	cmd, args := os.Args[1], os.Args[2:]
+	switch cmd {
+	case "load":
+		runLoad()
+	case "export":
+		runExport(args)
+	case "simulate":
+		runSimulate(args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
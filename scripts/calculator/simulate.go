@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/maksroxx/DeliveryService/calculator/simulation"
+)
+
+// runSimulate re-prices an export file and writes packages.csv, routes.csv
+// and tariffs.csv into the output directory; the summary goes to stdout.
+func runSimulate(args []string) {
+	fs := flag.NewFlagSet("simulate", flag.ExitOnError)
+	packagesPath := fs.String("packages", "packages.json", "packages exported with the export command")
+	catalogPath := fs.String("catalog", "", "candidate tariffs and cities: a catalog YAML file or a directory of CSVs")
+	surchargesPath := fs.String("surcharges", "", "candidate surcharge rules as a JSON array; calculator defaults when omitted")
+	promotionsPath := fs.String("promotions", "", "candidate promotions as a JSON array; historical discounts are kept when omitted")
+	fuel := fs.Float64("fuel", -1, "fuel surcharge percent; the rate recorded on each package when negative")
+	out := fs.String("out", "simulation", "directory for the CSV reports")
+	fs.Parse(args)
+
+	scenario, err := simulation.LoadScenario(*catalogPath, *surchargesPath, *promotionsPath)
+	if err != nil {
+		log.Fatalf("Failed to load scenario: %v", err)
+	}
+	if *fuel >= 0 {
+		scenario.FuelPercent = fuel
+	}
+	sim, err := simulation.New(scenario)
+	if err != nil {
+		log.Fatalf("Invalid scenario: %v", err)
+	}
+	packages, err := simulation.LoadPackages(*packagesPath)
+	if err != nil {
+		log.Fatalf("Failed to load packages: %v", err)
+	}
+
+	results := sim.Run(context.Background(), packages)
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err)
+	}
+	reports := map[string]func(w io.Writer) error{
+		"packages.csv": func(w io.Writer) error { return simulation.WriteResultsCSV(w, results) },
+		"routes.csv":   func(w io.Writer) error { return simulation.WriteDeltasCSV(w, "route", simulation.ByRoute(results)) },
+		"tariffs.csv":  func(w io.Writer) error { return simulation.WriteDeltasCSV(w, "tariff", simulation.ByTariff(results)) },
+	}
+	for name, write := range reports {
+		if err := writeReport(filepath.Join(*out, name), write); err != nil {
+			log.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	if err := simulation.Summarize(results).Write(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("reports written to %s\n", *out)
+}
+
+func writeReport(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}